@@ -29,7 +29,7 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
-	"github.com/kcp-dev/kcp/pkg/schemacompat"
+	"github.com/kcp-dev/sdk/schemacompat"
 )
 
 func main() {
@@ -58,6 +58,7 @@ func Bootstrap(ctx context.Context, crdClient apiextensionsclient.Interface, dis
 		{Group: apis.GroupName, Resource: "apiexportendpointslices"},
 		{Group: core.GroupName, Resource: "logicalclusters"},
 		{Group: apis.GroupName, Resource: "apiconversions"},
+		{Group: apis.GroupName, Resource: "apideployments"},
 		{Group: cache.GroupName, Resource: "cachedresources"},
 		{Group: cache.GroupName, Resource: "cachedresourceendpointslices"},
 	}
@@ -25,6 +25,7 @@ import (
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authentication/user"
@@ -270,6 +271,11 @@ func (o *apiBindingAdmission) Validate(ctx context.Context, a admission.Attribut
 			return forbidden
 		}
 
+		// The dependencies of the export must be bound, too, hence the user must be allowed to bind them.
+		if err := o.checkAPIExportDependenciesAccess(ctx, a.GetUserInfo(), exportClusterName, exportName); err != nil {
+			return admission.NewForbidden(a, fmt.Errorf("unable to %s APIBinding: %w", action, err))
+		}
+
 		// Verify the labels
 		value := ab.Labels()[apisv1alpha1.InternalAPIBindingExportLabelKey]
 		if expected := permissionclaims.ToAPIBindingExportLabelValue(
@@ -295,6 +301,59 @@ func (o *apiBindingAdmission) checkAPIExportAccess(ctx context.Context, user use
 	return CheckAPIExportAccess(ctx, user, apiExportName, authz)
 }
 
+// checkAPIExportDependenciesAccess checks that the user is allowed to use the 'bind' verb with the
+// dependencies of the APIExport, and with their dependencies in turn. Dependencies that do not exist
+// are left to the APIBinding controller to report.
+func (o *apiBindingAdmission) checkAPIExportDependenciesAccess(ctx context.Context, user user.Info, apiExportClusterName logicalcluster.Name, apiExportName string) error {
+	type exportRef struct {
+		clusterName logicalcluster.Name
+		name        string
+	}
+
+	// the visited APIExports, to stop at dependency cycles.
+	visited := sets.New[exportRef]()
+	queue := []exportRef{{clusterName: apiExportClusterName, name: apiExportName}}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if visited.Has(current) {
+			continue
+		}
+		visited.Insert(current)
+
+		export, err := o.getAPIExport(current.clusterName.Path(), current.name)
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return errors.New("unable to authorize request")
+		}
+
+		for _, dependency := range export.Spec.Dependencies {
+			// An empty path refers to the workspace of the depending APIExport.
+			path := logicalcluster.NewPath(dependency.Path)
+			if path.Empty() {
+				path = current.clusterName.Path()
+			}
+			dependencyExport, err := o.getAPIExport(path, dependency.Name)
+			if apierrors.IsNotFound(err) {
+				continue
+			} else if err != nil {
+				return errors.New("unable to authorize request")
+			}
+			ref := exportRef{clusterName: logicalcluster.From(dependencyExport), name: dependency.Name}
+			if visited.Has(ref) {
+				continue
+			}
+			if err := o.checkAPIExportAccess(ctx, user, ref.clusterName, ref.name); err != nil {
+				return fmt.Errorf("no permission to bind to export %s, a dependency of export %s", path.Join(dependency.Name), current.clusterName.Path().Join(current.name))
+			}
+			queue = append(queue, ref)
+		}
+	}
+
+	return nil
+}
+
 // ValidateInitialization ensures the required injected fields are set.
 func (o *apiBindingAdmission) ValidateInitialization() error {
 	if o.deepSARClient == nil {
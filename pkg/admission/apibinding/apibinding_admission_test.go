@@ -693,6 +693,90 @@ func newExport(path logicalcluster.Path, name string) apiExportBuilder {
 	}}
 }
 
+func TestValidateDependencies(t *testing.T) {
+	workloads := newExport(logicalcluster.NewPath("root:org:providers"), "workloads").APIExport
+	workloads.Spec.Dependencies = []apisv1alpha2.APIExportDependency{
+		{Name: "networking"},
+		{Path: "root:org:storage", Name: "volumes"},
+		{Name: "missing"},
+	}
+	volumes := newExport(logicalcluster.NewPath("root:org:storage"), "volumes").APIExport
+	volumes.Spec.Dependencies = []apisv1alpha2.APIExportDependency{
+		{Name: "disks"},
+		// a cycle back to the bound APIExport.
+		{Path: "root:org:providers", Name: "workloads"},
+	}
+	exports := map[string]*apisv1alpha2.APIExport{
+		"root:org:providers:workloads":  workloads,
+		"root-org-providers:workloads":  workloads,
+		"root-org-providers:networking": newExport(logicalcluster.NewPath("root:org:providers"), "networking").APIExport,
+		"root:org:storage:volumes":      volumes,
+		"root-org-storage:volumes":      volumes,
+		"root-org-storage:disks":        newExport(logicalcluster.NewPath("root:org:storage"), "disks").APIExport,
+	}
+
+	tests := map[string]struct {
+		deniedExport  string
+		expectedError string
+	}{
+		"passes when all dependencies are authorized": {},
+		"fails when a dependency in the same workspace is denied": {
+			deniedExport:  "networking",
+			expectedError: "no permission to bind to export root-org-providers:networking, a dependency of export root-org-providers:workloads",
+		},
+		"fails when a dependency in another workspace is denied": {
+			deniedExport:  "volumes",
+			expectedError: "no permission to bind to export root:org:storage:volumes, a dependency of export root-org-providers:workloads",
+		},
+		"fails when a transitive dependency is denied": {
+			deniedExport:  "disks",
+			expectedError: "no permission to bind to export root-org-storage:disks, a dependency of export root-org-storage:volumes",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			o := &apiBindingAdmission{
+				Handler: admission.NewHandler(admission.Create, admission.Update),
+				createAuthorizer: func(clusterName logicalcluster.Name, client kcpkubernetesclientset.ClusterInterface, opts delegated.Options) (authorizer.Authorizer, error) {
+					return &nameAuthorizer{denied: tc.deniedExport}, nil
+				},
+				getAPIExport: func(path logicalcluster.Path, name string) (*apisv1alpha2.APIExport, error) {
+					if export, ok := exports[path.Join(name).String()]; ok {
+						return export, nil
+					}
+					return nil, apierrors.NewNotFound(apisv1alpha2.Resource("apiexports"), path.Join(name).String())
+				},
+			}
+
+			attr := createAttrV1Alpha2(
+				newAPIBindingV1Alpha2().withName("test").withReference(logicalcluster.NewPath("root:org:providers"), "workloads").
+					withLabel(apisv1alpha1.InternalAPIBindingExportLabelKey, toSha224Base62("root-org-providers:workloads")).APIBinding,
+			)
+			ctx := request.WithCluster(context.Background(), request.Cluster{Name: logicalcluster.From(attr.GetObject().(metav1.Object))})
+
+			err := o.Validate(ctx, attr, nil)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+// nameAuthorizer denies access to the given resource name only.
+type nameAuthorizer struct {
+	denied string
+}
+
+func (a *nameAuthorizer) Authorize(ctx context.Context, attr authorizer.Attributes) (authorized authorizer.Decision, reason string, err error) {
+	if attr.GetName() == a.denied {
+		return authorizer.DecisionDeny, "reason", nil
+	}
+	return authorizer.DecisionAllow, "reason", nil
+}
+
 func TestValidateOverhangingPermissionClaims(t *testing.T) {
 	tests := map[string]struct {
 		annotations      func() map[string]string
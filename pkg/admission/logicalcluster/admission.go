@@ -70,6 +70,7 @@ var phaseOrdinal = map[corev1alpha1.LogicalClusterPhaseType]int{
 	corev1alpha1.LogicalClusterPhaseScheduling:   2,
 	corev1alpha1.LogicalClusterPhaseInitializing: 3,
 	corev1alpha1.LogicalClusterPhaseReady:        4,
+	corev1alpha1.LogicalClusterPhaseHibernated:   4,
 }
 
 // Admit adds type initializer to status on transition to initializing phase.
@@ -164,6 +165,10 @@ func (o *plugin) Validate(ctx context.Context, a admission.Attributes, _ admissi
 			return admission.NewForbidden(a, errors.New("spec.initializers is immutable"))
 		}
 
+		if old.Spec.Hibernation != logicalCluster.Spec.Hibernation {
+			return admission.NewForbidden(a, errors.New("spec.hibernation can only be changed through the Workspace"))
+		}
+
 		transitioningToInitializing := old.Status.Phase != corev1alpha1.LogicalClusterPhaseInitializing && logicalCluster.Status.Phase == corev1alpha1.LogicalClusterPhaseInitializing
 		if transitioningToInitializing && !newSpec.Equal(newStatus) {
 			return admission.NewForbidden(a, errors.New("status.initializers do not equal spec.initializers"))
@@ -314,6 +314,31 @@ func TestValidate(t *testing.T) {
 			),
 			wantErr: "cannot transition from",
 		},
+		{
+			name:        "passes hibernating a ready logical cluster",
+			clusterName: "root:org:ws",
+			attr: updateAttr(
+				newLogicalCluster("root:org:ws").withStatus(corev1alpha1.LogicalClusterStatus{
+					Phase: corev1alpha1.LogicalClusterPhaseHibernated,
+				}).LogicalCluster,
+				newLogicalCluster("root:org:ws").withStatus(corev1alpha1.LogicalClusterStatus{
+					Phase: corev1alpha1.LogicalClusterPhaseReady,
+				}).LogicalCluster,
+			),
+		},
+		{
+			name:        "fails if spec.hibernation is changed",
+			clusterName: "root:org:ws",
+			attr: updateAttr(
+				newLogicalCluster("root:org:ws").withHibernation(corev1alpha1.LogicalClusterHibernationModeDenied).withStatus(corev1alpha1.LogicalClusterStatus{
+					Phase: corev1alpha1.LogicalClusterPhaseReady,
+				}).LogicalCluster,
+				newLogicalCluster("root:org:ws").withStatus(corev1alpha1.LogicalClusterStatus{
+					Phase: corev1alpha1.LogicalClusterPhaseReady,
+				}).LogicalCluster,
+			),
+			wantErr: "spec.hibernation can only be changed through the Workspace",
+		},
 		{
 			name:        "fails deletion as another user",
 			clusterName: "root:org:ws",
@@ -421,6 +446,11 @@ func (b thisWsBuilder) withInitializers(initializers ...corev1alpha1.LogicalClus
 	return b
 }
 
+func (b thisWsBuilder) withHibernation(mode corev1alpha1.LogicalClusterHibernationMode) thisWsBuilder {
+	b.Spec.Hibernation = mode
+	return b
+}
+
 func (b thisWsBuilder) directlyDeletable() thisWsBuilder {
 	b.Spec.DirectlyDeletable = true
 	return b
@@ -44,6 +44,7 @@ import (
 
 	kcpinitializers "github.com/kcp-dev/kcp/pkg/admission/initializers"
 	"github.com/kcp-dev/kcp/pkg/admission/validatingwebhook"
+	kcpfilters "github.com/kcp-dev/kcp/pkg/server/filters"
 )
 
 const (
@@ -111,7 +112,7 @@ func (p *Plugin) Admit(ctx context.Context, attr admission.Attributes, o admissi
 		config = bytes.NewReader(p.config)
 	}
 
-	hookSource, err := p.getHookSource(clusterName, attr.GetResource().GroupResource())
+	hookSource, err := p.getHookSource(clusterName, attr.GetResource().GroupResource(), kcpfilters.IdentityFromContext(ctx))
 	if err != nil {
 		return err
 	}
@@ -144,8 +145,8 @@ func (p *Plugin) Admit(ctx context.Context, attr admission.Attributes, o admissi
 	return plugin.Admit(ctx, attr, o)
 }
 
-func (p *Plugin) getHookSource(clusterName logicalcluster.Name, groupResource schema.GroupResource) (generic.Source, error) {
-	clusterNameForGroupResource, err := p.getSourceClusterForGroupResource(clusterName, groupResource)
+func (p *Plugin) getHookSource(clusterName logicalcluster.Name, groupResource schema.GroupResource, identity string) (generic.Source, error) {
+	clusterNameForGroupResource, err := p.getSourceClusterForGroupResource(clusterName, groupResource, identity)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +165,7 @@ func (p *Plugin) getHookSource(clusterName logicalcluster.Name, groupResource sc
 	return p.managersCache[clusterNameForGroupResource], nil
 }
 
-func (p *Plugin) getSourceClusterForGroupResource(clusterName logicalcluster.Name, groupResource schema.GroupResource) (logicalcluster.Name, error) {
+func (p *Plugin) getSourceClusterForGroupResource(clusterName logicalcluster.Name, groupResource schema.GroupResource, identity string) (logicalcluster.Name, error) {
 	objs, err := p.getAPIBindings(clusterName)
 	if err != nil {
 		return "", err
@@ -172,6 +173,11 @@ func (p *Plugin) getSourceClusterForGroupResource(clusterName logicalcluster.Nam
 
 	for _, apiBinding := range objs {
 		for _, br := range apiBinding.Status.BoundResources {
+			// A resource renamed by the APIBinding is requested with the identity of its APIExport, which
+			// tells it apart from a resource of the same name bound by another APIBinding.
+			if (identity != "" && br.Schema.IdentityHash != identity) || (identity == "" && br.ServedAs != nil) {
+				continue
+			}
 			if br.Group == groupResource.Group && br.Resource == groupResource.Resource {
 				// GroupResource comes from an APIBinding/APIExport
 				return logicalcluster.Name(apiBinding.Status.APIExportClusterName), nil
@@ -28,7 +28,8 @@ import (
 	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
 )
 
-// Default the external and virtual URLs with the base URL if they are not set.
+// Default the external and virtual URLs with the base URL if they are not set,
+// and cordon shards that are drained.
 
 const (
 	PluginName = "tenancy.kcp.io/Shard"
@@ -82,6 +83,11 @@ func (o *shard) Admit(_ context.Context, a admission.Attributes, _ admission.Obj
 		wShard.Spec.VirtualWorkspaceURL = wShard.Spec.BaseURL
 	}
 
+	// a draining shard must not receive new workspaces while the old ones move away.
+	if wShard.Spec.Drain {
+		wShard.Spec.Unschedulable = true
+	}
+
 	raw, err := runtime.DefaultUnstructuredConverter.ToUnstructured(wShard)
 	if err != nil {
 		return err
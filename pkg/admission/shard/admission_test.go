@@ -96,6 +96,16 @@ func (b *shardBuilder) virtualWorkspaceURL(u string) *shardBuilder {
 	return b
 }
 
+func (b *shardBuilder) unschedulable() *shardBuilder {
+	b.Spec.Unschedulable = true
+	return b
+}
+
+func (b *shardBuilder) drain() *shardBuilder {
+	b.Spec.Drain = true
+	return b
+}
+
 func TestAdmitIgnoresOtherResources(t *testing.T) {
 	o := &shard{
 		Handler: admission.NewHandler(admission.Create, admission.Update),
@@ -148,6 +158,16 @@ func TestAdmit(t *testing.T) {
 			shard:    newShard().baseURL("https://base").externalURL("https://external").Shard,
 			expected: newShard().baseURL("https://base").externalURL("https://external").virtualWorkspaceURL("https://base").Shard,
 		},
+		{
+			name:     "cordoned shard",
+			shard:    newShard().baseURL("https://test").unschedulable().Shard,
+			expected: newShard().baseURL("https://test").externalURL("https://test").virtualWorkspaceURL("https://test").unschedulable().Shard,
+		},
+		{
+			name:     "draining shard is cordoned",
+			shard:    newShard().baseURL("https://test").drain().Shard,
+			expected: newShard().baseURL("https://test").externalURL("https://test").virtualWorkspaceURL("https://test").unschedulable().drain().Shard,
+		},
 	}
 	for _, tt := range tests {
 		attrs := map[string]admission.Attributes{
@@ -49,6 +49,7 @@ import (
 
 	"github.com/kcp-dev/kcp/pkg/admission/initializers"
 	"github.com/kcp-dev/kcp/pkg/admission/kubequota"
+	kcpfilters "github.com/kcp-dev/kcp/pkg/server/filters"
 )
 
 const PluginName = "KCPValidatingAdmissionPolicy"
@@ -169,7 +170,7 @@ func (k *KubeValidatingAdmissionPolicy) Validate(ctx context.Context, a admissio
 		return err
 	}
 
-	sourceCluster, err := k.getSourceClusterForGroupResource(cluster.Name, a.GetResource().GroupResource())
+	sourceCluster, err := k.getSourceClusterForGroupResource(cluster.Name, a.GetResource().GroupResource(), kcpfilters.IdentityFromContext(ctx))
 	if err != nil {
 		return err
 	}
@@ -182,7 +183,7 @@ func (k *KubeValidatingAdmissionPolicy) Validate(ctx context.Context, a admissio
 	return delegate.Validate(ctx, a, o)
 }
 
-func (k *KubeValidatingAdmissionPolicy) getSourceClusterForGroupResource(clusterName logicalcluster.Name, groupResource schema.GroupResource) (logicalcluster.Name, error) {
+func (k *KubeValidatingAdmissionPolicy) getSourceClusterForGroupResource(clusterName logicalcluster.Name, groupResource schema.GroupResource, identity string) (logicalcluster.Name, error) {
 	objs, err := k.getAPIBindings(clusterName)
 	if err != nil {
 		return "", err
@@ -190,6 +191,11 @@ func (k *KubeValidatingAdmissionPolicy) getSourceClusterForGroupResource(cluster
 
 	for _, apiBinding := range objs {
 		for _, br := range apiBinding.Status.BoundResources {
+			// A resource renamed by the APIBinding is requested with the identity of its APIExport, which
+			// tells it apart from a resource of the same name bound by another APIBinding.
+			if (identity != "" && br.Schema.IdentityHash != identity) || (identity == "" && br.ServedAs != nil) {
+				continue
+			}
 			if br.Group == groupResource.Group && br.Resource == groupResource.Resource {
 				return logicalcluster.Name(apiBinding.Status.APIExportClusterName), nil
 			}
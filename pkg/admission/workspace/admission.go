@@ -24,16 +24,21 @@ import (
 	"io"
 
 	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
 	kuser "k8s.io/apiserver/pkg/authentication/user"
 	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/tools/cache"
 
+	kcpkubernetesinformers "github.com/kcp-dev/client-go/informers"
+	"github.com/kcp-dev/logicalcluster/v3"
 	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
 	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
 	kcpinformers "github.com/kcp-dev/sdk/client/informers/externalversions"
@@ -41,6 +46,7 @@ import (
 
 	kcpinitializers "github.com/kcp-dev/kcp/pkg/admission/initializers"
 	"github.com/kcp-dev/kcp/pkg/authorization"
+	"github.com/kcp-dev/kcp/pkg/indexers"
 )
 
 // Validate and admit Workspace creation and updates.
@@ -62,6 +68,11 @@ type workspace struct {
 	*admission.Handler
 
 	logicalClusterLister corev1alpha1listers.LogicalClusterClusterLister
+
+	listResourceQuotas       func(clusterName logicalcluster.Name) ([]*corev1.ResourceQuota, error)
+	resourceQuotasSynced     func() bool
+	getGlobalLogicalClusters func(path logicalcluster.Path) ([]*corev1alpha1.LogicalCluster, error)
+	countNestedWorkspaces    func(path logicalcluster.Path) (int, error)
 }
 
 // Ensure that the required admission interfaces are implemented.
@@ -69,6 +80,7 @@ var _ admission.MutationInterface = &workspace{}
 var _ admission.ValidationInterface = &workspace{}
 var _ = admission.InitializationValidator(&workspace{})
 var _ = kcpinitializers.WantsKcpInformers(&workspace{})
+var _ = kcpinitializers.WantsKubeInformers(&workspace{})
 
 // Admit ensures that
 // - the owner user is recorded in annotations on create
@@ -133,7 +145,9 @@ func (o *workspace) Admit(ctx context.Context, a admission.Attributes, _ admissi
 // - the cluster is not removed
 // - the user is recorded in annotations on create
 // - the required groups match with the LogicalCluster
-// - only system privileged users can set both spec.Type and spec.Mount.
+// - the nested workspace quotas of the LogicalCluster and its ancestors are not exceeded
+// - only system privileged users can set both spec.Type and spec.Mount
+// - only system privileged users can set spec.moveFrom, and only on creation.
 func (o *workspace) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) (err error) {
 	clusterName, err := genericapirequest.ClusterNameFrom(ctx)
 	if err != nil {
@@ -177,6 +191,18 @@ func (o *workspace) Validate(ctx context.Context, a admission.Attributes, _ admi
 			if old.Spec.URL != ws.Spec.URL && !isSystemPrivileged {
 				return admission.NewForbidden(a, errors.New("spec.URL can only be changed by system privileged users"))
 			}
+			if oldShard, newShard := locationShard(old), locationShard(ws); oldShard != newShard {
+				if !isSystemPrivileged {
+					return admission.NewForbidden(a, errors.New("spec.location.shard can only be changed by system privileged users"))
+				}
+				if old.Status.Phase == corev1alpha1.LogicalClusterPhaseMigrating {
+					return admission.NewForbidden(a, errors.New("spec.location.shard cannot be changed while the workspace is migrating"))
+				}
+			}
+
+			if old.Spec.MoveFrom != ws.Spec.MoveFrom {
+				return admission.NewForbidden(a, errors.New("spec.moveFrom is immutable"))
+			}
 
 			if errs := validation.ValidateImmutableField(ws.Spec.Type, old.Spec.Type, field.NewPath("spec", "type")); len(errs) > 0 {
 				return admission.NewForbidden(a, errs.ToAggregate())
@@ -208,6 +234,9 @@ func (o *workspace) Validate(ctx context.Context, a admission.Attributes, _ admi
 			if old.Spec.Mount.Reference.APIVersion != ws.Spec.Mount.Reference.APIVersion {
 				return admission.NewForbidden(a, errors.New("spec.mount.apiVersion is immutable"))
 			}
+			if ws.Spec.Hibernation != "" {
+				return admission.NewForbidden(a, errors.New("spec.hibernation cannot be set for mounted workspaces"))
+			}
 
 			// if not system privileged, disallow setting spec.type
 			if !isSystemPrivileged && ws.Spec.Type != nil {
@@ -232,6 +261,20 @@ func (o *workspace) Validate(ctx context.Context, a admission.Attributes, _ admi
 		if ws.Spec.URL != "" && !isSystemPrivileged {
 			return admission.NewForbidden(a, errors.New("spec.URL can only be set by system privileged users"))
 		}
+		if locationShard(ws) != "" && !isSystemPrivileged {
+			return admission.NewForbidden(a, errors.New("spec.location.shard can only be set by system privileged users"))
+		}
+		if ws.Spec.MoveFrom != "" {
+			if !isSystemPrivileged {
+				return admission.NewForbidden(a, errors.New("spec.moveFrom can only be set by system privileged users"))
+			}
+			if !logicalcluster.NewPath(ws.Spec.MoveFrom).IsValid() {
+				return admission.NewForbidden(a, fmt.Errorf("spec.moveFrom %q is not a valid workspace path", ws.Spec.MoveFrom))
+			}
+			if ws.Spec.Mount != nil {
+				return admission.NewForbidden(a, errors.New("spec.moveFrom cannot be set for mounted workspaces"))
+			}
+		}
 
 		if !isSystemPrivileged {
 			userInfo, err := WorkspaceOwnerAnnotationValue(a.GetUserInfo())
@@ -258,6 +301,17 @@ func (o *workspace) Validate(ctx context.Context, a admission.Attributes, _ admi
 			}
 		}
 
+		// check that the nested workspace quotas of this workspace and its ancestors are not exceeded
+		if !isSystemPrivileged && ws.Spec.Mount == nil {
+			logicalCluster, err := o.logicalClusterLister.Cluster(clusterName).Get(corev1alpha1.LogicalClusterName)
+			if err != nil {
+				return admission.NewForbidden(a, err)
+			}
+			if err := o.checkQuotas(logicalCluster, ws.Name); err != nil {
+				return admission.NewForbidden(a, err)
+			}
+		}
+
 		if ws.Spec.Mount != nil {
 			if ws.Spec.Mount.Reference.Kind == "" {
 				return admission.NewForbidden(a, errors.New("spec.mount.kind must be set"))
@@ -268,6 +322,9 @@ func (o *workspace) Validate(ctx context.Context, a admission.Attributes, _ admi
 			if ws.Spec.Mount.Reference.APIVersion == "" {
 				return admission.NewForbidden(a, errors.New("spec.mount.apiVersion must be set"))
 			}
+			if ws.Spec.Hibernation != "" {
+				return admission.NewForbidden(a, errors.New("spec.hibernation cannot be set for mounted workspaces"))
+			}
 
 			if !isSystemPrivileged && ws.Spec.Type != nil {
 				return admission.NewForbidden(a, errors.New("spec.type cannot be set for mounted workspaces"))
@@ -282,15 +339,43 @@ func (o *workspace) ValidateInitialization() error {
 	if o.logicalClusterLister == nil {
 		return fmt.Errorf(PluginName + " plugin needs an LogicalCluster lister")
 	}
+	if o.listResourceQuotas == nil {
+		return fmt.Errorf(PluginName + " plugin needs a ResourceQuota lister")
+	}
+	if o.getGlobalLogicalClusters == nil || o.countNestedWorkspaces == nil {
+		return fmt.Errorf(PluginName + " plugin needs a global LogicalCluster indexer")
+	}
 	return nil
 }
 
 func (o *workspace) SetKcpInformers(local, global kcpinformers.SharedInformerFactory) {
 	logicalClustersReady := local.Core().V1alpha1().LogicalClusters().Informer().HasSynced
+	globalLogicalClustersReady := global.Core().V1alpha1().LogicalClusters().Informer().HasSynced
 	o.SetReadyFunc(func() bool {
-		return logicalClustersReady()
+		return logicalClustersReady() && globalLogicalClustersReady() && (o.resourceQuotasSynced == nil || o.resourceQuotasSynced())
 	})
 	o.logicalClusterLister = local.Core().V1alpha1().LogicalClusters().Lister()
+
+	indexers.AddIfNotPresentOrDie(global.Core().V1alpha1().LogicalClusters().Informer().GetIndexer(), cache.Indexers{
+		indexers.ByLogicalClusterPath:         indexers.IndexByLogicalClusterPath,
+		indexers.ByLogicalClusterAncestorPath: indexers.IndexByLogicalClusterAncestorPath,
+	})
+	globalLogicalClusterIndexer := global.Core().V1alpha1().LogicalClusters().Informer().GetIndexer()
+	o.getGlobalLogicalClusters = func(path logicalcluster.Path) ([]*corev1alpha1.LogicalCluster, error) {
+		return indexers.ByIndex[*corev1alpha1.LogicalCluster](globalLogicalClusterIndexer, indexers.ByLogicalClusterPath, path.String())
+	}
+	o.countNestedWorkspaces = func(path logicalcluster.Path) (int, error) {
+		nested, err := globalLogicalClusterIndexer.ByIndex(indexers.ByLogicalClusterAncestorPath, path.String())
+		return len(nested), err
+	}
+}
+
+func (o *workspace) SetKubeInformers(local, global kcpkubernetesinformers.SharedInformerFactory) {
+	o.resourceQuotasSynced = local.Core().V1().ResourceQuotas().Informer().HasSynced
+	resourceQuotaLister := local.Core().V1().ResourceQuotas().Lister()
+	o.listResourceQuotas = func(clusterName logicalcluster.Name) ([]*corev1.ResourceQuota, error) {
+		return resourceQuotaLister.Cluster(clusterName).List(labels.Everything())
+	}
 }
 
 // updateUnstructured updates the given unstructured object to match the given workspace.
@@ -303,6 +388,13 @@ func updateUnstructured(u *unstructured.Unstructured, ws *tenancyv1alpha1.Worksp
 	return nil
 }
 
+func locationShard(ws *tenancyv1alpha1.Workspace) string {
+	if ws.Spec.Location == nil {
+		return ""
+	}
+	return ws.Spec.Location.Shard
+}
+
 // WorkspaceOwnerAnnotationValue returns the value of the ExperimentalWorkspaceOwnerAnnotationKey annotation.
 func WorkspaceOwnerAnnotationValue(user kuser.Info) (string, error) {
 	info := &authenticationv1.UserInfo{
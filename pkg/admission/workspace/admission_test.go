@@ -24,7 +24,9 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -319,10 +321,13 @@ func TestAdmit(t *testing.T) {
 
 func TestValidate(t *testing.T) {
 	tests := []struct {
-		name            string
-		logicalClusters []*corev1alpha1.LogicalCluster
-		a               admission.Attributes
-		expectedErrors  []string
+		name                  string
+		logicalClusters       []*corev1alpha1.LogicalCluster
+		globalLogicalClusters []*corev1alpha1.LogicalCluster
+		resourceQuotas        []*corev1.ResourceQuota
+		nestedWorkspaces      map[string]int
+		a                     admission.Attributes
+		expectedErrors        []string
 	}{
 		{
 			name: "rejects type mutations",
@@ -519,6 +524,201 @@ func TestValidate(t *testing.T) {
 				}),
 			expectedErrors: []string{"spec.URL can only be changed by system privileged users"},
 		},
+		{
+			name: "rejects setting the location shard from unprivileged users",
+			logicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster(logicalcluster.NewPath("root:org")).LogicalCluster,
+			},
+			a: updateAttr(&tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test",
+					Annotations: map[string]string{"experimental.tenancy.kcp.io/owner": "{}"},
+				},
+				Spec: tenancyv1alpha1.WorkspaceSpec{
+					Type: &tenancyv1alpha1.WorkspaceTypeReference{
+						Name: "foo",
+						Path: "root:org",
+					},
+					Location: &tenancyv1alpha1.WorkspaceLocation{Shard: "amber"},
+				},
+			},
+				&tenancyv1alpha1.Workspace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "test",
+						Annotations: map[string]string{"experimental.tenancy.kcp.io/owner": "{}"},
+					},
+					Spec: tenancyv1alpha1.WorkspaceSpec{
+						Type: &tenancyv1alpha1.WorkspaceTypeReference{
+							Name: "foo",
+							Path: "root:org",
+						},
+					},
+				}),
+			expectedErrors: []string{"spec.location.shard can only be changed by system privileged users"},
+		},
+		{
+			name: "allows changing the location shard for system privileged users",
+			logicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster(logicalcluster.NewPath("root:org")).LogicalCluster,
+			},
+			a: updateAttrWithUser(&tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test",
+					Annotations: map[string]string{"experimental.tenancy.kcp.io/owner": "{}"},
+				},
+				Spec: tenancyv1alpha1.WorkspaceSpec{
+					Type: &tenancyv1alpha1.WorkspaceTypeReference{
+						Name: "foo",
+						Path: "root:org",
+					},
+					Location: &tenancyv1alpha1.WorkspaceLocation{Shard: "amber"},
+				},
+				Status: tenancyv1alpha1.WorkspaceStatus{
+					Phase: corev1alpha1.LogicalClusterPhaseReady,
+				},
+			},
+				&tenancyv1alpha1.Workspace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "test",
+						Annotations: map[string]string{"experimental.tenancy.kcp.io/owner": "{}"},
+					},
+					Spec: tenancyv1alpha1.WorkspaceSpec{
+						Type: &tenancyv1alpha1.WorkspaceTypeReference{
+							Name: "foo",
+							Path: "root:org",
+						},
+						Location: &tenancyv1alpha1.WorkspaceLocation{Shard: "root"},
+					},
+					Status: tenancyv1alpha1.WorkspaceStatus{
+						Phase: corev1alpha1.LogicalClusterPhaseReady,
+					},
+				}, &kuser.DefaultInfo{Groups: []string{kuser.SystemPrivilegedGroup}}),
+		},
+		{
+			name: "rejects changing the location shard while migrating",
+			logicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster(logicalcluster.NewPath("root:org")).LogicalCluster,
+			},
+			a: updateAttrWithUser(&tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test",
+					Annotations: map[string]string{"experimental.tenancy.kcp.io/owner": "{}"},
+				},
+				Spec: tenancyv1alpha1.WorkspaceSpec{
+					Type: &tenancyv1alpha1.WorkspaceTypeReference{
+						Name: "foo",
+						Path: "root:org",
+					},
+					Location: &tenancyv1alpha1.WorkspaceLocation{Shard: "beta"},
+				},
+				Status: tenancyv1alpha1.WorkspaceStatus{
+					Phase: corev1alpha1.LogicalClusterPhaseMigrating,
+				},
+			},
+				&tenancyv1alpha1.Workspace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "test",
+						Annotations: map[string]string{"experimental.tenancy.kcp.io/owner": "{}"},
+					},
+					Spec: tenancyv1alpha1.WorkspaceSpec{
+						Type: &tenancyv1alpha1.WorkspaceTypeReference{
+							Name: "foo",
+							Path: "root:org",
+						},
+						Location: &tenancyv1alpha1.WorkspaceLocation{Shard: "amber"},
+					},
+					Status: tenancyv1alpha1.WorkspaceStatus{
+						Phase: corev1alpha1.LogicalClusterPhaseMigrating,
+					},
+				}, &kuser.DefaultInfo{Groups: []string{kuser.SystemPrivilegedGroup}}),
+			expectedErrors: []string{"spec.location.shard cannot be changed while the workspace is migrating"},
+		},
+		{
+			name: "rejects moveFrom from unprivileged users",
+			logicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster(logicalcluster.NewPath("root:org")).LogicalCluster,
+			},
+			a: createAttr(&tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: tenancyv1alpha1.WorkspaceSpec{
+					Type: &tenancyv1alpha1.WorkspaceTypeReference{
+						Name: "foo",
+						Path: "root:org",
+					},
+					MoveFrom: "root:other:test",
+				},
+			}),
+			expectedErrors: []string{"spec.moveFrom can only be set by system privileged users"},
+		},
+		{
+			name: "rejects invalid moveFrom",
+			logicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster(logicalcluster.NewPath("root:org")).LogicalCluster,
+			},
+			a: createAttrWithUser(&tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: tenancyv1alpha1.WorkspaceSpec{
+					Type: &tenancyv1alpha1.WorkspaceTypeReference{
+						Name: "foo",
+						Path: "root:org",
+					},
+					MoveFrom: "root:Other",
+				},
+			}, &kuser.DefaultInfo{Groups: []string{kuser.SystemPrivilegedGroup}}),
+			expectedErrors: []string{`spec.moveFrom "root:Other" is not a valid workspace path`},
+		},
+		{
+			name: "allows moveFrom for system privileged users",
+			logicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster(logicalcluster.NewPath("root:org")).LogicalCluster,
+			},
+			a: createAttrWithUser(&tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: tenancyv1alpha1.WorkspaceSpec{
+					Type: &tenancyv1alpha1.WorkspaceTypeReference{
+						Name: "foo",
+						Path: "root:org",
+					},
+					MoveFrom: "root:other:test",
+				},
+			}, &kuser.DefaultInfo{Groups: []string{kuser.SystemPrivilegedGroup}}),
+		},
+		{
+			name: "rejects changing moveFrom",
+			logicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster(logicalcluster.NewPath("root:org")).LogicalCluster,
+			},
+			a: updateAttrWithUser(&tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: tenancyv1alpha1.WorkspaceSpec{
+					Type: &tenancyv1alpha1.WorkspaceTypeReference{
+						Name: "foo",
+						Path: "root:org",
+					},
+					MoveFrom: "root:other:test",
+				},
+			},
+				&tenancyv1alpha1.Workspace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test",
+					},
+					Spec: tenancyv1alpha1.WorkspaceSpec{
+						Type: &tenancyv1alpha1.WorkspaceTypeReference{
+							Name: "foo",
+							Path: "root:org",
+						},
+					},
+				}, &kuser.DefaultInfo{Groups: []string{kuser.SystemPrivilegedGroup}}),
+			expectedErrors: []string{"spec.moveFrom is immutable"},
+		},
 		{
 			name: "rejects transition to ready directly when invalid",
 			logicalClusters: []*corev1alpha1.LogicalCluster{
@@ -714,12 +914,130 @@ func TestValidate(t *testing.T) {
 				Groups: []string{kuser.SystemPrivilegedGroup},
 			}),
 		},
+		{
+			name: "rejects exceeding nested workspaces quota on create",
+			logicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster(logicalcluster.NewPath("root:org")).LogicalCluster,
+			},
+			resourceQuotas: []*corev1.ResourceQuota{
+				newResourceQuota("root:org", "a", corev1.ResourceList{tenancyv1alpha1.ResourceNestedWorkspaces: resource.MustParse("10")}),
+				newResourceQuota("root:org", "b", corev1.ResourceList{tenancyv1alpha1.ResourceNestedWorkspaces: resource.MustParse("5")}),
+				newResourceQuota("root:other", "c", corev1.ResourceList{tenancyv1alpha1.ResourceNestedWorkspaces: resource.MustParse("1")}),
+			},
+			nestedWorkspaces: map[string]int{"root:org": 5},
+			a: createAttr(&tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+					Annotations: map[string]string{
+						"experimental.tenancy.kcp.io/owner": "{}",
+					},
+				},
+			}),
+			expectedErrors: []string{"exceeded quota of workspace root:org: tenancy.kcp.io/nested-workspaces=5, but 5 workspaces are nested below it"},
+		},
+		{
+			name: "accepts within nested workspaces quota on create",
+			logicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster(logicalcluster.NewPath("root:org")).LogicalCluster,
+			},
+			resourceQuotas: []*corev1.ResourceQuota{
+				newResourceQuota("root:org", "a", corev1.ResourceList{tenancyv1alpha1.ResourceNestedWorkspaces: resource.MustParse("10")}),
+			},
+			nestedWorkspaces: map[string]int{"root:org": 9},
+			a: createAttr(&tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+					Annotations: map[string]string{
+						"experimental.tenancy.kcp.io/owner": "{}",
+					},
+				},
+			}),
+		},
+		{
+			name: "rejects exceeding nested workspaces quota of an ancestor on create",
+			logicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster(logicalcluster.NewPath("root:org")).LogicalCluster,
+			},
+			globalLogicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster(logicalcluster.NewPath("root")).WithWorkspaceQuota(`{"nestedWorkspaces":3}`).LogicalCluster,
+			},
+			nestedWorkspaces: map[string]int{"root": 3, "root:org": 1},
+			a: createAttr(&tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+					Annotations: map[string]string{
+						"experimental.tenancy.kcp.io/owner": "{}",
+					},
+				},
+			}),
+			expectedErrors: []string{"exceeded quota of workspace root: tenancy.kcp.io/nested-workspaces=3, but 3 workspaces are nested below it"},
+		},
+		{
+			name: "rejects exceeding workspace depth quota of an ancestor on create",
+			logicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster(logicalcluster.NewPath("root:org")).LogicalCluster,
+			},
+			globalLogicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster(logicalcluster.NewPath("root")).WithWorkspaceQuota(`{"workspaceDepth":1}`).LogicalCluster,
+			},
+			a: createAttr(&tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+					Annotations: map[string]string{
+						"experimental.tenancy.kcp.io/owner": "{}",
+					},
+				},
+			}),
+			expectedErrors: []string{"exceeded quota of workspace root: tenancy.kcp.io/workspace-depth=1, but the workspace would be nested 2 levels deep"},
+		},
+		{
+			name: "ignores workspace quota annotations set on the workspace on create",
+			logicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster(logicalcluster.NewPath("root:org")).LogicalCluster,
+			},
+			globalLogicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster(logicalcluster.NewPath("root")).WithWorkspaceQuota(`{"workspaceDepth":1}`).LogicalCluster,
+			},
+			a: createAttr(&tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+					Annotations: map[string]string{
+						"experimental.tenancy.kcp.io/owner":       "{}",
+						"internal.tenancy.kcp.io/workspace-quota": `{"workspaceDepth":100}`,
+					},
+				},
+			}),
+			expectedErrors: []string{"exceeded quota of workspace root: tenancy.kcp.io/workspace-depth=1, but the workspace would be nested 2 levels deep"},
+		},
+		{
+			name: "accepts exceeding workspace quotas on create as system:master",
+			logicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster(logicalcluster.NewPath("root:org")).LogicalCluster,
+			},
+			globalLogicalClusters: []*corev1alpha1.LogicalCluster{
+				newLogicalCluster(logicalcluster.NewPath("root")).WithWorkspaceQuota(`{"workspaceDepth":1}`).LogicalCluster,
+			},
+			a: createAttrWithUser(&tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+					Annotations: map[string]string{
+						"experimental.tenancy.kcp.io/owner": "{}",
+					},
+				},
+			}, &kuser.DefaultInfo{
+				Name:   "admin",
+				Groups: []string{kuser.SystemPrivilegedGroup},
+			}),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			o := &workspace{
-				Handler:              admission.NewHandler(admission.Create, admission.Update),
-				logicalClusterLister: fakeLogicalClusterClusterLister(tt.logicalClusters),
+				Handler:                  admission.NewHandler(admission.Create, admission.Update),
+				logicalClusterLister:     fakeLogicalClusterClusterLister(tt.logicalClusters),
+				listResourceQuotas:       fakeListResourceQuotas(tt.resourceQuotas),
+				getGlobalLogicalClusters: fakeGetGlobalLogicalClusters(tt.globalLogicalClusters),
+				countNestedWorkspaces:    fakeCountNestedWorkspaces(tt.nestedWorkspaces),
 			}
 			ctx := request.WithCluster(context.Background(), request.Cluster{Name: "root:org"})
 			err := o.Validate(ctx, tt.a, nil)
@@ -775,6 +1093,24 @@ func (b thisBuilder) WithRequiredGroups(groups ...string) thisBuilder {
 	return b
 }
 
+func (b thisBuilder) WithWorkspaceQuota(value string) thisBuilder {
+	b.LogicalCluster.Annotations[tenancyv1alpha1.LogicalClusterWorkspaceQuotaAnnotationKey] = value
+	return b
+}
+
+func newResourceQuota(clusterName, name string, hard corev1.ResourceList) *corev1.ResourceQuota {
+	return &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "admin",
+			Annotations: map[string]string{
+				logicalcluster.AnnotationKey: clusterName,
+			},
+		},
+		Spec: corev1.ResourceQuotaSpec{Hard: hard},
+	}
+}
+
 type fakeLogicalClusterClusterLister []*corev1alpha1.LogicalCluster
 
 func (l fakeLogicalClusterClusterLister) List(selector labels.Selector) (ret []*corev1alpha1.LogicalCluster, err error) {
@@ -813,3 +1149,33 @@ func (l fakeLogicalClusterLister) GetWithContext(ctx context.Context, name strin
 	}
 	return nil, apierrors.NewNotFound(tenancyv1alpha1.Resource("workspace"), name)
 }
+
+func fakeListResourceQuotas(quotas []*corev1.ResourceQuota) func(clusterName logicalcluster.Name) ([]*corev1.ResourceQuota, error) {
+	return func(clusterName logicalcluster.Name) ([]*corev1.ResourceQuota, error) {
+		var ret []*corev1.ResourceQuota
+		for _, q := range quotas {
+			if logicalcluster.From(q) == clusterName {
+				ret = append(ret, q)
+			}
+		}
+		return ret, nil
+	}
+}
+
+func fakeGetGlobalLogicalClusters(logicalClusters []*corev1alpha1.LogicalCluster) func(path logicalcluster.Path) ([]*corev1alpha1.LogicalCluster, error) {
+	return func(path logicalcluster.Path) ([]*corev1alpha1.LogicalCluster, error) {
+		var ret []*corev1alpha1.LogicalCluster
+		for _, lc := range logicalClusters {
+			if logicalcluster.From(lc).Path() == path {
+				ret = append(ret, lc)
+			}
+		}
+		return ret, nil
+	}
+}
+
+func fakeCountNestedWorkspaces(nested map[string]int) func(path logicalcluster.Path) (int, error) {
+	return func(path logicalcluster.Path) (int, error) {
+		return nested[path.String()], nil
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/reconciler/kubequota"
+)
+
+// checkQuotas returns an error if a new child workspace of the given name in the given LogicalCluster
+// exceeds one of the nested workspace quotas of the LogicalCluster or of its ancestors. The quotas of
+// the LogicalCluster are taken from its ResourceQuotas, those of the ancestors from the
+// tenancyv1alpha1.LogicalClusterWorkspaceQuotaAnnotationKey annotation of their LogicalClusters, as
+// they are seen at the time of admission.
+func (o *workspace) checkQuotas(logicalCluster *corev1alpha1.LogicalCluster, name string) error {
+	parent := logicalClusterPath(logicalCluster)
+	path := parent.Join(name)
+
+	resourceQuotas, err := o.listResourceQuotas(logicalcluster.From(logicalCluster))
+	if err != nil {
+		return err
+	}
+	if err := o.checkQuota(path, parent, kubequota.WorkspaceQuotaFor(resourceQuotas)); err != nil {
+		return err
+	}
+
+	for current := parent; ; {
+		ancestor, ok := current.Parent()
+		if !ok || ancestor == current {
+			break
+		}
+		current = ancestor
+
+		logicalClusters, err := o.getGlobalLogicalClusters(ancestor)
+		if err != nil {
+			return err
+		}
+		for _, lc := range logicalClusters {
+			q, err := kubequota.WorkspaceQuotaFromLogicalCluster(lc)
+			if err != nil {
+				return err
+			}
+			if err := o.checkQuota(path, ancestor, q); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkQuota returns an error if a new workspace at the given path exceeds the quota of the given ancestor.
+func (o *workspace) checkQuota(path, ancestor logicalcluster.Path, q *kubequota.WorkspaceQuota) error {
+	if q == nil {
+		return nil
+	}
+	if q.WorkspaceDepth != nil {
+		if depth := int64(pathDepth(path) - pathDepth(ancestor)); depth > *q.WorkspaceDepth {
+			return fmt.Errorf("exceeded quota of workspace %s: %s=%d, but the workspace would be nested %d levels deep", ancestor, tenancyv1alpha1.ResourceWorkspaceDepth, *q.WorkspaceDepth, depth)
+		}
+	}
+	if q.NestedWorkspaces != nil {
+		nested, err := o.countNestedWorkspaces(ancestor)
+		if err != nil {
+			return err
+		}
+		if int64(nested) >= *q.NestedWorkspaces {
+			return fmt.Errorf("exceeded quota of workspace %s: %s=%d, but %d workspaces are nested below it", ancestor, tenancyv1alpha1.ResourceNestedWorkspaces, *q.NestedWorkspaces, nested)
+		}
+	}
+	return nil
+}
+
+// logicalClusterPath returns the canonical path of the LogicalCluster, falling back to its name.
+func logicalClusterPath(logicalCluster *corev1alpha1.LogicalCluster) logicalcluster.Path {
+	if path, found := logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey]; found {
+		return logicalcluster.NewPath(path)
+	}
+	return logicalcluster.From(logicalCluster).Path()
+}
+
+func pathDepth(path logicalcluster.Path) int {
+	return len(strings.Split(path.String(), ":"))
+}
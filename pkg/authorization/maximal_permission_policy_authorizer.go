@@ -132,10 +132,13 @@ func (a *MaximalPermissionPolicyAuthorizer) Authorize(ctx context.Context, attr
 		return authorizer.DecisionNoOpinion, MaximalPermissionPolicyAccessNotPermittedReason, fmt.Errorf("error getting APIBindings: %w", err)
 	}
 	var relevantBinding *apisv1alpha2.APIBinding
+	var relevantResource apisv1alpha2.BoundAPIResource
 	for _, binding := range bindings {
 		for _, br := range binding.Status.BoundResources {
-			if br.Group == attr.GetAPIGroup() && br.Resource == attr.GetResource() {
+			// Match by the group and resource the bound resource is served as in the workspace.
+			if gr := br.ServedGroupResource(); gr.Group == attr.GetAPIGroup() && gr.Resource == attr.GetResource() {
 				relevantBinding = binding
+				relevantResource = br
 				break
 			}
 		}
@@ -171,6 +174,9 @@ func (a *MaximalPermissionPolicyAuthorizer) Authorize(ctx context.Context, attr
 	clusterAuthorizer := a.newAuthorizer(logicalcluster.From(apiExport))
 	prefixedAttr := deepCopyAttributes(attr)
 	prefixedAttr.User = rbacregistryvalidation.PrefixUser(prefixedAttr.GetUser(), apisv1alpha1.MaximalPermissionPolicyRBACUserGroupPrefix)
+	// The policy of the APIExport refers to the resource as exported, not as renamed by the APIBinding.
+	prefixedAttr.APIGroup = relevantResource.Group
+	prefixedAttr.Resource = relevantResource.Resource
 	dec, reason, err := clusterAuthorizer.Authorize(ctx, prefixedAttr)
 	reason = fmt.Sprintf("API export %q|%q policy: %v", logicalcluster.From(apiExport), apiExport.Name, reason)
 	if err != nil {
@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
 	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
 	controlplaneapiserver "k8s.io/kubernetes/pkg/controlplane/apiserver"
@@ -42,6 +43,9 @@ const (
 	WorkspaceAccessNotPermittedReason = "workspace access not permitted"
 )
 
+// readOnlyVerbs are the verbs permitted in logical clusters that are read-only.
+var readOnlyVerbs = sets.New[string]("get", "list", "watch")
+
 func NewWorkspaceContentAuthorizer(localInformers, globalInformers kcpkubernetesinformers.SharedInformerFactory, localLogicalClusterLister, globalLogicalClusterLister corev1alpha1listers.LogicalClusterClusterLister) func(delegate authorizer.Authorizer) authorizer.Authorizer {
 	return func(delegate authorizer.Authorizer) authorizer.Authorizer {
 		return &workspaceContentAuthorizer{
@@ -109,7 +113,22 @@ func (a *workspaceContentAuthorizer) Authorize(ctx context.Context, attr authori
 		return authorizer.DecisionNoOpinion, "error getting LogicalCluster", err
 	}
 
-	if logicalCluster.Status.Phase != corev1alpha1.LogicalClusterPhaseInitializing && logicalCluster.Status.Phase != corev1alpha1.LogicalClusterPhaseReady {
+	switch logicalCluster.Status.Phase {
+	case corev1alpha1.LogicalClusterPhaseInitializing, corev1alpha1.LogicalClusterPhaseReady:
+	case corev1alpha1.LogicalClusterPhaseMigrating:
+		// the logical cluster is copied to another shard, changes would get lost.
+		if !readOnlyVerbs.Has(attr.GetVerb()) {
+			return authorizer.DecisionNoOpinion, fmt.Sprintf("verb %q not permitted due to phase %q", attr.GetVerb(), logicalCluster.Status.Phase), nil
+		}
+	case corev1alpha1.LogicalClusterPhaseHibernated:
+		// the workspace is suspended. Tell the user how to get it back.
+		if logicalCluster.Spec.Hibernation != corev1alpha1.LogicalClusterHibernationModeReadOnly {
+			return authorizer.DecisionNoOpinion, "workspace is hibernated, unset spec.hibernation of the Workspace to resume it", nil
+		}
+		if !readOnlyVerbs.Has(attr.GetVerb()) {
+			return authorizer.DecisionNoOpinion, fmt.Sprintf("verb %q not permitted, workspace is hibernated read-only, unset spec.hibernation of the Workspace to resume it", attr.GetVerb()), nil
+		}
+	default:
 		return authorizer.DecisionNoOpinion, fmt.Sprintf("not permitted due to phase %q", logicalCluster.Status.Phase), nil
 	}
 
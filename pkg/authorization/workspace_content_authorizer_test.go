@@ -70,6 +70,7 @@ func TestWorkspaceContentAuthorizer(t *testing.T) {
 		testName              string
 		requestedWorkspace    string
 		requestingUser        *user.DefaultInfo
+		verb                  string
 		wantReason, wantError string
 		wantDecision          authorizer.Decision
 		deepSARHeader         bool
@@ -182,6 +183,51 @@ func TestWorkspaceContentAuthorizer(t *testing.T) {
 			wantDecision:       authorizer.DecisionAllow,
 			wantReason:         "delegating due to local service account access",
 		},
+		{
+			testName: "permitted user can read a migrating workspace",
+
+			requestedWorkspace: "root:migrating",
+			requestingUser:     &user.DefaultInfo{Name: "user-access", Groups: []string{"system:authenticated"}},
+			verb:               "list",
+			wantDecision:       authorizer.DecisionAllow,
+			wantReason:         "delegating due to user logical cluster access",
+		},
+		{
+			testName: "permitted user cannot write to a migrating workspace",
+
+			requestedWorkspace: "root:migrating",
+			requestingUser:     &user.DefaultInfo{Name: "user-access", Groups: []string{"system:authenticated"}},
+			verb:               "create",
+			wantDecision:       authorizer.DecisionNoOpinion,
+			wantReason:         "verb \"create\" not permitted due to phase \"Migrating\"",
+		},
+		{
+			testName: "permitted user can read a read-only hibernated workspace",
+
+			requestedWorkspace: "root:hibernated-readonly",
+			requestingUser:     &user.DefaultInfo{Name: "user-access", Groups: []string{"system:authenticated"}},
+			verb:               "get",
+			wantDecision:       authorizer.DecisionAllow,
+			wantReason:         "delegating due to user logical cluster access",
+		},
+		{
+			testName: "permitted user cannot write to a read-only hibernated workspace",
+
+			requestedWorkspace: "root:hibernated-readonly",
+			requestingUser:     &user.DefaultInfo{Name: "user-access", Groups: []string{"system:authenticated"}},
+			verb:               "update",
+			wantDecision:       authorizer.DecisionNoOpinion,
+			wantReason:         "verb \"update\" not permitted, workspace is hibernated read-only, unset spec.hibernation of the Workspace to resume it",
+		},
+		{
+			testName: "permitted user cannot read a denied hibernated workspace",
+
+			requestedWorkspace: "root:hibernated-denied",
+			requestingUser:     &user.DefaultInfo{Name: "user-access", Groups: []string{"system:authenticated"}},
+			verb:               "get",
+			wantDecision:       authorizer.DecisionNoOpinion,
+			wantReason:         "workspace is hibernated, unset spec.hibernation of the Workspace to resume it",
+		},
 		{
 			testName: "system:kcp:logical-cluster-admin can always pass",
 
@@ -305,6 +351,66 @@ func TestWorkspaceContentAuthorizer(t *testing.T) {
 						Name:     "access",
 					},
 				},
+				&v1.ClusterRoleBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							logicalcluster.AnnotationKey: "root:migrating",
+						},
+						Name: "user-access:root:migrating:access",
+					},
+					Subjects: []v1.Subject{
+						{
+							Kind:     "User",
+							APIGroup: "rbac.authorization.k8s.io",
+							Name:     "user-access",
+						},
+					},
+					RoleRef: v1.RoleRef{
+						APIGroup: "rbac.authorization.k8s.io",
+						Kind:     "ClusterRole",
+						Name:     "access",
+					},
+				},
+				&v1.ClusterRoleBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							logicalcluster.AnnotationKey: "root:hibernated-readonly",
+						},
+						Name: "user-access:root:hibernated-readonly:access",
+					},
+					Subjects: []v1.Subject{
+						{
+							Kind:     "User",
+							APIGroup: "rbac.authorization.k8s.io",
+							Name:     "user-access",
+						},
+					},
+					RoleRef: v1.RoleRef{
+						APIGroup: "rbac.authorization.k8s.io",
+						Kind:     "ClusterRole",
+						Name:     "access",
+					},
+				},
+				&v1.ClusterRoleBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							logicalcluster.AnnotationKey: "root:hibernated-denied",
+						},
+						Name: "user-access:root:hibernated-denied:access",
+					},
+					Subjects: []v1.Subject{
+						{
+							Kind:     "User",
+							APIGroup: "rbac.authorization.k8s.io",
+							Name:     "user-access",
+						},
+					},
+					RoleRef: v1.RoleRef{
+						APIGroup: "rbac.authorization.k8s.io",
+						Kind:     "ClusterRole",
+						Name:     "access",
+					},
+				},
 				&v1.ClusterRoleBinding{
 					ObjectMeta: metav1.ObjectMeta{
 						Annotations: map[string]string{
@@ -358,6 +464,20 @@ func TestWorkspaceContentAuthorizer(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{Name: corev1alpha1.LogicalClusterName, Annotations: map[string]string{logicalcluster.AnnotationKey: "root:initializing"}},
 				Status:     corev1alpha1.LogicalClusterStatus{Phase: corev1alpha1.LogicalClusterPhaseInitializing},
 			}))
+			require.NoError(t, localIndexer.Add(&corev1alpha1.LogicalCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: corev1alpha1.LogicalClusterName, Annotations: map[string]string{logicalcluster.AnnotationKey: "root:migrating"}},
+				Status:     corev1alpha1.LogicalClusterStatus{Phase: corev1alpha1.LogicalClusterPhaseMigrating},
+			}))
+			require.NoError(t, localIndexer.Add(&corev1alpha1.LogicalCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: corev1alpha1.LogicalClusterName, Annotations: map[string]string{logicalcluster.AnnotationKey: "root:hibernated-readonly"}},
+				Spec:       corev1alpha1.LogicalClusterSpec{Hibernation: corev1alpha1.LogicalClusterHibernationModeReadOnly},
+				Status:     corev1alpha1.LogicalClusterStatus{Phase: corev1alpha1.LogicalClusterPhaseHibernated},
+			}))
+			require.NoError(t, localIndexer.Add(&corev1alpha1.LogicalCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: corev1alpha1.LogicalClusterName, Annotations: map[string]string{logicalcluster.AnnotationKey: "root:hibernated-denied"}},
+				Spec:       corev1alpha1.LogicalClusterSpec{Hibernation: corev1alpha1.LogicalClusterHibernationModeDenied},
+				Status:     corev1alpha1.LogicalClusterStatus{Phase: corev1alpha1.LogicalClusterPhaseHibernated},
+			}))
 			require.NoError(t, localIndexer.Add(&corev1alpha1.LogicalCluster{
 				ObjectMeta: metav1.ObjectMeta{Name: corev1alpha1.LogicalClusterName, Annotations: map[string]string{logicalcluster.AnnotationKey: "rootwithoutparent"}},
 				Status:     corev1alpha1.LogicalClusterStatus{Phase: corev1alpha1.LogicalClusterPhaseReady},
@@ -377,6 +497,7 @@ func TestWorkspaceContentAuthorizer(t *testing.T) {
 			ctx = request.WithCluster(ctx, requestedCluster)
 			attr := authorizer.AttributesRecord{
 				User: tt.requestingUser,
+				Verb: tt.verb,
 			}
 			if tt.deepSARHeader {
 				ctx = context.WithValue(ctx, deepSARKey, true)
@@ -0,0 +1,348 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conversion converts objects between the versions of an APIResourceSchema
+// following the rules of its APIConversion.
+package conversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/version"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"k8s.io/apiserver/pkg/cel/environment"
+
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+)
+
+// Converter converts objects between the versions of an APIResourceSchema.
+type Converter struct {
+	conversions map[versionPair]*compiledConversion
+}
+
+type versionPair struct {
+	from, to string
+}
+
+type compiledConversion struct {
+	rules    []compiledRule
+	preserve []fieldPath
+}
+
+type compiledRule struct {
+	field       fieldPath
+	destination fieldPath
+	// program is nil if the rule has no transformation.
+	program cel.Program
+}
+
+// fieldPath is a field path like ".spec.name.first", split into its segments.
+type fieldPath []string
+
+func (p fieldPath) String() string {
+	return "." + strings.Join(p, ".")
+}
+
+func (p fieldPath) isMetadata() bool {
+	return p[0] == "metadata"
+}
+
+// isLabelOrAnnotation returns true if the path points to a single label or annotation.
+func (p fieldPath) isLabelOrAnnotation() bool {
+	return len(p) == 3 && p[0] == "metadata" && (p[1] == "labels" || p[1] == "annotations")
+}
+
+// Compile compiles the rules of the APIConversion, including their CEL transformations.
+func Compile(apiConversion *apisv1alpha1.APIConversion) (*Converter, error) {
+	env, err := newEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Converter{conversions: map[versionPair]*compiledConversion{}}
+	var errs []error
+	for _, conversion := range apiConversion.Spec.Conversions {
+		compiled := &compiledConversion{}
+		for _, rule := range conversion.Rules {
+			field, err := parseFieldPath(rule.Field)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("conversion from %s to %s: invalid field: %w", conversion.From, conversion.To, err))
+				continue
+			}
+			destination, err := parseFieldPath(rule.Destination)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("conversion from %s to %s: invalid destination: %w", conversion.From, conversion.To, err))
+				continue
+			}
+			if destination.isMetadata() && !destination.isLabelOrAnnotation() {
+				errs = append(errs, fmt.Errorf("conversion from %s to %s: invalid destination: %q must not be under .metadata, except for .metadata.labels and .metadata.annotations", conversion.From, conversion.To, rule.Destination))
+				continue
+			}
+			compiledRule := compiledRule{field: field, destination: destination}
+			if rule.Transformation != "" {
+				ast, issues := env.Compile(rule.Transformation)
+				if issues != nil && issues.Err() != nil {
+					errs = append(errs, fmt.Errorf("conversion from %s to %s: invalid transformation for %s: %w", conversion.From, conversion.To, rule.Destination, issues.Err()))
+					continue
+				}
+				compiledRule.program, err = env.Program(ast, cel.CostLimit(celconfig.PerCallLimit))
+				if err != nil {
+					errs = append(errs, fmt.Errorf("conversion from %s to %s: invalid transformation for %s: %w", conversion.From, conversion.To, rule.Destination, err))
+					continue
+				}
+			}
+			compiled.rules = append(compiled.rules, compiledRule)
+		}
+		for _, preserve := range conversion.Preserve {
+			path, err := parseFieldPath(preserve)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("conversion from %s to %s: invalid preserved field: %w", conversion.From, conversion.To, err))
+				continue
+			}
+			if path.isMetadata() {
+				errs = append(errs, fmt.Errorf("conversion from %s to %s: invalid preserved field: %q must not be under .metadata", conversion.From, conversion.To, preserve))
+				continue
+			}
+			compiled.preserve = append(compiled.preserve, path)
+		}
+		c.conversions[versionPair{from: conversion.From, to: conversion.To}] = compiled
+	}
+	if len(errs) > 0 {
+		return nil, utilerrors.NewAggregate(errs)
+	}
+
+	return c, nil
+}
+
+func newEnv() (*cel.Env, error) {
+	envSet, err := environment.MustBaseEnvSet(environment.DefaultCompatibilityVersion(), true).Extend(
+		environment.VersionedOptions{
+			IntroducedVersion: version.MajorMinor(1, 0),
+			EnvOptions: []cel.EnvOption{
+				cel.Variable("self", cel.DynType),
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return envSet.Env(environment.StoredExpressions)
+}
+
+func parseFieldPath(path string) (fieldPath, error) {
+	if !strings.HasPrefix(path, ".") {
+		return nil, fmt.Errorf("%q must start with a dot", path)
+	}
+	segments := strings.Split(strings.TrimPrefix(path, "."), ".")
+	for _, s := range segments {
+		if s == "" {
+			return nil, fmt.Errorf("%q must not contain empty segments", path)
+		}
+	}
+	return segments, nil
+}
+
+// Convert converts a copy of the object to the given version. Fields that are not mentioned in
+// a rule are copied as they are, and dropped by pruning if they do not exist in the target version.
+//
+// The values of the preserved fields are stored in the apisv1alpha1.AnnotationPreservedFieldsKey
+// annotation, and restored when the object is converted back. Only the fields preserved by the
+// conversion in the opposite direction are restored, other values in the annotation are ignored.
+// The metadata of the object must not change, except for its labels and annotations.
+func (c *Converter) Convert(in *unstructured.Unstructured, targetGV schema.GroupVersion) (*unstructured.Unstructured, error) {
+	fromGV, err := schema.ParseGroupVersion(in.GetAPIVersion())
+	if err != nil {
+		return nil, err
+	}
+	out := in.DeepCopy()
+	out.SetAPIVersion(targetGV.String())
+	if fromGV.Version == targetGV.Version {
+		return out, nil
+	}
+
+	conversion, found := c.conversions[versionPair{from: fromGV.Version, to: targetGV.Version}]
+	if !found {
+		return nil, fmt.Errorf("no conversion from %s to %s", fromGV.Version, targetGV.Version)
+	}
+
+	for _, rule := range conversion.rules {
+		value, found, err := unstructured.NestedFieldNoCopy(in.Object, rule.field...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s: %w", rule.field, err)
+		}
+		if !found {
+			continue
+		}
+		if rule.program != nil {
+			result, _, err := rule.program.Eval(map[string]any{"self": value})
+			if err != nil {
+				return nil, fmt.Errorf("failed to transform %s: %w", rule.field, err)
+			}
+			if value, err = toUnstructured(result); err != nil {
+				return nil, fmt.Errorf("failed to transform %s: %w", rule.field, err)
+			}
+		} else {
+			value = runtime.DeepCopyJSONValue(value)
+		}
+		if err := unstructured.SetNestedField(out.Object, value, rule.destination...); err != nil {
+			return nil, fmt.Errorf("failed to set %s: %w", rule.destination, err)
+		}
+	}
+
+	// restore the fields preserved by the conversion in the opposite direction, and preserve the
+	// fields of this one. The annotation is user-controlled, hence only the fields the APIConversion
+	// asks to preserve are restored.
+	annotations := out.GetAnnotations()
+	if raw, found := annotations[apisv1alpha1.AnnotationPreservedFieldsKey]; found {
+		var preserved map[string]any
+		if err := json.Unmarshal([]byte(raw), &preserved); err != nil {
+			return nil, fmt.Errorf("failed to parse %s annotation: %w", apisv1alpha1.AnnotationPreservedFieldsKey, err)
+		}
+		if reverse, found := c.conversions[versionPair{from: targetGV.Version, to: fromGV.Version}]; found {
+			for _, path := range reverse.preserve {
+				value, found := preserved[path.String()]
+				if !found {
+					continue
+				}
+				if err := unstructured.SetNestedField(out.Object, fromJSON(value), path...); err != nil {
+					return nil, fmt.Errorf("failed to restore %s: %w", path, err)
+				}
+			}
+		}
+		delete(annotations, apisv1alpha1.AnnotationPreservedFieldsKey)
+	}
+	preserved := map[string]any{}
+	for _, path := range conversion.preserve {
+		if value, found, err := unstructured.NestedFieldNoCopy(in.Object, path...); err != nil {
+			return nil, fmt.Errorf("failed to get %s: %w", path, err)
+		} else if found {
+			preserved[path.String()] = value
+		}
+	}
+	if len(preserved) > 0 {
+		raw, err := json.Marshal(preserved)
+		if err != nil {
+			return nil, err
+		}
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[apisv1alpha1.AnnotationPreservedFieldsKey] = string(raw)
+	}
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	out.SetAnnotations(annotations)
+
+	if !equality.Semantic.DeepEqual(metadataWithoutLabelsAndAnnotations(in), metadataWithoutLabelsAndAnnotations(out)) {
+		return nil, fmt.Errorf("conversion from %s to %s must not change metadata other than labels and annotations", fromGV.Version, targetGV.Version)
+	}
+
+	return out, nil
+}
+
+func metadataWithoutLabelsAndAnnotations(obj *unstructured.Unstructured) map[string]any {
+	metadata, _ := obj.Object["metadata"].(map[string]any)
+	ret := make(map[string]any, len(metadata))
+	for k, v := range metadata {
+		if k != "labels" && k != "annotations" {
+			ret[k] = v
+		}
+	}
+	return ret
+}
+
+// toUnstructured converts the result of a CEL expression to an unstructured value.
+func toUnstructured(val ref.Val) (any, error) {
+	switch v := val.(type) {
+	case types.Null:
+		return nil, nil
+	case types.Bool:
+		return bool(v), nil
+	case types.Int:
+		return int64(v), nil
+	case types.Uint:
+		return int64(v), nil
+	case types.Double:
+		return float64(v), nil
+	case types.String:
+		return string(v), nil
+	case traits.Mapper:
+		out := map[string]any{}
+		it := v.Iterator()
+		for it.HasNext() == types.True {
+			key := it.Next()
+			k, ok := key.(types.String)
+			if !ok {
+				return nil, fmt.Errorf("unsupported map key type %s", key.Type())
+			}
+			value, err := toUnstructured(v.Get(key))
+			if err != nil {
+				return nil, err
+			}
+			out[string(k)] = value
+		}
+		return out, nil
+	case traits.Lister:
+		var out []any
+		it := v.Iterator()
+		for it.HasNext() == types.True {
+			value, err := toUnstructured(it.Next())
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, value)
+		}
+		return out, nil
+	case *types.Err:
+		return nil, v
+	}
+	return nil, fmt.Errorf("unsupported result type %s", val.Type())
+}
+
+// fromJSON converts a JSON-decoded value to an unstructured value, i.e. integral numbers to int64.
+func fromJSON(value any) any {
+	switch v := value.(type) {
+	case float64:
+		if v == float64(int64(v)) {
+			return int64(v)
+		}
+		return v
+	case map[string]any:
+		for k, e := range v {
+			v[k] = fromJSON(e)
+		}
+		return v
+	case []any:
+		for i, e := range v {
+			v[i] = fromJSON(e)
+		}
+		return v
+	}
+	return value
+}
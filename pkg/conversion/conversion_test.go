@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+)
+
+func newConversion() *apisv1alpha1.APIConversion {
+	return &apisv1alpha1.APIConversion{
+		ObjectMeta: metav1.ObjectMeta{Name: "v1.widgets.example.io"},
+		Spec: apisv1alpha1.APIConversionSpec{
+			Conversions: []apisv1alpha1.APIVersionConversion{
+				{
+					From: "v1",
+					To:   "v2",
+					Rules: []apisv1alpha1.APIConversionRule{
+						{Field: ".spec.firstName", Destination: ".spec.name.first"},
+						{Field: ".spec.lastName", Destination: ".spec.name.last", Transformation: "self.upperAscii()"},
+						{Field: ".spec.replicas", Destination: ".spec.scale", Transformation: "{'replicas': self * 2, 'min': 1}"},
+					},
+				},
+				{
+					From: "v2",
+					To:   "v1",
+					Rules: []apisv1alpha1.APIConversionRule{
+						{Field: ".spec.name.first", Destination: ".spec.firstName"},
+						{Field: ".spec.name.last", Destination: ".spec.lastName", Transformation: "self.lowerAscii()"},
+					},
+					Preserve: []string{".spec.color"},
+				},
+			},
+		},
+	}
+}
+
+func TestConvert(t *testing.T) {
+	c, err := Compile(newConversion())
+	require.NoError(t, err)
+
+	v1 := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "example.io/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]any{"name": "w"},
+		"spec": map[string]any{
+			"firstName": "jane",
+			"lastName":  "doe",
+			"replicas":  int64(3),
+		},
+	}}
+
+	v2, err := c.Convert(v1, schema.GroupVersion{Group: "example.io", Version: "v2"})
+	require.NoError(t, err)
+	require.Equal(t, "example.io/v2", v2.GetAPIVersion())
+	require.Equal(t, map[string]any{
+		"firstName": "jane",
+		"lastName":  "doe",
+		"replicas":  int64(3),
+		"name":      map[string]any{"first": "jane", "last": "DOE"},
+		"scale":     map[string]any{"replicas": int64(6), "min": int64(1)},
+	}, v2.Object["spec"], "unmapped fields are kept for pruning")
+	require.Equal(t, "jane", v1.Object["spec"].(map[string]any)["firstName"], "input must not be mutated")
+
+	// round-trip a field that only exists in v2.
+	require.NoError(t, unstructured.SetNestedField(v2.Object, "blue", "spec", "color"))
+	back, err := c.Convert(v2, schema.GroupVersion{Group: "example.io", Version: "v1"})
+	require.NoError(t, err)
+	require.Equal(t, "doe", back.Object["spec"].(map[string]any)["lastName"])
+	require.Equal(t, `{".spec.color":"blue"}`, back.GetAnnotations()[apisv1alpha1.AnnotationPreservedFieldsKey])
+
+	unstructured.RemoveNestedField(back.Object, "spec", "color")
+	again, err := c.Convert(back, schema.GroupVersion{Group: "example.io", Version: "v2"})
+	require.NoError(t, err)
+	color, _, _ := unstructured.NestedString(again.Object, "spec", "color")
+	require.Equal(t, "blue", color)
+	require.Empty(t, again.GetAnnotations())
+
+	// a crafted annotation restores neither metadata nor fields that are not preserved.
+	crafted := back.DeepCopy()
+	crafted.SetAnnotations(map[string]string{
+		apisv1alpha1.AnnotationPreservedFieldsKey: `{".metadata.name":"other",".metadata.namespace":"kube-system",".spec.owner":"mallory"}`,
+	})
+	again, err = c.Convert(crafted, schema.GroupVersion{Group: "example.io", Version: "v2"})
+	require.NoError(t, err)
+	require.Equal(t, "w", again.GetName())
+	require.Empty(t, again.GetNamespace())
+	_, found, _ := unstructured.NestedFieldNoCopy(again.Object, "spec", "owner")
+	require.False(t, found, "fields that are not preserved must not be restored")
+	require.Empty(t, again.GetAnnotations())
+
+	// converting to the same version changes nothing.
+	same, err := c.Convert(v1, schema.GroupVersion{Group: "example.io", Version: "v1"})
+	require.NoError(t, err)
+	require.Equal(t, v1, same)
+
+	_, err = c.Convert(v1, schema.GroupVersion{Group: "example.io", Version: "v3"})
+	require.ErrorContains(t, err, "no conversion from v1 to v3")
+}
+
+func TestCompileErrors(t *testing.T) {
+	conversion := newConversion()
+	conversion.Spec.Conversions[0].Rules = append(conversion.Spec.Conversions[0].Rules,
+		apisv1alpha1.APIConversionRule{Field: "spec.foo", Destination: ".spec.foo"},
+		apisv1alpha1.APIConversionRule{Field: ".spec.bar", Destination: ".spec..bar"},
+		apisv1alpha1.APIConversionRule{Field: ".spec.baz", Destination: ".spec.baz", Transformation: "self +"},
+	)
+
+	conversion.Spec.Conversions[0].Rules = append(conversion.Spec.Conversions[0].Rules,
+		apisv1alpha1.APIConversionRule{Field: ".spec.name", Destination: ".metadata.name"},
+		apisv1alpha1.APIConversionRule{Field: ".spec.team", Destination: ".metadata.labels.team"},
+	)
+	conversion.Spec.Conversions[1].Preserve = append(conversion.Spec.Conversions[1].Preserve, ".metadata.labels")
+
+	_, err := Compile(conversion)
+	require.ErrorContains(t, err, `invalid field: "spec.foo" must start with a dot`)
+	require.ErrorContains(t, err, `invalid destination: ".metadata.name" must not be under .metadata`)
+	require.NotContains(t, err.Error(), ".metadata.labels.team")
+	require.ErrorContains(t, err, `invalid preserved field: ".metadata.labels" must not be under .metadata`)
+	require.ErrorContains(t, err, `invalid destination: ".spec..bar" must not contain empty segments`)
+	require.ErrorContains(t, err, "invalid transformation for .spec.baz")
+}
+
+func TestConvertRejectsMetadataChanges(t *testing.T) {
+	c := &Converter{conversions: map[versionPair]*compiledConversion{
+		{from: "v1", to: "v2"}: {rules: []compiledRule{
+			{field: fieldPath{"spec", "name"}, destination: fieldPath{"metadata", "name"}},
+		}},
+	}}
+
+	_, err := c.Convert(&unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "example.io/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]any{"name": "w"},
+		"spec":       map[string]any{"name": "other"},
+	}}, schema.GroupVersion{Group: "example.io", Version: "v2"})
+	require.ErrorContains(t, err, "must not change metadata other than labels and annotations")
+}
@@ -48,6 +48,10 @@ type Result struct {
 	// ErrorCode is the HTTP error code to return for the request.
 	// If this is set, the URL and Shard fields are ignored.
 	ErrorCode int
+
+	// MovedTo is set if the path has been resolved through a workspace that
+	// has been moved. It is the path the request is served from.
+	MovedTo logicalcluster.Path
 }
 
 // PathRewriter can rewrite a logical cluster path before the actual mapping through
@@ -73,8 +77,13 @@ type State struct {
 	shardClusterWorkspaceMount map[string]map[logicalcluster.Name]map[string]tenancyv1alpha1.WorkspaceSpec // (shard name, logical cluster, workspace name) -> WorkspaceSpec
 
 	shardClusterWorkspaceNameErrorCode map[string]map[logicalcluster.Name]map[string]int // (shard name, logical cluster, workspace name) -> error code
+
+	shardClusterWorkspaceNameMovedTo map[string]map[logicalcluster.Name]map[string]logicalcluster.Path // (shard name, logical cluster, workspace name) -> path of the moved workspace
 }
 
+// maxRedirects is the number of moved workspaces a lookup follows, protecting against cycles.
+const maxRedirects = 10
+
 func New(rewriters []PathRewriter) *State {
 	return &State{
 		rewriters: rewriters,
@@ -93,6 +102,10 @@ func New(rewriters []PathRewriter) *State {
 		// shardClusterWorkspaceNameErrorCode is a map of shar,logical cluster, workspace to error code when we want to return an error code
 		// instead of a URL.
 		shardClusterWorkspaceNameErrorCode: map[string]map[logicalcluster.Name]map[string]int{},
+
+		// shardClusterWorkspaceNameMovedTo holds workspaces that have been moved to another path,
+		// which is served instead until the old workspace is deleted.
+		shardClusterWorkspaceNameMovedTo: map[string]map[logicalcluster.Name]map[string]logicalcluster.Path{},
 	}
 }
 
@@ -106,6 +119,20 @@ func (c *State) UpsertWorkspace(shard string, ws *tenancyv1alpha1.Workspace) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
+	// A moved workspace does not own its logical cluster anymore. Its path is redirected to the new one.
+	if movedTo, found := ws.Annotations[tenancyv1alpha1.WorkspaceMovedToAnnotationKey]; found {
+		if c.shardClusterWorkspaceNameMovedTo[shard] == nil {
+			c.shardClusterWorkspaceNameMovedTo[shard] = map[logicalcluster.Name]map[string]logicalcluster.Path{}
+		}
+		if c.shardClusterWorkspaceNameMovedTo[shard][clusterName] == nil {
+			c.shardClusterWorkspaceNameMovedTo[shard][clusterName] = map[string]logicalcluster.Path{}
+		}
+		c.shardClusterWorkspaceNameMovedTo[shard][clusterName][ws.Name] = logicalcluster.NewPath(movedTo)
+		c.deleteWorkspaceCluster(shard, clusterName, ws)
+		clustersOnShard.WithLabelValues(shard).Set(float64(len(c.shardClusterWorkspaceName[shard])))
+		return
+	}
+
 	// If the workspace is unavailable, we set custom error code for it. And add it to the index as normal.
 	// TODO(mjudeikis): Once we have one more case - move to a separate function.
 	if ws.Status.Phase == corev1alpha1.LogicalClusterPhaseUnavailable {
@@ -164,34 +191,25 @@ func (c *State) DeleteWorkspace(shard string, ws *tenancyv1alpha1.Workspace) {
 	c.lock.RLock()
 	_, foundCluster := c.shardClusterWorkspaceNameCluster[shard][clusterName][ws.Name]
 	_, foundMount := c.shardClusterWorkspaceMount[shard][clusterName][ws.Name]
+	_, foundMovedTo := c.shardClusterWorkspaceNameMovedTo[shard][clusterName][ws.Name]
 	c.lock.RUnlock()
 
-	if !foundCluster && !foundMount {
+	if !foundCluster && !foundMount && !foundMovedTo {
 		return
 	}
 
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if _, foundCluster = c.shardClusterWorkspaceNameCluster[shard][clusterName][ws.Name]; foundCluster {
-		delete(c.shardClusterWorkspaceNameCluster[shard][clusterName], ws.Name)
-		if len(c.shardClusterWorkspaceNameCluster[shard][clusterName]) == 0 {
-			delete(c.shardClusterWorkspaceNameCluster[shard], clusterName)
-		}
-		if len(c.shardClusterWorkspaceNameCluster[shard]) == 0 {
-			delete(c.shardClusterWorkspaceNameCluster, shard)
-		}
-
-		cluster := logicalcluster.Name(ws.Spec.Cluster)
+	c.deleteWorkspaceCluster(shard, clusterName, ws)
 
-		delete(c.shardClusterWorkspaceName[shard], cluster)
-		if len(c.shardClusterWorkspaceName[shard]) == 0 {
-			delete(c.shardClusterWorkspaceName, shard)
-		}
-
-		delete(c.shardClusterParentCluster[shard], cluster)
-		if len(c.shardClusterParentCluster[shard]) == 0 {
-			delete(c.shardClusterParentCluster, shard)
+	if _, foundMovedTo = c.shardClusterWorkspaceNameMovedTo[shard][clusterName][ws.Name]; foundMovedTo {
+		delete(c.shardClusterWorkspaceNameMovedTo[shard][clusterName], ws.Name)
+		if len(c.shardClusterWorkspaceNameMovedTo[shard][clusterName]) == 0 {
+			delete(c.shardClusterWorkspaceNameMovedTo[shard], clusterName)
+			if len(c.shardClusterWorkspaceNameMovedTo[shard]) == 0 {
+				delete(c.shardClusterWorkspaceNameMovedTo, shard)
+			}
 		}
 	}
 
@@ -216,13 +234,61 @@ func (c *State) DeleteWorkspace(shard string, ws *tenancyv1alpha1.Workspace) {
 	clustersOnShard.WithLabelValues(shard).Set(float64(len(c.shardClusterWorkspaceName[shard])))
 }
 
+// deleteWorkspaceCluster removes the mapping of the workspace to its logical cluster. The reverse
+// mappings are only removed if they still point to the workspace, as a logical cluster is referenced
+// by two workspaces during a move. The caller must hold the write lock.
+func (c *State) deleteWorkspaceCluster(shard string, clusterName logicalcluster.Name, ws *tenancyv1alpha1.Workspace) {
+	if _, found := c.shardClusterWorkspaceNameCluster[shard][clusterName][ws.Name]; !found {
+		return
+	}
+
+	delete(c.shardClusterWorkspaceNameCluster[shard][clusterName], ws.Name)
+	if len(c.shardClusterWorkspaceNameCluster[shard][clusterName]) == 0 {
+		delete(c.shardClusterWorkspaceNameCluster[shard], clusterName)
+	}
+	if len(c.shardClusterWorkspaceNameCluster[shard]) == 0 {
+		delete(c.shardClusterWorkspaceNameCluster, shard)
+	}
+
+	cluster := logicalcluster.Name(ws.Spec.Cluster)
+	if c.shardClusterParentCluster[shard][cluster] != clusterName || c.shardClusterWorkspaceName[shard][cluster] != ws.Name {
+		return
+	}
+
+	delete(c.shardClusterWorkspaceName[shard], cluster)
+	if len(c.shardClusterWorkspaceName[shard]) == 0 {
+		delete(c.shardClusterWorkspaceName, shard)
+	}
+
+	delete(c.shardClusterParentCluster[shard], cluster)
+	if len(c.shardClusterParentCluster[shard]) == 0 {
+		delete(c.shardClusterParentCluster, shard)
+	}
+}
+
 func (c *State) UpsertLogicalCluster(shard string, logicalCluster *corev1alpha1.LogicalCluster) {
+	// A LogicalCluster that is still being copied to this shard is not served from here
+	// yet. The entry flips over to this shard when the migration annotation is removed.
+	if _, migrating := logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterMigrationSourceAnnotationKey]; migrating {
+		return
+	}
+	// A LogicalCluster that has been migrated away from this shard is only updated and
+	// deleted here anymore, and must not take the entry back from the target shard.
+	if _, migrated := logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterMigratedToAnnotationKey]; migrated {
+		return
+	}
+
 	clusterName := logicalcluster.From(logicalCluster)
 
 	c.lock.RLock()
 	got := c.clusterShards[clusterName]
 	c.lock.RUnlock()
 
+	// A LogicalCluster being deleted never takes over the entry from another shard.
+	if got != "" && got != shard && !logicalCluster.DeletionTimestamp.IsZero() {
+		return
+	}
+
 	if got != shard {
 		c.lock.Lock()
 		defer c.lock.Unlock()
@@ -286,6 +352,7 @@ func (c *State) DeleteShard(shardName string) {
 	delete(c.shardClusterWorkspaceType, shardName)
 	delete(c.shardClusterParentCluster, shardName)
 	delete(c.shardClusterWorkspaceNameErrorCode, shardName)
+	delete(c.shardClusterWorkspaceNameMovedTo, shardName)
 
 	clustersOnShard.DeleteLabelValues(shardName)
 }
@@ -300,6 +367,11 @@ func (c *State) Lookup(path logicalcluster.Path) (Result, bool) {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
 
+	return c.lookup(segments, 0)
+}
+
+// lookup resolves the path segments. The caller must hold the read lock.
+func (c *State) lookup(segments []string, redirects int) (Result, bool) {
 	var (
 		shard     string
 		cluster   logicalcluster.Name
@@ -319,6 +391,21 @@ func (c *State) Lookup(path logicalcluster.Path) (Result, bool) {
 			continue
 		}
 
+		if movedTo, found := c.shardClusterWorkspaceNameMovedTo[shard][cluster][s]; found {
+			if redirects >= maxRedirects {
+				return Result{}, false
+			}
+			redirected := append(strings.Split(movedTo.String(), ":"), segments[i+1:]...)
+			for _, rewriter := range c.rewriters {
+				redirected = rewriter(redirected)
+			}
+			result, found := c.lookup(redirected, redirects+1)
+			if found && result.MovedTo.Empty() {
+				result.MovedTo = logicalcluster.NewPath(strings.Join(redirected, ":"))
+			}
+			return result, found
+		}
+
 		if ec, found := c.shardClusterWorkspaceNameErrorCode[shard][cluster][s]; found {
 			errorCode = ec
 		}
@@ -380,5 +467,6 @@ func (c *State) LookupURL(path logicalcluster.Path) (Result, bool) {
 		Cluster: result.Cluster,
 		Type:    result.Type,
 		URL:     strings.TrimSuffix(baseURL, "/") + result.Cluster.Path().RequestPath(),
+		MovedTo: result.MovedTo,
 	}, true
 }
@@ -441,6 +441,124 @@ func TestUpsertLogicalCluster(t *testing.T) {
 	validateLookupOutput(t, logicalcluster.NewPath("root:org"), r.Shard, r.Cluster, r.URL, found, "amber", "34", "", true)
 }
 
+func TestUpsertMigratingLogicalCluster(t *testing.T) {
+	target := New(nil)
+
+	target.UpsertShard("root", "https://root.io")
+	target.UpsertShard("amber", "https://amber.io")
+	target.UpsertWorkspace("root", newWorkspace("org", "root", "34"))
+	target.UpsertLogicalCluster("root", newLogicalCluster("root"))
+	target.UpsertLogicalCluster("root", newLogicalCluster("34"))
+
+	// the copy on the target shard is ignored while it is being migrated
+	copied := newLogicalCluster("34")
+	copied.Annotations[tenancyv1alpha1.LogicalClusterMigrationSourceAnnotationKey] = "root"
+	target.UpsertLogicalCluster("amber", copied)
+	r, found := target.Lookup(logicalcluster.NewPath("root:org"))
+	validateLookupOutput(t, logicalcluster.NewPath("root:org"), r.Shard, r.Cluster, r.URL, found, "root", "34", "", true)
+
+	// the entry flips when the migration annotation is removed
+	target.UpsertLogicalCluster("amber", newLogicalCluster("34"))
+	r, found = target.Lookup(logicalcluster.NewPath("root:org"))
+	validateLookupOutput(t, logicalcluster.NewPath("root:org"), r.Shard, r.Cluster, r.URL, found, "amber", "34", "", true)
+
+	// cleaning up the source does not remove the entry
+	target.DeleteLogicalCluster("root", newLogicalCluster("34"))
+	r, found = target.Lookup(logicalcluster.NewPath("root:org"))
+	validateLookupOutput(t, logicalcluster.NewPath("root:org"), r.Shard, r.Cluster, r.URL, found, "amber", "34", "", true)
+}
+
+func TestUpsertMigratedSourceLogicalCluster(t *testing.T) {
+	target := New(nil)
+
+	target.UpsertShard("root", "https://root.io")
+	target.UpsertShard("amber", "https://amber.io")
+	target.UpsertWorkspace("root", newWorkspace("org", "root", "34"))
+	target.UpsertLogicalCluster("root", newLogicalCluster("root"))
+	target.UpsertLogicalCluster("root", newLogicalCluster("34"))
+
+	// the source is annotated before the flip
+	source := newLogicalCluster("34")
+	source.Annotations[tenancyv1alpha1.LogicalClusterMigratedToAnnotationKey] = "amber"
+	target.UpsertLogicalCluster("root", source)
+	r, found := target.Lookup(logicalcluster.NewPath("root:org"))
+	validateLookupOutput(t, logicalcluster.NewPath("root:org"), r.Shard, r.Cluster, r.URL, found, "root", "34", "", true)
+
+	target.UpsertLogicalCluster("amber", newLogicalCluster("34"))
+	r, found = target.Lookup(logicalcluster.NewPath("root:org"))
+	validateLookupOutput(t, logicalcluster.NewPath("root:org"), r.Shard, r.Cluster, r.URL, found, "amber", "34", "", true)
+
+	// updating the source after the flip, e.g. when its terminators are cleared, does not flip back
+	target.UpsertLogicalCluster("root", source)
+	r, found = target.Lookup(logicalcluster.NewPath("root:org"))
+	validateLookupOutput(t, logicalcluster.NewPath("root:org"), r.Shard, r.Cluster, r.URL, found, "amber", "34", "", true)
+
+	// neither does an update of the source while it is deleted
+	deleting := newLogicalCluster("34")
+	now := metav1.Now()
+	deleting.DeletionTimestamp = &now
+	target.UpsertLogicalCluster("root", deleting)
+	r, found = target.Lookup(logicalcluster.NewPath("root:org"))
+	validateLookupOutput(t, logicalcluster.NewPath("root:org"), r.Shard, r.Cluster, r.URL, found, "amber", "34", "", true)
+
+	// and the deletion of the source keeps the entry
+	target.DeleteLogicalCluster("root", source)
+	r, found = target.Lookup(logicalcluster.NewPath("root:org"))
+	validateLookupOutput(t, logicalcluster.NewPath("root:org"), r.Shard, r.Cluster, r.URL, found, "amber", "34", "", true)
+}
+
+func TestMovedWorkspace(t *testing.T) {
+	target := New(nil)
+
+	target.UpsertShard("root", "https://root.io")
+	target.UpsertWorkspace("root", newWorkspace("org1", "root", "o1"))
+	target.UpsertWorkspace("root", newWorkspace("org2", "root", "o2"))
+	target.UpsertWorkspace("root", newWorkspace("team", "o1", "34"))
+	target.UpsertWorkspace("root", newWorkspace("sub", "34", "56"))
+	for _, cluster := range []string{"root", "o1", "o2", "34", "56"} {
+		target.UpsertLogicalCluster("root", newLogicalCluster(cluster))
+	}
+
+	// the new workspace takes over the logical cluster, the old one redirects to it
+	target.UpsertWorkspace("root", newWorkspace("team", "o2", "34"))
+	moved := newWorkspace("team", "o1", "34")
+	moved.Annotations[tenancyv1alpha1.WorkspaceMovedToAnnotationKey] = "root:org2:team"
+	target.UpsertWorkspace("root", moved)
+
+	for path, expected := range map[string]struct {
+		cluster logicalcluster.Name
+		movedTo logicalcluster.Path
+	}{
+		"root:org2:team":     {cluster: "34"},
+		"root:org2:team:sub": {cluster: "56"},
+		"root:org1:team":     {cluster: "34", movedTo: logicalcluster.NewPath("root:org2:team")},
+		"root:org1:team:sub": {cluster: "56", movedTo: logicalcluster.NewPath("root:org2:team:sub")},
+	} {
+		r, found := target.Lookup(logicalcluster.NewPath(path))
+		validateLookupOutput(t, logicalcluster.NewPath(path), r.Shard, r.Cluster, r.URL, found, "root", expected.cluster, "", true)
+		if r.MovedTo != expected.movedTo {
+			t.Fatalf("unexpected movedTo = %v, expected = %v, for %q path", r.MovedTo, expected.movedTo, path)
+		}
+	}
+
+	// deleting the old workspace ends the redirect, but keeps the new path
+	target.DeleteWorkspace("root", moved)
+
+	r, found := target.Lookup(logicalcluster.NewPath("root:org1:team"))
+	validateLookupOutput(t, logicalcluster.NewPath("root:org1:team"), r.Shard, r.Cluster, r.URL, found, "", "", "", false)
+
+	r, found = target.Lookup(logicalcluster.NewPath("root:org2:team:sub"))
+	validateLookupOutput(t, logicalcluster.NewPath("root:org2:team:sub"), r.Shard, r.Cluster, r.URL, found, "root", "56", "", true)
+
+	// redirect cycles are not followed forever
+	loop := newWorkspace("loop", "o1", "")
+	loop.Annotations[tenancyv1alpha1.WorkspaceMovedToAnnotationKey] = "root:org1:loop"
+	target.UpsertWorkspace("root", loop)
+
+	r, found = target.Lookup(logicalcluster.NewPath("root:org1:loop"))
+	validateLookupOutput(t, logicalcluster.NewPath("root:org1:loop"), r.Shard, r.Cluster, r.URL, found, "", "", "", false)
+}
+
 // Since LookupURL uses Lookup method the following test is just a smoke tests.
 func TestLookupURL(t *testing.T) {
 	target := New(nil)
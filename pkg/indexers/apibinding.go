@@ -115,6 +115,28 @@ func APIBindingBoundResourceValue(clusterName logicalcluster.Name, group, resour
 	return fmt.Sprintf("%s|%s.%s", clusterName, resource, group)
 }
 
+const APIBindingByServedResources = "byServedResources"
+
+// IndexAPIBindingByServedResources indexes the APIBindings by the group and resource their renamed bound
+// resources are served as.
+func IndexAPIBindingByServedResources(obj interface{}) ([]string, error) {
+	apiBinding, ok := obj.(*apisv1alpha2.APIBinding)
+	if !ok {
+		return []string{}, fmt.Errorf("obj %T is not an APIBinding", obj)
+	}
+
+	clusterName := logicalcluster.From(apiBinding)
+
+	var ret []string
+	for _, r := range apiBinding.Status.BoundResources {
+		if r.ServedAs != nil {
+			ret = append(ret, APIBindingBoundResourceValue(clusterName, r.ServedAs.Group, r.ServedAs.Resource))
+		}
+	}
+
+	return ret, nil
+}
+
 const APIBindingsByAPIExport = "APIBindingByAPIExport"
 
 // IndexAPIBindingByAPIExport indexes the APIBindings by their APIExport's Reference Path and Name.
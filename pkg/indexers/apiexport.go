@@ -38,6 +38,8 @@ const (
 	APIExportByClaimedIdentities = "APIExportByClaimedIdentities"
 	// APIExportEndpointSliceByAPIExport is the indexer name for retrieving APIExportEndpointSlices by their APIExport's Reference Path and Name.
 	APIExportEndpointSliceByAPIExport = "APIExportEndpointSliceByAPIExport"
+	// APIDeploymentByAPIExport is the indexer name for retrieving APIDeployments by their APIExport's cluster and name.
+	APIDeploymentByAPIExport = "APIDeploymentByAPIExport"
 
 	APIExportByVirtualResourceIdentities       = "APIExportByVirtualResourceIdentities"
 	APIExportByVirtualResourceIdentitiesAndGRs = "APIExportByVirtualResourceIdentitiesAndGRs"
@@ -101,6 +103,16 @@ func IndexAPIExportEndpointSliceByAPIExport(obj interface{}) ([]string, error) {
 	return result, nil
 }
 
+// IndexAPIDeploymentByAPIExport indexes the APIDeployments by their APIExport's cluster and name.
+func IndexAPIDeploymentByAPIExport(obj interface{}) ([]string, error) {
+	apiDeployment, ok := obj.(*apisv1alpha2.APIDeployment)
+	if !ok {
+		return []string{}, fmt.Errorf("obj %T is not an APIDeployment", obj)
+	}
+
+	return []string{logicalcluster.From(apiDeployment).Path().Join(apiDeployment.Spec.ExportName).String()}, nil
+}
+
 // IndexAPIExportByVirtualResourceIdentities is an index function that indexes an APIExport by its
 // exported resources' virtual storage identity.
 func IndexAPIExportByVirtualResourceIdentities(obj interface{}) ([]string, error) {
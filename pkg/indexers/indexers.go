@@ -37,6 +37,8 @@ const (
 	ByLogicalClusterPath = "ByLogicalClusterPath"
 	// ByLogicalClusterPathAndName indexes by logical cluster path and object name, if the annotation exists.
 	ByLogicalClusterPathAndName = "ByLogicalClusterPathAndName"
+	// ByLogicalClusterAncestorPath indexes by the paths of all ancestors of the logical cluster, if the path annotation exists.
+	ByLogicalClusterAncestorPath = "ByLogicalClusterAncestorPath"
 )
 
 // IndexByLogicalClusterPath indexes by logical cluster path, if the annotation exists.
@@ -55,6 +57,30 @@ func IndexByLogicalClusterPath(obj interface{}) ([]string, error) {
 	return []string{logicalcluster.From(metaObj).String()}, nil
 }
 
+// IndexByLogicalClusterAncestorPath indexes by the paths of all ancestors of the logical cluster, if the
+// path annotation exists. Looking up a path returns all logical clusters nested below it, at any depth.
+func IndexByLogicalClusterAncestorPath(obj interface{}) ([]string, error) {
+	metaObj, ok := obj.(metav1.Object)
+	if !ok {
+		return []string{}, fmt.Errorf("obj is supposed to be a metav1.Object, but is %T", obj)
+	}
+	path, found := metaObj.GetAnnotations()[core.LogicalClusterPathAnnotationKey]
+	if !found {
+		return []string{}, nil
+	}
+
+	var ancestors []string
+	for current := logicalcluster.NewPath(path); ; {
+		parent, ok := current.Parent()
+		if !ok || parent == current {
+			break
+		}
+		ancestors = append(ancestors, parent.String())
+		current = parent
+	}
+	return ancestors, nil
+}
+
 // IndexByLogicalClusterPathAndName indexes by logical cluster path and object name, if the annotation exists.
 func IndexByLogicalClusterPathAndName(obj interface{}) ([]string, error) {
 	metaObj, ok := obj.(metav1.Object)
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package indexers
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+)
+
+func TestIndexByLogicalClusterAncestorPath(t *testing.T) {
+	tests := map[string]struct {
+		obj     interface{}
+		want    []string
+		wantErr bool
+	}{
+		"not an object": {
+			obj:     "not an object",
+			want:    []string{},
+			wantErr: true,
+		},
+		"no path annotation": {
+			obj:  &corev1alpha1.LogicalCluster{},
+			want: []string{},
+		},
+		"root": {
+			obj:  withPath("root"),
+			want: nil,
+		},
+		"nested": {
+			obj:  withPath("root:org:team"),
+			want: []string{"root:org", "root"},
+		},
+		"system cluster": {
+			obj:  withPath("system:admin"),
+			want: nil,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := IndexByLogicalClusterAncestorPath(tt.obj)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IndexByLogicalClusterAncestorPath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IndexByLogicalClusterAncestorPath() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func withPath(path string) *corev1alpha1.LogicalCluster {
+	return &corev1alpha1.LogicalCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{core.LogicalClusterPathAnnotationKey: path},
+		},
+	}
+}
@@ -70,8 +70,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIBindingList":                              schema_sdk_apis_apis_v1alpha2_APIBindingList(ref),
 		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIBindingSpec":                              schema_sdk_apis_apis_v1alpha2_APIBindingSpec(ref),
 		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIBindingStatus":                            schema_sdk_apis_apis_v1alpha2_APIBindingStatus(ref),
+		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIDeployment":                               schema_sdk_apis_apis_v1alpha2_APIDeployment(ref),
+		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIDeploymentBindingStatus":                  schema_sdk_apis_apis_v1alpha2_APIDeploymentBindingStatus(ref),
+		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIDeploymentList":                           schema_sdk_apis_apis_v1alpha2_APIDeploymentList(ref),
+		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIDeploymentSpec":                           schema_sdk_apis_apis_v1alpha2_APIDeploymentSpec(ref),
+		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIDeploymentStatus":                         schema_sdk_apis_apis_v1alpha2_APIDeploymentStatus(ref),
+		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIDeploymentWave":                           schema_sdk_apis_apis_v1alpha2_APIDeploymentWave(ref),
 		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExport":                                   schema_sdk_apis_apis_v1alpha2_APIExport(ref),
+		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExportChannel":                            schema_sdk_apis_apis_v1alpha2_APIExportChannel(ref),
+		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExportDependency":                         schema_sdk_apis_apis_v1alpha2_APIExportDependency(ref),
 		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExportList":                               schema_sdk_apis_apis_v1alpha2_APIExportList(ref),
+		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExportRelease":                            schema_sdk_apis_apis_v1alpha2_APIExportRelease(ref),
 		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExportSpec":                               schema_sdk_apis_apis_v1alpha2_APIExportSpec(ref),
 		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExportStatus":                             schema_sdk_apis_apis_v1alpha2_APIExportStatus(ref),
 		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.AcceptablePermissionClaim":                   schema_sdk_apis_apis_v1alpha2_AcceptablePermissionClaim(ref),
@@ -85,6 +94,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.MaximalPermissionPolicy":                     schema_sdk_apis_apis_v1alpha2_MaximalPermissionPolicy(ref),
 		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.PermissionClaim":                             schema_sdk_apis_apis_v1alpha2_PermissionClaim(ref),
 		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.PermissionClaimSelector":                     schema_sdk_apis_apis_v1alpha2_PermissionClaimSelector(ref),
+		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.ResourceRename":                              schema_sdk_apis_apis_v1alpha2_ResourceRename(ref),
 		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.ResourceSchema":                              schema_sdk_apis_apis_v1alpha2_ResourceSchema(ref),
 		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.ResourceSchemaStorage":                       schema_sdk_apis_apis_v1alpha2_ResourceSchemaStorage(ref),
 		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.ResourceSchemaStorageCRD":                    schema_sdk_apis_apis_v1alpha2_ResourceSchemaStorageCRD(ref),
@@ -92,6 +102,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.ResourceSelector":                            schema_sdk_apis_apis_v1alpha2_ResourceSelector(ref),
 		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.ScopedPermissionClaim":                       schema_sdk_apis_apis_v1alpha2_ScopedPermissionClaim(ref),
 		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.VirtualWorkspace":                            schema_sdk_apis_apis_v1alpha2_VirtualWorkspace(ref),
+		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.boundAPIResourceServedAs":                    schema_sdk_apis_apis_v1alpha2_boundAPIResourceServedAs(ref),
+		"github.com/kcp-dev/sdk/apis/apis/v1alpha2.exportBindingReleaseReference":               schema_sdk_apis_apis_v1alpha2_exportBindingReleaseReference(ref),
 		"github.com/kcp-dev/sdk/apis/cache/v1alpha1.CachedObject":                               schema_sdk_apis_cache_v1alpha1_CachedObject(ref),
 		"github.com/kcp-dev/sdk/apis/cache/v1alpha1.CachedObjectList":                           schema_sdk_apis_cache_v1alpha1_CachedObjectList(ref),
 		"github.com/kcp-dev/sdk/apis/cache/v1alpha1.CachedObjectSpec":                           schema_sdk_apis_cache_v1alpha1_CachedObjectSpec(ref),
@@ -125,19 +137,24 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.ExtraMapping":                             schema_sdk_apis_tenancy_v1alpha1_ExtraMapping(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.Issuer":                                   schema_sdk_apis_tenancy_v1alpha1_Issuer(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.JWTAuthenticator":                         schema_sdk_apis_tenancy_v1alpha1_JWTAuthenticator(ref),
+		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.LimitRangeTemplate":                       schema_sdk_apis_tenancy_v1alpha1_LimitRangeTemplate(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.Mount":                                    schema_sdk_apis_tenancy_v1alpha1_Mount(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.ObjectReference":                          schema_sdk_apis_tenancy_v1alpha1_ObjectReference(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.PrefixedClaimOrExpression":                schema_sdk_apis_tenancy_v1alpha1_PrefixedClaimOrExpression(ref),
+		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.ResourceQuotaTemplate":                    schema_sdk_apis_tenancy_v1alpha1_ResourceQuotaTemplate(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.UserValidationRule":                       schema_sdk_apis_tenancy_v1alpha1_UserValidationRule(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.VirtualWorkspace":                         schema_sdk_apis_tenancy_v1alpha1_VirtualWorkspace(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.Workspace":                                schema_sdk_apis_tenancy_v1alpha1_Workspace(ref),
+		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceAffinityTerm":                    schema_sdk_apis_tenancy_v1alpha1_WorkspaceAffinityTerm(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceAuthenticationConfiguration":     schema_sdk_apis_tenancy_v1alpha1_WorkspaceAuthenticationConfiguration(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceAuthenticationConfigurationList": schema_sdk_apis_tenancy_v1alpha1_WorkspaceAuthenticationConfigurationList(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceAuthenticationConfigurationSpec": schema_sdk_apis_tenancy_v1alpha1_WorkspaceAuthenticationConfigurationSpec(ref),
+		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceExpiration":                      schema_sdk_apis_tenancy_v1alpha1_WorkspaceExpiration(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceList":                            schema_sdk_apis_tenancy_v1alpha1_WorkspaceList(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceLocation":                        schema_sdk_apis_tenancy_v1alpha1_WorkspaceLocation(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceSpec":                            schema_sdk_apis_tenancy_v1alpha1_WorkspaceSpec(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceStatus":                          schema_sdk_apis_tenancy_v1alpha1_WorkspaceStatus(ref),
+		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceTopologySpreadConstraint":        schema_sdk_apis_tenancy_v1alpha1_WorkspaceTopologySpreadConstraint(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceType":                            schema_sdk_apis_tenancy_v1alpha1_WorkspaceType(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceTypeExtension":                   schema_sdk_apis_tenancy_v1alpha1_WorkspaceTypeExtension(ref),
 		"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceTypeList":                        schema_sdk_apis_tenancy_v1alpha1_WorkspaceTypeList(ref),
@@ -927,7 +944,7 @@ func schema_sdk_apis_apis_v1alpha1_APIExportSpec(ref common.ReferenceCallback) c
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "latestResourceSchemas records the latest APIResourceSchemas that are exposed with this APIExport.\n\nThe schemas can be changed in the life-cycle of the APIExport. These changes have no effect on existing APIBindings, but only on newly bound ones.\n\nFor updating existing APIBindings, use an APIDeployment keeping bound workspaces up-to-date.",
+							Description: "latestResourceSchemas records the latest APIResourceSchemas that are exposed with this APIExport.\n\nThe schemas can be changed in the life-cycle of the APIExport. These changes are applied to all APIBindings at once.\n\nFor rolling out new schemas to existing APIBindings gradually, use an APIDeployment, which updates this field when the rollout is complete.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -1164,7 +1181,7 @@ func schema_sdk_apis_apis_v1alpha1_APIResourceSchemaSpec(ref common.ReferenceCal
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "versions is the API version of the defined custom resource.\n\nNote: the OpenAPI v3 schemas must be equal for all versions until CEL\n      version migration is supported.",
+							Description: "versions is the API version of the defined custom resource.\n\nNote: versions with different OpenAPI v3 schemas need a conversion webhook, or\n      an APIConversion of the same name as this APIResourceSchema.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -1998,12 +2015,58 @@ func schema_sdk_apis_apis_v1alpha2_APIBindingSpec(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"resourceRenames": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"group",
+									"resource",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "resourceRenames serve resources of the APIExport under another group or resource name in this workspace, e.g. to avoid naming conflicts with other APIBindings or CRDs. Objects are stored under the identity of the APIExport independently of the name they are served as, hence the APIExport owner sees them under the exported name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/sdk/apis/apis/v1alpha2.ResourceRename"),
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"group",
+									"resource",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "resources restricts the APIBinding to the listed resources of the APIExport. Other resources of the APIExport are neither bound nor served in this workspace. All resources are bound if the list is empty. Resources can be added, but not removed once bound.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/sdk/apis/apis/v1alpha2.GroupResource"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"reference"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.AcceptablePermissionClaim", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.BindingReference"},
+			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.AcceptablePermissionClaim", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.BindingReference", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.GroupResource", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.ResourceRename"},
 	}
 }
 
@@ -2021,6 +2084,13 @@ func schema_sdk_apis_apis_v1alpha2_APIBindingStatus(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
+					"release": {
+						SchemaProps: spec.SchemaProps{
+							Description: "release is the name of the release of the APIExport that is bound. It is empty if the resources of the APIExport are bound.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"boundResources": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -2103,6 +2173,26 @@ func schema_sdk_apis_apis_v1alpha2_APIBindingStatus(ref common.ReferenceCallback
 							},
 						},
 					},
+					"dependencies": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "dependencies are the names of the APIBindings in this workspace that bind the dependencies of the referenced APIExport. They cannot be deleted while this APIBinding exists.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -2111,6 +2201,336 @@ func schema_sdk_apis_apis_v1alpha2_APIBindingStatus(ref common.ReferenceCallback
 	}
 }
 
+func schema_sdk_apis_apis_v1alpha2_APIDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIDeployment rolls out new APIResourceSchemas of an APIExport to the workspaces bound to it, in waves.\n\nAn APIDeployment lives in the workspace of the APIExport. Bindings that are part of the current or an earlier wave are bound to spec.resources, all others keep the resources of the APIExport. Before every wave, the new schemas are checked to be backwards compatible with the schemas of the APIExport. When the last wave is complete, spec.resources are written to the APIExport.\n\nDeleting an APIDeployment before it completed returns all bindings to the resources of the APIExport.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec holds the desired state.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIDeploymentSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status communicates the observed state.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIDeploymentStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIDeploymentSpec", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIDeploymentStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_sdk_apis_apis_v1alpha2_APIDeploymentBindingStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIDeploymentBindingStatus records the progress of one binding.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cluster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "cluster is the name of the logical cluster of the binding.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the binding.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"wave": {
+						SchemaProps: spec.SchemaProps{
+							Description: "wave is the name of the wave the binding belongs to. It is empty for the final wave.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "state is the state of the binding in the rollout.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "message explains the state, e.g. why a binding failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"cluster", "name", "state"},
+			},
+		},
+	}
+}
+
+func schema_sdk_apis_apis_v1alpha2_APIDeploymentList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIDeploymentList is a list of APIDeployment resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIDeployment"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIDeployment", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_sdk_apis_apis_v1alpha2_APIDeploymentSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIDeploymentSpec defines the desired state of APIDeployment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"exportName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "exportName is the name of the APIExport in the same workspace whose bindings are upgraded.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+									"group",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "resources are the new resources of the APIExport. Resources that are not part of the APIExport yet are added, resources of the APIExport that are missing here are kept.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/sdk/apis/apis/v1alpha2.ResourceSchema"),
+									},
+								},
+							},
+						},
+					},
+					"waves": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "waves are the steps of the rollout. Every binding belongs to the first wave it matches. Bindings that match no wave are upgraded in a final wave after all others. Without waves, all bindings are upgraded at once.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIDeploymentWave"),
+									},
+								},
+							},
+						},
+					},
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "paused stops the rollout from proceeding to the next wave. Bindings of the current wave are still upgraded.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"exportName", "resources"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIDeploymentWave", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.ResourceSchema"},
+	}
+}
+
+func schema_sdk_apis_apis_v1alpha2_APIDeploymentStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIDeploymentStatus communicates the observed state of the APIDeployment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "phase is the current phase of the APIDeployment: - \"\": the rollout has not started yet. - Progressing: the bindings are being upgraded. - Completed: all bindings are upgraded and the APIExport is updated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"currentWave": {
+						SchemaProps: spec.SchemaProps{
+							Description: "currentWave is the index of the wave being rolled out. The implicit final wave of the bindings that match no wave has the index len(spec.waves).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"bindings": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"cluster",
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "bindings records the progress of every binding of the APIExport.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIDeploymentBindingStatus"),
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "conditions is a list of conditions that apply to the APIDeployment.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIDeploymentBindingStatus", "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1.Condition"},
+	}
+}
+
+func schema_sdk_apis_apis_v1alpha2_APIDeploymentWave(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIDeploymentWave selects the bindings upgraded in one step of the rollout.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the wave.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"partition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "partition is the name of a Partition in the workspace of the APIDeployment. The wave selects the bindings in workspaces scheduled to shards of the partition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "selector selects bindings by their labels. If partition is set too, bindings have to match both.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
 func schema_sdk_apis_apis_v1alpha2_APIExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2156,56 +2576,162 @@ func schema_sdk_apis_apis_v1alpha2_APIExport(ref common.ReferenceCallback) commo
 			},
 		},
 		Dependencies: []string{
-			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExportSpec", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExportStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExportSpec", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExportStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_sdk_apis_apis_v1alpha2_APIExportChannel(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIExportChannel points at a release of an APIExport.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the channel.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"release": {
+						SchemaProps: spec.SchemaProps{
+							Description: "release is the name of the release the channel points at.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "release"},
+			},
+		},
+	}
+}
+
+func schema_sdk_apis_apis_v1alpha2_APIExportDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIExportDependency references an APIExport that another APIExport depends on.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "path is a logical cluster path where the APIExport is defined. If the path is unset, the logical cluster of the depending APIExport is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the APIExport.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_sdk_apis_apis_v1alpha2_APIExportList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIExportList is a list of APIExport resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExport"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExport", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_sdk_apis_apis_v1alpha2_APIExportList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sdk_apis_apis_v1alpha2_APIExportRelease(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "APIExportList is a list of APIExport resources",
+				Description: "APIExportRelease is a named set of resource schemas of an APIExport.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "name is the name of the release.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+					"resources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+									"group",
+								},
+								"x-kubernetes-list-type": "map",
+							},
 						},
-					},
-					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "resources are the APIResourceSchemas bound by APIBindings to this release.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExport"),
+										Ref:     ref("github.com/kcp-dev/sdk/apis/apis/v1alpha2.ResourceSchema"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"metadata", "items"},
+				Required: []string{"name", "resources"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExport", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.ResourceSchema"},
 	}
 }
 
@@ -2227,7 +2753,7 @@ func schema_sdk_apis_apis_v1alpha2_APIExportSpec(ref common.ReferenceCallback) c
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources records the APIResourceSchemas that are exposed with this APIExport.\n\nThe schemas can be changed in the life-cycle of the APIExport. These changes have no effect on existing APIBindings, but only on newly bound ones.\n\nFor updating existing APIBindings, use an APIDeployment keeping bound workspaces up-to-date.",
+							Description: "Resources records the APIResourceSchemas that are exposed with this APIExport.\n\nThe schemas can be changed in the life-cycle of the APIExport. These changes are applied to all APIBindings at once.\n\nFor rolling out new schemas to existing APIBindings gradually, use an APIDeployment, which updates this field when the rollout is complete.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2274,11 +2800,74 @@ func schema_sdk_apis_apis_v1alpha2_APIExportSpec(ref common.ReferenceCallback) c
 							},
 						},
 					},
+					"dependencies": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "dependencies are other APIExports that the APIs of this APIExport require, e.g. because their objects reference objects of those APIs.\n\nWhen a workspace binds to this APIExport, every dependency must be bound by an APIBinding in the workspace, too. These APIBindings are not created automatically. APIBindings that dependencies are bound through cannot be deleted while this APIExport is bound.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExportDependency"),
+									},
+								},
+							},
+						},
+					},
+					"releases": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "releases are named sets of resource schemas, e.g. \"v1.2.0\". APIBindings can pin a release, or follow a channel, instead of binding to the resources above.\n\nThe resources above are still served in the virtual workspace of this APIExport, hence the schemas of a release should be compatible with them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExportRelease"),
+									},
+								},
+							},
+						},
+					},
+					"channels": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "channels point at releases, e.g. \"stable\" and \"beta\". APIBindings following a channel move along with it according to their upgrade policy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExportChannel"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.Identity", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.MaximalPermissionPolicy", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.PermissionClaim", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.ResourceSchema"},
+			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExportChannel", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExportDependency", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.APIExportRelease", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.Identity", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.MaximalPermissionPolicy", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.PermissionClaim", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.ResourceSchema"},
 	}
 }
 
@@ -2394,12 +2983,18 @@ func schema_sdk_apis_apis_v1alpha2_AcceptablePermissionClaim(ref common.Referenc
 							Format:  "",
 						},
 					},
+					"expiresAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "expiresAt is the point in time at which the acceptance of the claim expires. After that, the claim is treated as not accepted and the API service provider loses access, until the claim is accepted again with a later or no expiry.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 				Required: []string{"resource", "verbs", "selector", "state"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.PermissionClaimSelector"},
+			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.PermissionClaimSelector", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -2474,12 +3069,18 @@ func schema_sdk_apis_apis_v1alpha2_BoundAPIResource(ref common.ReferenceCallback
 							},
 						},
 					},
+					"servedAs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "servedAs is the group and resource the bound API is served as in the workspace, if it is renamed through spec.resourceRenames. Objects are still stored under group and resource.",
+							Ref:         ref("github.com/kcp-dev/sdk/apis/apis/v1alpha2.GroupResource"),
+						},
+					},
 				},
 				Required: []string{"group", "resource", "schema"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.BoundAPIResourceSchema"},
+			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.BoundAPIResourceSchema", "github.com/kcp-dev/sdk/apis/apis/v1alpha2.GroupResource"},
 	}
 }
 
@@ -2543,6 +3144,27 @@ func schema_sdk_apis_apis_v1alpha2_ExportBindingReference(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"release": {
+						SchemaProps: spec.SchemaProps{
+							Description: "release pins the APIBinding to a release of the APIExport. If neither release nor channel is set, the resources of the APIExport are bound.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"channel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "channel makes the APIBinding follow a channel of the APIExport, i.e. it is bound to the release the channel points at.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"upgradePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "upgradePolicy defines when an APIBinding following a channel moves to the release the channel points at: - Automatic (default): as soon as the channel points at another release. - Compatible: only if the schemas of the new release are backwards compatible with the\n  schemas of the bound release.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
@@ -2737,6 +3359,46 @@ func schema_sdk_apis_apis_v1alpha2_PermissionClaimSelector(ref common.ReferenceC
 							Format:      "",
 						},
 					},
+					"namespaces": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "namespaces limits access to objects in one of the given namespaces. Cluster-scoped objects are never selected if this is set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"names": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "names limits access to objects with one of the given names.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -2745,6 +3407,44 @@ func schema_sdk_apis_apis_v1alpha2_PermissionClaimSelector(ref common.ReferenceC
 	}
 }
 
+func schema_sdk_apis_apis_v1alpha2_ResourceRename(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceRename serves a resource of an APIExport under another group or resource name.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "group is the name of an API group. For core groups this is the empty string '\"\"'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "resource is the name of the resource. Note: it is worth noting that you can not ask for permissions for resource provided by a CRD not provided by an api export.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"as": {
+						SchemaProps: spec.SchemaProps{
+							Description: "as is the group and resource the resource is served as in the workspace. The group must not be the core group, nor a group reserved for Kubernetes or kcp.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kcp-dev/sdk/apis/apis/v1alpha2.GroupResource"),
+						},
+					},
+				},
+				Required: []string{"resource", "as"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.GroupResource"},
+	}
+}
+
 func schema_sdk_apis_apis_v1alpha2_ResourceSchema(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2971,6 +3671,74 @@ func schema_sdk_apis_apis_v1alpha2_VirtualWorkspace(ref common.ReferenceCallback
 	}
 }
 
+func schema_sdk_apis_apis_v1alpha2_boundAPIResourceServedAs(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "boundAPIResourceServedAs holds the served group and resource of a BoundAPIResource, which do not exist in v1alpha1.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "group is the name of an API group. For core groups this is the empty string '\"\"'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "resource is the name of the resource. Note: it is worth noting that you can not ask for permissions for resource provided by a CRD not provided by an api export.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"servedAs": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kcp-dev/sdk/apis/apis/v1alpha2.GroupResource"),
+						},
+					},
+				},
+				Required: []string{"resource", "servedAs"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kcp-dev/sdk/apis/apis/v1alpha2.GroupResource"},
+	}
+}
+
+func schema_sdk_apis_apis_v1alpha2_exportBindingReleaseReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "exportBindingReleaseReference holds the fields of ExportBindingReference that do not exist in v1alpha1.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"release": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"channel": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"upgradePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_sdk_apis_cache_v1alpha1_CachedObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3808,6 +4576,13 @@ func schema_sdk_apis_core_v1alpha1_LogicalClusterSpec(ref common.ReferenceCallba
 							},
 						},
 					},
+					"hibernation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "hibernation suspends the logical cluster when set. It is set by the system from the spec.hibernation field of the owning Workspace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -4014,6 +4789,20 @@ func schema_sdk_apis_core_v1alpha1_ShardSpec(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"unschedulable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "unschedulable prevents new workspaces from being scheduled onto this shard, i.e. the shard is cordoned. Logical clusters that live on the shard already are not affected.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"drain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "drain moves the logical clusters of all workspaces on this shard to other schedulable shards. A draining shard is unschedulable. The progress is reported in the Drained condition.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"baseURL"},
 			},
@@ -4030,7 +4819,21 @@ func schema_sdk_apis_core_v1alpha1_ShardStatus(ref common.ReferenceCallback) com
 				Properties: map[string]spec.Schema{
 					"capacity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Set of integer resources that logical clusters can be scheduled into",
+							Description: "Set of integer resources that logical clusters can be scheduled into",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"allocated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "allocated is the set of integer resources currently consumed by logical clusters on this shard. It is reported by the shard itself, and compared against capacity when scheduling new logical clusters.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -4359,6 +5162,44 @@ func schema_sdk_apis_tenancy_v1alpha1_JWTAuthenticator(ref common.ReferenceCallb
 	}
 }
 
+func schema_sdk_apis_tenancy_v1alpha1_LimitRangeTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LimitRangeTemplate describes a LimitRange created in workspaces of a WorkspaceType.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the LimitRange.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "namespace is the namespace of the LimitRange.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "spec is the spec of the LimitRange.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LimitRangeSpec"),
+						},
+					},
+				},
+				Required: []string{"name", "spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LimitRangeSpec"},
+	}
+}
+
 func schema_sdk_apis_tenancy_v1alpha1_Mount(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4457,6 +5298,60 @@ func schema_sdk_apis_tenancy_v1alpha1_PrefixedClaimOrExpression(ref common.Refer
 	}
 }
 
+func schema_sdk_apis_tenancy_v1alpha1_ResourceQuotaTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceQuotaTemplate describes a ResourceQuota created in workspaces of a WorkspaceType.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the ResourceQuota.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "namespace is the namespace of the ResourceQuota.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "annotations are set on the ResourceQuota, e.g. experimental.quota.kcp.io/cluster-scoped to count objects in all namespaces of the workspace.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "spec is the spec of the ResourceQuota.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceQuotaSpec"),
+						},
+					},
+				},
+				Required: []string{"name", "spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ResourceQuotaSpec"},
+	}
+}
+
 func schema_sdk_apis_tenancy_v1alpha1_UserValidationRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4561,6 +5456,40 @@ func schema_sdk_apis_tenancy_v1alpha1_Workspace(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_sdk_apis_tenancy_v1alpha1_WorkspaceAffinityTerm(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkspaceAffinityTerm selects sibling workspaces, and the topology domain they are compared by.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workspaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "workspaceSelector selects sibling workspaces by their labels. If both workspaceSelector and type are unset, all sibling workspaces are selected.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "type selects sibling workspaces of the given type. If path is empty, types of that name in any workspace are selected.",
+							Ref:         ref("github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceTypeReference"),
+						},
+					},
+					"topologyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "topologyKey is the key of shard labels. Shards with the same value of this label are in the same topology domain. If empty, every shard is its own topology domain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceTypeReference", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
 func schema_sdk_apis_tenancy_v1alpha1_WorkspaceAuthenticationConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4680,6 +5609,39 @@ func schema_sdk_apis_tenancy_v1alpha1_WorkspaceAuthenticationConfigurationSpec(r
 	}
 }
 
+func schema_sdk_apis_tenancy_v1alpha1_WorkspaceExpiration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkspaceExpiration defines when a workspace expires.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ttl is the lifetime of the workspace, counted from its creation, e.g. \"24h\".",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"expiresAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "expiresAt is the point in time at which the workspace expires.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"warningPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "warningPeriod is how long before the expiry the Expiring condition is set. It defaults to one hour.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_sdk_apis_tenancy_v1alpha1_WorkspaceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4741,11 +5703,75 @@ func schema_sdk_apis_tenancy_v1alpha1_WorkspaceLocation(ref common.ReferenceCall
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"shard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "shard is the name of the shard the workspace must live on. It can only be set by system privileged users.\n\nIf the workspace is already scheduled onto another shard, the logical cluster of the workspace is migrated with all its objects to this shard. The workspace is read-only while it is migrated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"affinity": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "affinity places the workspace into a topology domain that hosts sibling workspaces, i.e. workspaces in the same parent workspace, matched by the given terms. A term that matches no scheduled sibling workspace does not restrict the placement.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceAffinityTerm"),
+									},
+								},
+							},
+						},
+					},
+					"antiAffinity": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "antiAffinity keeps the workspace out of topology domains that host sibling workspaces matched by the given terms.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceAffinityTerm"),
+									},
+								},
+							},
+						},
+					},
+					"topologySpreadConstraints": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "topologySpreadConstraints spread sibling workspaces evenly across topology domains.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceTopologySpreadConstraint"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceAffinityTerm", "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceTopologySpreadConstraint", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -4788,11 +5814,31 @@ func schema_sdk_apis_tenancy_v1alpha1_WorkspaceSpec(ref common.ReferenceCallback
 							Ref:         ref("github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.Mount"),
 						},
 					},
+					"moveFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "moveFrom is the path of an existing workspace that is moved here, e.g. \"root:org-a:team\". The new workspace takes over the logical cluster of the existing workspace, including its child workspaces. The old path is served from the new path for a grace period, after which the old workspace is deleted.\n\nThe type of the workspace must match the type of the moved workspace. The field can only be set on creation by system privileged users.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hibernation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "hibernation suspends the workspace when set, e.g. because it is idle. Controllers for the workspace are stopped to reclaim resources, and user access is restricted:\n\n- ReadOnly permits reading, but not changing objects in the workspace. - Denied denies all access to the workspace.\n\nUnset the field to resume the workspace. Child workspaces are not affected.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expiration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "expiration deletes the workspace automatically after a lifetime or at a point in time, e.g. for ephemeral CI or preview workspaces. Before the workspace expires, the Expiring condition warns about it. The workspace is deleted like any other workspace, i.e. its terminators run before it is gone.",
+							Ref:         ref("github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceExpiration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.Mount", "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceLocation", "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceTypeReference"},
+			"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.Mount", "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceExpiration", "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceLocation", "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceTypeReference"},
 	}
 }
 
@@ -4862,6 +5908,43 @@ func schema_sdk_apis_tenancy_v1alpha1_WorkspaceStatus(ref common.ReferenceCallba
 	}
 }
 
+func schema_sdk_apis_tenancy_v1alpha1_WorkspaceTopologySpreadConstraint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkspaceTopologySpreadConstraint describes how sibling workspaces are spread across topology domains.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"topologyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "topologyKey is the key of shard labels. Shards with the same value of this label are in the same topology domain. Shards without this label are not considered.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxSkew": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxSkew is the maximal difference of the number of selected sibling workspaces between any two topology domains, including the workspace being scheduled.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"workspaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "workspaceSelector selects the sibling workspaces that are counted. If unset, all sibling workspaces are counted.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+				Required: []string{"topologyKey"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
 func schema_sdk_apis_tenancy_v1alpha1_WorkspaceType(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5134,6 +6217,41 @@ func schema_sdk_apis_tenancy_v1alpha1_WorkspaceTypeSpec(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"defaultResourceQuotas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "defaultResourceQuotas are ResourceQuotas to create during initialization of workspaces created from this type. Missing namespaces are created.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.ResourceQuotaTemplate"),
+									},
+								},
+							},
+						},
+					},
+					"defaultLimitRanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "defaultLimitRanges are LimitRanges to create during initialization of workspaces created from this type. Missing namespaces are created.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.LimitRangeTemplate"),
+									},
+								},
+							},
+						},
+					},
+					"defaultResourcesLifecycle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configure the lifecycle behaviour of defaultResourceQuotas and defaultLimitRanges. With Maintain, changes to the templates are rolled out to existing workspaces, and modified or deleted objects are restored.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"authenticationConfigurations": {
 						SchemaProps: spec.SchemaProps{
 							Description: "authenticationConfigurations are additional authentication options that should apply to any workspace using this workspace type.",
@@ -5152,7 +6270,7 @@ func schema_sdk_apis_tenancy_v1alpha1_WorkspaceTypeSpec(ref common.ReferenceCall
 			},
 		},
 		Dependencies: []string{
-			"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.APIExportReference", "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.AuthenticationConfigurationReference", "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceTypeExtension", "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceTypeReference", "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceTypeSelector"},
+			"github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.APIExportReference", "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.AuthenticationConfigurationReference", "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.LimitRangeTemplate", "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.ResourceQuotaTemplate", "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceTypeExtension", "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceTypeReference", "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1.WorkspaceTypeSelector"},
 	}
 }
 
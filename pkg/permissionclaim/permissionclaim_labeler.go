@@ -19,6 +19,7 @@ package permissionclaim
 import (
 	"context"
 	"fmt"
+	"time"
 
 	authenticationv1 "k8s.io/api/authentication/v1"
 	authorizationv1 "k8s.io/api/authorization/v1"
@@ -88,9 +89,9 @@ func normalizeEventGroupResource(gr schema.GroupResource) schema.GroupResource {
 }
 
 // LabelsFor returns all the applicable labels for the cluster-group-resource relating to permission claims. This is
-// the intersection of (1) all APIBindings in the cluster that have accepted claims for the group-resource with (2)
-// associated APIExports that are claiming group-resource.
-func (l *Labeler) LabelsFor(ctx context.Context, cluster logicalcluster.Name, groupResource schema.GroupResource, resourceName string, resourceLabels map[string]string) (map[string]string, error) {
+// the intersection of (1) all APIBindings in the cluster that have accepted claims for the group-resource and whose
+// selector matches the object with (2) associated APIExports that are claiming group-resource.
+func (l *Labeler) LabelsFor(ctx context.Context, cluster logicalcluster.Name, groupResource schema.GroupResource, resourceNamespace, resourceName string, resourceLabels map[string]string) (map[string]string, error) {
 	labels := map[string]string{}
 	if _, nonPersisted := NonPersistedResourcesClaimable[groupResource]; nonPersisted {
 		return labels, nil
@@ -119,21 +120,18 @@ func (l *Labeler) LabelsFor(ctx context.Context, cluster logicalcluster.Name, gr
 		}
 
 		for _, claim := range binding.Spec.PermissionClaims {
-			if claim.State != apisv1alpha2.ClaimAccepted || claim.Group != normalizedGR.Group || claim.Resource != normalizedGR.Resource {
+			if !claim.IsAcceptedAt(time.Now()) || claim.Group != normalizedGR.Group || claim.Resource != normalizedGR.Resource {
 				continue
 			}
 
-			if !claim.Selector.MatchAll {
-				selector, err := metav1.LabelSelectorAsSelector(&claim.Selector.LabelSelector)
-				if err != nil {
-					logger.Error(err, "error calculating permission claim label key and value",
-						"claim", claim.String())
-					continue
-				}
-
-				if !selector.Matches(klabels.Set(resourceLabels)) {
-					continue
-				}
+			matches, err := SelectorMatches(claim.Selector, resourceNamespace, resourceName, resourceLabels)
+			if err != nil {
+				logger.Error(err, "error calculating permission claim label key and value",
+					"claim", claim.String())
+				continue
+			}
+			if !matches {
+				continue
 			}
 
 			k, v, err := permissionclaims.ToLabelKeyAndValue(logicalcluster.From(export), export.Name, claim.PermissionClaim)
@@ -173,6 +171,24 @@ func (l *Labeler) LabelsFor(ctx context.Context, cluster logicalcluster.Name, gr
 	return labels, nil
 }
 
+// SelectorMatches returns whether the object with the given namespace, name and labels
+// is selected by the permission claim selector.
+func SelectorMatches(selector apisv1alpha2.PermissionClaimSelector, namespace, name string, objLabels map[string]string) (bool, error) {
+	if selector.MatchAll {
+		return true, nil
+	}
+
+	if !selector.MatchesNamespaceAndName(namespace, name) {
+		return false, nil
+	}
+
+	labelSelector, err := metav1.LabelSelectorAsSelector(&selector.LabelSelector)
+	if err != nil {
+		return false, err
+	}
+	return labelSelector.Matches(klabels.Set(objLabels)), nil
+}
+
 // InstallIndexers adds the additional indexers that this controller requires to the informers.
 func InstallIndexers(apiExportInformer apisv1alpha2informers.APIExportClusterInformer) {
 	indexers.AddIfNotPresentOrDie(apiExportInformer.Informer().GetIndexer(), cache.Indexers{
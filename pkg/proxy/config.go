@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"k8s.io/apiserver/pkg/audit"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -53,6 +54,9 @@ type ExtraConfig struct {
 	AuthenticationInfo    genericapiserver.AuthenticationInfo
 	ServingInfo           *genericapiserver.SecureServingInfo
 	AdditionalAuthEnabled bool
+
+	AuditBackend             audit.Backend
+	AuditPolicyRuleEvaluator audit.PolicyRuleEvaluator
 }
 
 type CompletedConfig struct {
@@ -105,5 +109,13 @@ func NewConfig(ctx context.Context, opts *proxyoptions.Options) (*Config, error)
 
 	c.AdditionalAuthEnabled = c.Options.Authentication.AdditionalAuthEnabled()
 
+	// the audit options only know how to apply to a generic apiserver config.
+	auditConfig := &genericapiserver.Config{}
+	if err := c.Options.Audit.ApplyTo(auditConfig); err != nil {
+		return nil, fmt.Errorf("failed to configure auditing: %w", err)
+	}
+	c.AuditBackend = auditConfig.AuditBackend
+	c.AuditPolicyRuleEvaluator = auditConfig.AuditPolicyRuleEvaluator
+
 	return c, nil
 }
@@ -31,4 +31,9 @@ limitations under the License.
 //     backend_server_ca: certs/kcp-ca-cert.pem
 //     proxy_client_cert: certs/proxy-client-cert.pem
 //     proxy_client_key: certs/proxy-client-key.pem
+//
+// The configuration and the certificates it references are checked for changes
+// periodically. Valid changes are applied without a restart, while requests in
+// flight finish with the previous configuration. Invalid changes are logged and
+// ignored. Adding or removing the /clusters/ mapping requires a restart.
 package proxy
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/kcp/pkg/proxy/metrics"
+)
+
+// failoverEndpoint is one replica of a mapping backend.
+type failoverEndpoint struct {
+	url   *url.URL
+	proxy *httputil.ReverseProxy
+}
+
+// failoverAttempt receives the error of a request proxied to one endpoint, instead
+// of it being written to the client.
+type failoverAttempt struct {
+	err error
+}
+
+type failoverAttemptContextKeyType int
+
+const failoverAttemptContextKey failoverAttemptContextKeyType = iota
+
+// newFailoverProxy proxies requests to the first healthy one of the given replicas
+// of a mapping backend. Idempotent requests are retried against the next healthy
+// replica if a replica cannot be reached. If no replica can serve the request, the
+// client gets a 503 Service Unavailable.
+func newFailoverProxy(urls []*url.URL, transport http.RoundTripper, health *healthChecker) http.Handler {
+	endpoints := make([]failoverEndpoint, 0, len(urls))
+	for _, u := range urls {
+		proxy := httputil.NewSingleHostReverseProxy(u)
+		proxy.Transport = transport
+		proxy.ModifyResponse = func(resp *http.Response) error {
+			health.observe(resp.Request.Context(), backendHealthKind, u.String(), u, nil)
+			return nil
+		}
+		proxy.ErrorHandler = func(w http.ResponseWriter, req *http.Request, err error) {
+			attempt, _ := req.Context().Value(failoverAttemptContextKey).(*failoverAttempt)
+			attempt.err = err
+		}
+		endpoints = append(endpoints, failoverEndpoint{url: u, proxy: proxy})
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		logger := klog.FromContext(ctx)
+
+		retry := isRetryable(req)
+		var retryAfter time.Duration
+		attempted := false
+		for _, e := range endpoints {
+			if d := health.retryAfter(e.url); d > 0 {
+				if retryAfter == 0 || d < retryAfter {
+					retryAfter = d
+				}
+				continue
+			}
+			if attempted {
+				metrics.RecordBackendFailover()
+			}
+			attempted = true
+
+			attempt := &failoverAttempt{}
+			e.proxy.ServeHTTP(w, req.WithContext(context.WithValue(ctx, failoverAttemptContextKey, attempt)))
+			if attempt.err == nil || ctx.Err() != nil {
+				return
+			}
+
+			logger.V(2).Info("Failed to proxy request to backend", "backend", e.url.String(), "err", attempt.err)
+			health.observe(ctx, backendHealthKind, e.url.String(), e.url, attempt.err)
+			if !retry {
+				break
+			}
+		}
+
+		writeUnavailable(w, req, fmt.Sprintf("backend for %s is unavailable", req.URL.Path), retryAfter)
+	})
+}
+
+// isRetryable returns whether the request can be sent again after it failed,
+// i.e. it is idempotent and has no body that was consumed already.
+func isRetryable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+	default:
+		return false
+	}
+	return req.Body == nil || req.Body == http.NoBody || req.ContentLength == 0
+}
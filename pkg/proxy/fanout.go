@@ -0,0 +1,582 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/kcp/pkg/proxy/lookup"
+)
+
+const (
+	// compositeResourceVersionPrefix marks the resourceVersions of wildcard requests fanned out to
+	// multiple shards.
+	compositeResourceVersionPrefix = "kcp.shards."
+
+	// maxTranslatedBodyBytes is the size up to which request bodies are searched for composite
+	// resourceVersions. Larger bodies are passed on unchanged.
+	maxTranslatedBodyBytes = 3 * 1024 * 1024
+)
+
+var compositeResourceVersionPattern = regexp.MustCompile(regexp.QuoteMeta(compositeResourceVersionPrefix) + `[A-Za-z0-9_-]+`)
+
+// hopHeaders are not forwarded to the shards of a fanned out request.
+var hopHeaders = []string{
+	"Connection",
+	"Keep-Alive",
+	"Proxy-Connection",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// compositeResourceVersion is the resourceVersion of a wildcard request fanned out to multiple shards.
+// It holds the resourceVersion of each shard, keyed by shard name.
+type compositeResourceVersion map[string]string
+
+func (rv compositeResourceVersion) String() string {
+	data, _ := json.Marshal(map[string]string(rv))
+	return compositeResourceVersionPrefix + base64.RawURLEncoding.EncodeToString(data)
+}
+
+// parseCompositeResourceVersion returns the composite resourceVersion s, or false if s is none.
+func parseCompositeResourceVersion(s string) (compositeResourceVersion, bool) {
+	encoded, ok := strings.CutPrefix(s, compositeResourceVersionPrefix)
+	if !ok {
+		return nil, false
+	}
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, false
+	}
+	rv := compositeResourceVersion{}
+	if err := json.Unmarshal(data, &rv); err != nil {
+		return nil, false
+	}
+	return rv, true
+}
+
+// fanOutContinue is the continue token of a paginated list fanned out to multiple shards. Shards
+// are listed one after the other in the order of their names.
+type fanOutContinue struct {
+	// Shard is the shard to continue with.
+	Shard string `json:"shard"`
+	// Continue is the continue token of the shard, empty if the shard has not been listed yet.
+	Continue string `json:"continue,omitempty"`
+	// ResourceVersion holds the resourceVersions of the shards listed so far.
+	ResourceVersion compositeResourceVersion `json:"resourceVersion,omitempty"`
+}
+
+func (c fanOutContinue) String() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func parseFanOutContinue(s string) (*fanOutContinue, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	var c fanOutContinue
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// shardList is a list returned by a shard. Items are kept as they are, including their resourceVersion.
+type shardList struct {
+	metav1.TypeMeta `json:",inline"`
+	Metadata        metav1.ListMeta   `json:"metadata"`
+	Items           []json.RawMessage `json:"items"`
+}
+
+// shardWatchEvent is a watch event returned by a shard.
+type shardWatchEvent struct {
+	Type   watch.EventType `json:"type"`
+	Object json.RawMessage `json:"object"`
+}
+
+// fanOutHandler serves wildcard list and watch requests by sending them to each target shard and merging
+// the results. Lists and watch events get a composite resourceVersion made of the resourceVersion of each
+// shard, which is split again when it is passed back by the client.
+type fanOutHandler struct {
+	transport http.RoundTripper
+	health    *healthChecker
+}
+
+func newFanOutHandler(transport http.RoundTripper, health *healthChecker) *fanOutHandler {
+	return &fanOutHandler{transport: transport, health: health}
+}
+
+func (h *fanOutHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	fanOut := lookup.FanOutFrom(req.Context())
+	if fanOut == nil || len(fanOut.Shards) == 0 {
+		responsewriters.ErrorNegotiated(apierrors.NewServiceUnavailable("no shards available"), kubernetesscheme.Codecs, schema.GroupVersion{}, w, req)
+		return
+	}
+
+	if fanOut.Watch {
+		h.watch(w, req, fanOut.Shards)
+		return
+	}
+	h.list(w, req, fanOut.Shards)
+}
+
+func (h *fanOutHandler) list(w http.ResponseWriter, req *http.Request, shards []lookup.Shard) {
+	query := req.URL.Query()
+
+	var limit int64
+	if s := query.Get("limit"); s != "" {
+		var err error
+		if limit, err = strconv.ParseInt(s, 10, 64); err != nil || limit < 0 {
+			responsewriters.ErrorNegotiated(apierrors.NewBadRequest(fmt.Sprintf("invalid limit %q", s)), kubernetesscheme.Codecs, schema.GroupVersion{}, w, req)
+			return
+		}
+	}
+
+	// A continued list starts at the shard of the continue token, with the resourceVersions of the shards
+	// listed before. Later shards are listed at their latest resourceVersion.
+	start := 0
+	rvs := compositeResourceVersion{}
+	var requested compositeResourceVersion
+	var token *fanOutContinue
+	if s := query.Get("continue"); s != "" {
+		var err error
+		if token, err = parseFanOutContinue(s); err != nil {
+			responsewriters.ErrorNegotiated(apierrors.NewBadRequest(fmt.Sprintf("invalid continue token: %v", err)), kubernetesscheme.Codecs, schema.GroupVersion{}, w, req)
+			return
+		}
+		start = -1
+		for i, shard := range shards {
+			if shard.Name == token.Shard {
+				start = i
+				break
+			}
+		}
+		if start < 0 {
+			responsewriters.ErrorNegotiated(apierrors.NewResourceExpired(fmt.Sprintf("shard %q of the continue token is gone", token.Shard)), kubernetesscheme.Codecs, schema.GroupVersion{}, w, req)
+			return
+		}
+		for shard, rv := range token.ResourceVersion {
+			rvs[shard] = rv
+		}
+	} else {
+		var err error
+		if requested, err = requestedResourceVersions(query.Get("resourceVersion")); err != nil {
+			responsewriters.ErrorNegotiated(err, kubernetesscheme.Codecs, schema.GroupVersion{}, w, req)
+			return
+		}
+	}
+
+	var typeMeta metav1.TypeMeta
+	items := []json.RawMessage{}
+	var next *fanOutContinue
+	for i := start; i < len(shards); i++ {
+		shard := shards[i]
+
+		shardQuery := cloneQuery(query)
+		shardQuery.Del("continue")
+		switch {
+		case token != nil:
+			shardQuery.Del("resourceVersion")
+			shardQuery.Del("resourceVersionMatch")
+			if i == start && token.Continue != "" {
+				shardQuery.Set("continue", token.Continue)
+			}
+		case requested != nil:
+			setShardResourceVersion(shardQuery, requested, shard.Name, "")
+		}
+		if limit > 0 {
+			shardQuery.Set("limit", strconv.FormatInt(limit-int64(len(items)), 10))
+		}
+
+		resp, err := h.do(req.Context(), req, shard, shardQuery)
+		if err != nil {
+			writeUnavailable(w, req, fmt.Sprintf("shard %q unavailable: %v", shard.Name, err), h.health.retryAfter(shard.URL))
+			return
+		}
+		if resp.StatusCode != http.StatusOK {
+			copyResponse(w, resp)
+			return
+		}
+		var list shardList
+		err = json.NewDecoder(resp.Body).Decode(&list)
+		resp.Body.Close()
+		if err != nil {
+			responsewriters.InternalError(w, req, fmt.Errorf("failed to decode list of shard %q: %w", shard.Name, err))
+			return
+		}
+
+		typeMeta = list.TypeMeta
+		rvs[shard.Name] = list.Metadata.ResourceVersion
+		items = append(items, list.Items...)
+
+		if list.Metadata.Continue != "" {
+			next = &fanOutContinue{Shard: shard.Name, Continue: list.Metadata.Continue}
+			break
+		}
+		if limit > 0 && int64(len(items)) >= limit && i+1 < len(shards) {
+			next = &fanOutContinue{Shard: shards[i+1].Name}
+			break
+		}
+	}
+
+	out := shardList{
+		TypeMeta: typeMeta,
+		Metadata: metav1.ListMeta{ResourceVersion: rvs.String()},
+		Items:    items,
+	}
+	if next != nil {
+		next.ResourceVersion = rvs
+		out.Metadata.Continue = next.String()
+	}
+
+	data, err := json.Marshal(out)
+	if err != nil {
+		responsewriters.InternalError(w, req, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+func (h *fanOutHandler) watch(w http.ResponseWriter, req *http.Request, shards []lookup.Shard) {
+	logger := klog.FromContext(req.Context())
+
+	query := req.URL.Query()
+	requested, err := requestedResourceVersions(query.Get("resourceVersion"))
+	if err != nil {
+		responsewriters.ErrorNegotiated(err, kubernetesscheme.Codecs, schema.GroupVersion{}, w, req)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(req.Context())
+	defer cancel()
+
+	// Open all watches before answering, so that a failing shard fails the whole request.
+	bodies := make(map[string]io.ReadCloser, len(shards))
+	defer func() {
+		for _, body := range bodies {
+			body.Close()
+		}
+	}()
+	for _, shard := range shards {
+		shardQuery := cloneQuery(query)
+		if requested != nil {
+			// A shard unknown to the client is watched from any resourceVersion, sending its objects as
+			// added first.
+			setShardResourceVersion(shardQuery, requested, shard.Name, "0")
+		}
+
+		resp, err := h.do(ctx, req, shard, shardQuery)
+		if err != nil {
+			writeUnavailable(w, req, fmt.Sprintf("shard %q unavailable: %v", shard.Name, err), h.health.retryAfter(shard.URL))
+			return
+		}
+		if resp.StatusCode != http.StatusOK {
+			copyResponse(w, resp)
+			return
+		}
+		bodies[shard.Name] = resp.Body
+	}
+
+	type event struct {
+		shard string
+		shardWatchEvent
+	}
+	events := make(chan event)
+	done := make(chan string, len(shards))
+	for shard, body := range bodies {
+		go func() {
+			decoder := json.NewDecoder(body)
+			for {
+				var e shardWatchEvent
+				if err := decoder.Decode(&e); err != nil {
+					done <- shard
+					return
+				}
+				select {
+				case events <- event{shard: shard, shardWatchEvent: e}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	flusher := http.NewResponseController(w)
+	if err := flusher.Flush(); err != nil {
+		logger.V(4).Info("failed to flush watch response", "err", err)
+		return
+	}
+
+	rvs := compositeResourceVersion{}
+	for shard, rv := range requested {
+		rvs[shard] = rv
+	}
+	initialEventsEnded := sets.New[string]()
+	encoder := json.NewEncoder(w)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case shard := <-done:
+			// The client resumes from the last composite resourceVersion once the watch of any shard ends.
+			logger.V(4).Info("watch of shard ended", "shard", shard)
+			return
+		case e := <-events:
+			if e.Type == watch.Error {
+				_ = encoder.Encode(e.shardWatchEvent)
+				_ = flusher.Flush()
+				return
+			}
+
+			obj, rv, initialEventsEnd, err := watchEventResourceVersion(e.Object)
+			if err != nil {
+				logger.Error(err, "failed to decode watch event", "shard", e.shard)
+				return
+			}
+			rvs[e.shard] = rv
+
+			// The end of initial events is only signalled once all shards have sent theirs.
+			if e.Type == watch.Bookmark && initialEventsEnd {
+				initialEventsEnded.Insert(e.shard)
+				if initialEventsEnded.Len() < len(shards) {
+					continue
+				}
+			}
+
+			if e.Object, err = setResourceVersion(obj, rvs.String()); err != nil {
+				logger.Error(err, "failed to encode watch event", "shard", e.shard)
+				return
+			}
+			if err := encoder.Encode(e.shardWatchEvent); err != nil {
+				return
+			}
+			if err := flusher.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// do sends the request to the given shard with the given query. The response is always JSON.
+func (h *fanOutHandler) do(ctx context.Context, req *http.Request, shard lookup.Shard, query url.Values) (*http.Response, error) {
+	shardURL := *shard.URL
+	shardURL.RawQuery = query.Encode()
+
+	shardReq := req.Clone(ctx)
+	shardReq.URL = &shardURL
+	shardReq.Host = shardURL.Host
+	shardReq.RequestURI = ""
+	shardReq.Body = http.NoBody
+	shardReq.ContentLength = 0
+	for _, header := range hopHeaders {
+		shardReq.Header.Del(header)
+	}
+	shardReq.Header.Del(lookup.PartitionHeader)
+	// the transport decompresses the response itself if it asked for compression.
+	shardReq.Header.Del("Accept-Encoding")
+	shardReq.Header.Set("Accept", "application/json")
+
+	if h.health.retryAfter(shard.URL) > 0 {
+		return nil, errEndpointUnhealthy
+	}
+	resp, err := h.transport.RoundTrip(shardReq)
+	h.health.observe(ctx, shardHealthKind, shard.Name, shard.URL, err)
+	return resp, err
+}
+
+// requestedResourceVersions returns the composite resourceVersion requested by the client, or nil if
+// the client requested any or the latest resourceVersion, which is passed on to every shard as is.
+func requestedResourceVersions(resourceVersion string) (compositeResourceVersion, error) {
+	if resourceVersion == "" || resourceVersion == "0" {
+		return nil, nil
+	}
+	rvs, ok := parseCompositeResourceVersion(resourceVersion)
+	if !ok {
+		// make clients start over with a list across all shards.
+		return nil, apierrors.NewResourceExpired(fmt.Sprintf("resourceVersion %q is not a resourceVersion across shards", resourceVersion))
+	}
+	return rvs, nil
+}
+
+// setShardResourceVersion sets the resourceVersion of the shard from the composite resourceVersion. If the
+// shard is not part of it, the given fallback is used, and no resourceVersion is set if that is empty.
+func setShardResourceVersion(query url.Values, requested compositeResourceVersion, shard, fallback string) {
+	rv, ok := requested[shard]
+	if !ok {
+		rv = fallback
+	}
+	if rv == "" {
+		query.Del("resourceVersion")
+		query.Del("resourceVersionMatch")
+		return
+	}
+	query.Set("resourceVersion", rv)
+}
+
+// watchEventResourceVersion decodes the object of a watch event, and returns its resourceVersion and
+// whether it marks the end of the initial events.
+func watchEventResourceVersion(data []byte) (obj map[string]json.RawMessage, resourceVersion string, initialEventsEnd bool, err error) {
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, "", false, err
+	}
+	var meta struct {
+		ResourceVersion string            `json:"resourceVersion"`
+		Annotations     map[string]string `json:"annotations"`
+	}
+	if metadata, ok := obj["metadata"]; ok {
+		if err := json.Unmarshal(metadata, &meta); err != nil {
+			return nil, "", false, err
+		}
+	}
+	return obj, meta.ResourceVersion, meta.Annotations[metav1.InitialEventsAnnotationKey] == "true", nil
+}
+
+// setResourceVersion sets metadata.resourceVersion of the decoded object and encodes it again.
+func setResourceVersion(obj map[string]json.RawMessage, resourceVersion string) ([]byte, error) {
+	meta := map[string]json.RawMessage{}
+	if metadata, ok := obj["metadata"]; ok {
+		if err := json.Unmarshal(metadata, &meta); err != nil {
+			return nil, err
+		}
+	}
+	encoded, err := json.Marshal(resourceVersion)
+	if err != nil {
+		return nil, err
+	}
+	meta["resourceVersion"] = encoded
+	if obj["metadata"], err = json.Marshal(meta); err != nil {
+		return nil, err
+	}
+	return json.Marshal(obj)
+}
+
+// withCompositeResourceVersions replaces composite resourceVersions in requests routed to a single shard
+// by the resourceVersion of that shard. Objects received through a watch fanned out to multiple shards
+// carry a composite resourceVersion, which is thereby accepted when the object is written back. Protobuf
+// request bodies are passed on unchanged.
+func withCompositeResourceVersions(delegate http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		shard := lookup.ShardNameFrom(req.Context())
+		if shard == "" {
+			delegate.ServeHTTP(w, req)
+			return
+		}
+
+		translate := func(s string) (string, bool) {
+			rvs, ok := parseCompositeResourceVersion(s)
+			if !ok {
+				return s, false
+			}
+			return rvs[shard], true
+		}
+
+		query := req.URL.Query()
+		if rv, ok := translate(query.Get("resourceVersion")); ok {
+			if rv == "" {
+				query.Del("resourceVersion")
+			} else {
+				query.Set("resourceVersion", rv)
+			}
+			req.URL.RawQuery = query.Encode()
+		}
+
+		switch req.Method {
+		case http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			delegate.ServeHTTP(w, req)
+			return
+		}
+		if req.Body == nil || req.Body == http.NoBody || strings.Contains(req.Header.Get("Content-Type"), "protobuf") {
+			delegate.ServeHTTP(w, req)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(req.Body, maxTranslatedBodyBytes+1))
+		if err != nil {
+			responsewriters.ErrorNegotiated(apierrors.NewBadRequest(fmt.Sprintf("unable to read request body: %v", err)), kubernetesscheme.Codecs, schema.GroupVersion{}, w, req)
+			return
+		}
+		if len(body) > maxTranslatedBodyBytes {
+			req.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(body), req.Body), req.Body}
+			delegate.ServeHTTP(w, req)
+			return
+		}
+		req.Body.Close()
+
+		if bytes.Contains(body, []byte(compositeResourceVersionPrefix)) {
+			body = compositeResourceVersionPattern.ReplaceAllFunc(body, func(match []byte) []byte {
+				if rv, ok := translate(string(match)); ok {
+					return []byte(rv)
+				}
+				return match
+			})
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+		req.Header.Set("Content-Length", strconv.Itoa(len(body)))
+
+		delegate.ServeHTTP(w, req)
+	}
+}
+
+func cloneQuery(query url.Values) url.Values {
+	out := make(url.Values, len(query))
+	for k, v := range query {
+		out[k] = append([]string(nil), v...)
+	}
+	return out
+}
+
+// copyResponse passes an error response of a shard on to the client.
+func copyResponse(w http.ResponseWriter, resp *http.Response) {
+	defer resp.Body.Close()
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = io.Copy(w, resp.Body)
+}
@@ -0,0 +1,392 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kcp-dev/kcp/pkg/proxy/lookup"
+)
+
+func TestCompositeResourceVersion(t *testing.T) {
+	rv := compositeResourceVersion{"alpha": "10", "beta": "20"}
+
+	parsed, ok := parseCompositeResourceVersion(rv.String())
+	require.True(t, ok)
+	require.Equal(t, rv, parsed)
+
+	for _, s := range []string{"", "0", "12345", "kcp.shards.", "kcp.shards.!!!"} {
+		_, ok := parseCompositeResourceVersion(s)
+		require.False(t, ok, "expected %q not to be a composite resourceVersion", s)
+	}
+}
+
+// newListShard serves a list of the given object names at the given resourceVersion, paginated by limit
+// with the index of the next item as continue token.
+func newListShard(t *testing.T, resourceVersion string, names ...string) (*httptest.Server, *[]url.Values) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		queries = append(queries, query)
+		require.Equal(t, "application/json", req.Header.Get("Accept"))
+		require.Empty(t, req.Header.Get(lookup.PartitionHeader))
+
+		start := 0
+		if c := query.Get("continue"); c != "" {
+			start, _ = strconv.Atoi(c)
+		}
+		end := len(names)
+		if limit, _ := strconv.Atoi(query.Get("limit")); limit > 0 && start+limit < end {
+			end = start + limit
+		}
+
+		items := []string{}
+		for _, name := range names[start:end] {
+			items = append(items, fmt.Sprintf(`{"metadata":{"name":%q,"resourceVersion":"1"}}`, name))
+		}
+		cont := ""
+		if end < len(names) {
+			cont = strconv.Itoa(end)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"apiVersion":"v1","kind":"ConfigMapList","metadata":{"resourceVersion":%q,"continue":%q},"items":[%s]}`, resourceVersion, cont, strings.Join(items, ","))
+	}))
+	t.Cleanup(server.Close)
+	return server, &queries
+}
+
+func fanOutRequest(t *testing.T, watch bool, query string, shards ...*httptest.Server) *http.Request {
+	fanOut := &lookup.FanOut{Watch: watch}
+	for i, server := range shards {
+		u, err := url.Parse(server.URL + "/clusters/*/api/v1/configmaps")
+		require.NoError(t, err)
+		fanOut.Shards = append(fanOut.Shards, lookup.Shard{Name: string(rune('a' + i)), URL: u})
+	}
+	req := httptest.NewRequest(http.MethodGet, "/clusters/*/api/v1/configmaps?"+query, nil)
+	req.Header.Set(lookup.PartitionHeader, "eu")
+	return req.WithContext(lookup.WithFanOut(req.Context(), fanOut))
+}
+
+func decodeList(t *testing.T, rec *httptest.ResponseRecorder) (names []string, resourceVersion compositeResourceVersion, cont string) {
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var list struct {
+		Kind     string `json:"kind"`
+		Metadata struct {
+			ResourceVersion string `json:"resourceVersion"`
+			Continue        string `json:"continue"`
+		} `json:"metadata"`
+		Items []struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+		} `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Equal(t, "ConfigMapList", list.Kind)
+	names = []string{}
+	for _, item := range list.Items {
+		names = append(names, item.Metadata.Name)
+	}
+	rv, ok := parseCompositeResourceVersion(list.Metadata.ResourceVersion)
+	require.True(t, ok, "expected composite resourceVersion, got %q", list.Metadata.ResourceVersion)
+	return names, rv, list.Metadata.Continue
+}
+
+func TestFanOutList(t *testing.T) {
+	shardA, queriesA := newListShard(t, "10", "a1", "a2", "a3")
+	shardB, queriesB := newListShard(t, "20", "b1")
+	handler := newFanOutHandler(http.DefaultTransport, nil)
+
+	t.Run("all items", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, fanOutRequest(t, false, "", shardA, shardB))
+
+		names, rv, cont := decodeList(t, rec)
+		require.Equal(t, []string{"a1", "a2", "a3", "b1"}, names)
+		require.Equal(t, compositeResourceVersion{"a": "10", "b": "20"}, rv)
+		require.Empty(t, cont)
+	})
+
+	t.Run("composite resourceVersion is split", func(t *testing.T) {
+		*queriesA, *queriesB = nil, nil
+		rv := compositeResourceVersion{"a": "5"}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, fanOutRequest(t, false, "resourceVersionMatch=NotOlderThan&resourceVersion="+rv.String(), shardA, shardB))
+
+		decodeList(t, rec)
+		require.Equal(t, "5", (*queriesA)[0].Get("resourceVersion"))
+		require.Equal(t, "NotOlderThan", (*queriesA)[0].Get("resourceVersionMatch"))
+		require.Empty(t, (*queriesB)[0].Get("resourceVersion"), "unknown shard must be listed at its latest resourceVersion")
+		require.Empty(t, (*queriesB)[0].Get("resourceVersionMatch"))
+	})
+
+	t.Run("shard resourceVersion is expired", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, fanOutRequest(t, false, "resourceVersion=10", shardA, shardB))
+		require.Equal(t, http.StatusGone, rec.Code)
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		var pages [][]string
+		cont := ""
+		for {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, fanOutRequest(t, false, "limit=2&continue="+url.QueryEscape(cont), shardA, shardB))
+
+			var names []string
+			var rv compositeResourceVersion
+			names, rv, cont = decodeList(t, rec)
+			pages = append(pages, names)
+			if cont == "" {
+				require.Equal(t, compositeResourceVersion{"a": "10", "b": "20"}, rv)
+				break
+			}
+			require.Less(t, len(pages), 5, "pagination does not terminate")
+		}
+		require.Equal(t, [][]string{{"a1", "a2"}, {"a3", "b1"}}, pages)
+	})
+
+	t.Run("shard error", func(t *testing.T) {
+		forbidden := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Forbidden","code":403}`))
+		}))
+		defer forbidden.Close()
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, fanOutRequest(t, false, "", shardA, forbidden))
+		require.Equal(t, http.StatusForbidden, rec.Code)
+		require.Contains(t, rec.Body.String(), `"reason":"Forbidden"`)
+	})
+}
+
+// newWatchShard streams the given events, then the events sent on more, keeps the watch open until the
+// test is done, and records the query of the watch request.
+func newWatchShard(t *testing.T, more <-chan string, events ...string) (*httptest.Server, *url.Values) {
+	query := &url.Values{}
+	stop := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		*query = req.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		for _, event := range events {
+			_, _ = w.Write([]byte(event + "\n"))
+		}
+		w.(http.Flusher).Flush()
+		for {
+			select {
+			case event := <-more:
+				_, _ = w.Write([]byte(event + "\n"))
+				w.(http.Flusher).Flush()
+			case <-stop:
+				return
+			case <-req.Context().Done():
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(stop) })
+	return server, query
+}
+
+func TestFanOutWatch(t *testing.T) {
+	initialEventsEnd := func(rv string) string {
+		return fmt.Sprintf(`{"type":"BOOKMARK","object":{"kind":"ConfigMap","metadata":{"resourceVersion":%q,"annotations":{"k8s.io/initial-events-end":"true"}}}}`, rv)
+	}
+	shardA, queryA := newWatchShard(t, nil,
+		`{"type":"ADDED","object":{"kind":"ConfigMap","metadata":{"name":"a1","resourceVersion":"11"},"data":{"size":"12345678901234567890"}}}`,
+		initialEventsEnd("12"),
+	)
+	moreB := make(chan string, 1)
+	shardB, queryB := newWatchShard(t, moreB, initialEventsEnd("21"))
+	shardC, queryC := newWatchShard(t, nil, initialEventsEnd("31"))
+
+	rv := compositeResourceVersion{"a": "10", "b": "20"}
+	fanOutReq := fanOutRequest(t, true, "", shardA, shardB, shardC)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		newFanOutHandler(http.DefaultTransport, nil).ServeHTTP(w, req.WithContext(lookup.WithFanOut(req.Context(), lookup.FanOutFrom(fanOutReq.Context()))))
+	}))
+	defer proxy.Close()
+
+	resp, err := http.Get(proxy.URL + "/clusters/*/api/v1/configmaps?watch=true&sendInitialEvents=true&resourceVersion=" + rv.String())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	type event struct {
+		Type   string `json:"type"`
+		Object struct {
+			Metadata struct {
+				Name            string `json:"name"`
+				ResourceVersion string `json:"resourceVersion"`
+			} `json:"metadata"`
+			Data map[string]json.RawMessage `json:"data"`
+		} `json:"object"`
+	}
+	var events []event
+	scanner := bufio.NewScanner(resp.Body)
+	next := func() {
+		t.Helper()
+		require.True(t, scanner.Scan(), "watch ended early: %v", scanner.Err())
+		var e event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e), scanner.Text())
+		events = append(events, e)
+	}
+
+	// The end of initial events is sent once after all shards sent theirs, and shard b only sends
+	// its next event after that.
+	next()
+	next()
+	require.Equal(t, []string{"ADDED", "BOOKMARK"}, []string{events[0].Type, events[1].Type})
+	moreB <- `{"type":"MODIFIED","object":{"kind":"ConfigMap","metadata":{"name":"b1","resourceVersion":"22"}}}`
+	next()
+	require.Equal(t, "MODIFIED", events[2].Type)
+
+	require.Equal(t, "10", queryA.Get("resourceVersion"))
+	require.Equal(t, "20", queryB.Get("resourceVersion"))
+	require.Equal(t, "0", queryC.Get("resourceVersion"), "unknown shard must be watched from any resourceVersion")
+
+	// Events of one shard are in order, and the end of initial events carries the resourceVersions
+	// of the initial events end of all shards.
+	lastRV := map[string]string{}
+	for _, e := range events {
+		composite, ok := parseCompositeResourceVersion(e.Object.Metadata.ResourceVersion)
+		require.True(t, ok, "expected composite resourceVersion, got %q", e.Object.Metadata.ResourceVersion)
+		switch e.Type {
+		case "BOOKMARK":
+			require.Equal(t, "12", composite["a"])
+			require.Equal(t, "21", composite["b"])
+			require.Equal(t, "31", composite["c"])
+		case "ADDED":
+			require.Equal(t, "a1", e.Object.Metadata.Name)
+			require.Equal(t, "11", composite["a"])
+			require.Equal(t, `"12345678901234567890"`, string(e.Object.Data["size"]))
+		case "MODIFIED":
+			require.Equal(t, "b1", e.Object.Metadata.Name)
+			require.Equal(t, "22", composite["b"])
+		default:
+			t.Fatalf("unexpected event %q", e.Type)
+		}
+		for shard, rv := range composite {
+			require.GreaterOrEqual(t, rv, lastRV[shard], "resourceVersion of shard %s went backwards", shard)
+			lastRV[shard] = rv
+		}
+	}
+}
+
+func TestWithCompositeResourceVersions(t *testing.T) {
+	rv := compositeResourceVersion{"a": "10", "b": "20"}
+
+	tests := map[string]struct {
+		shard       string
+		method      string
+		query       string
+		contentType string
+		body        string
+
+		wantQuery string
+		wantBody  string
+	}{
+		"update": {
+			shard:       "b",
+			method:      http.MethodPut,
+			contentType: "application/json",
+			body:        `{"metadata":{"name":"foo","resourceVersion":"` + rv.String() + `"}}`,
+			wantBody:    `{"metadata":{"name":"foo","resourceVersion":"20"}}`,
+		},
+		"apply": {
+			shard:       "a",
+			method:      http.MethodPatch,
+			contentType: "application/apply-patch+yaml",
+			body:        "metadata:\n  name: foo\n  resourceVersion: " + rv.String() + "\n",
+			wantBody:    "metadata:\n  name: foo\n  resourceVersion: 10\n",
+		},
+		"native resourceVersion": {
+			shard:       "a",
+			method:      http.MethodPut,
+			contentType: "application/json",
+			body:        `{"metadata":{"name":"foo","resourceVersion":"3","labels":{"kcp.shards.x":"y"}}}`,
+			wantBody:    `{"metadata":{"name":"foo","resourceVersion":"3","labels":{"kcp.shards.x":"y"}}}`,
+		},
+		"protobuf": {
+			shard:       "a",
+			method:      http.MethodPut,
+			contentType: "application/vnd.kubernetes.protobuf",
+			body:        rv.String(),
+			wantBody:    rv.String(),
+		},
+		"get": {
+			shard:     "b",
+			method:    http.MethodGet,
+			query:     "resourceVersion=" + rv.String(),
+			wantQuery: "resourceVersion=20",
+		},
+		"not routed to a shard": {
+			method:    http.MethodGet,
+			query:     "resourceVersion=" + rv.String(),
+			wantQuery: "resourceVersion=" + rv.String(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotQuery, gotBody string
+			handler := withCompositeResourceVersions(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				gotQuery = req.URL.RawQuery
+				if req.Body != nil {
+					body, err := io.ReadAll(req.Body)
+					require.NoError(t, err)
+					gotBody = string(body)
+					require.Equal(t, int64(len(body)), req.ContentLength)
+				}
+			}))
+
+			var body io.Reader
+			if tc.body != "" {
+				body = strings.NewReader(tc.body)
+			}
+			target := "/clusters/root/api/v1/namespaces/default/configmaps/foo"
+			if tc.query != "" {
+				target += "?" + tc.query
+			}
+			req := httptest.NewRequest(tc.method, target, body)
+			if tc.contentType != "" {
+				req.Header.Set("Content-Type", tc.contentType)
+			}
+			if tc.shard != "" {
+				req = req.WithContext(lookup.WithShardName(req.Context(), tc.shard))
+			}
+
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+			require.Equal(t, tc.wantQuery, gotQuery)
+			require.Equal(t, tc.wantBody, gotBody)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filters
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+	kaudit "k8s.io/apiserver/pkg/audit"
+	genericapifilters "k8s.io/apiserver/pkg/endpoints/filters"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	genericfilters "k8s.io/apiserver/pkg/server/filters"
+
+	"github.com/kcp-dev/sdk/apis/core"
+
+	"github.com/kcp-dev/kcp/pkg/proxy/lookup"
+	"github.com/kcp-dev/kcp/pkg/server/requestinfo"
+)
+
+const (
+	clusterAuditAnnotation = "kcp.io/cluster"
+	shardAuditAnnotation   = request.ShardAnnotationKey
+)
+
+var longRunningRequestCheck = genericfilters.BasicLongRunningRequestCheck(sets.NewString("watch"), sets.NewString())
+
+// WithAuditing records audit events of the requests passing through the front-proxy
+// according to the audit policy. It must run after authentication and after the
+// cluster resolver, and needs an audit context initialized by WithAuditInit.
+//
+// The events carry the requested logical cluster path, the resolved logical cluster
+// and the target shard as annotations. The audit ID is passed on to the shard, so
+// that the events of the front-proxy and of the shard can be correlated.
+func WithAuditing(handler http.Handler, sink kaudit.Sink, policy kaudit.PolicyRuleEvaluator) http.Handler {
+	if sink == nil || policy == nil {
+		return handler
+	}
+
+	handler = withAuditAnnotations(handler)
+	handler = genericapifilters.WithAudit(handler, sink, policy, longRunningRequestCheck)
+	return withClusterRequestInfo(handler)
+}
+
+// WithFailedAuthenticationAuditing records audit events of requests that failed
+// authentication at the front-proxy.
+func WithFailedAuthenticationAuditing(failed http.Handler, sink kaudit.Sink, policy kaudit.PolicyRuleEvaluator) http.Handler {
+	if sink == nil || policy == nil {
+		return failed
+	}
+
+	failed = genericapifilters.WithFailedAuthenticationAudit(failed, sink, policy)
+	return withClusterRequestInfo(failed)
+}
+
+func withAuditAnnotations(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+
+		if path := lookup.RequestedClusterPathFrom(ctx); !path.Empty() {
+			kaudit.AddAuditAnnotation(ctx, core.LogicalClusterPathAnnotationKey, path.String())
+		}
+		if cluster := lookup.ClusterNameFrom(ctx); !cluster.Empty() {
+			kaudit.AddAuditAnnotation(ctx, clusterAuditAnnotation, cluster.String())
+		}
+		if shard := lookup.ShardNameFrom(ctx); shard != "" {
+			kaudit.AddAuditAnnotation(ctx, shardAuditAnnotation, shard)
+		} else if fanOut := lookup.FanOutFrom(ctx); fanOut != nil {
+			shards := make([]string, 0, len(fanOut.Shards))
+			for _, s := range fanOut.Shards {
+				shards = append(shards, s.Name)
+			}
+			kaudit.AddAuditAnnotation(ctx, shardAuditAnnotation, strings.Join(shards, ","))
+		}
+
+		if auditID := kaudit.GetAuditIDTruncated(ctx); auditID != "" {
+			req.Header.Set(auditinternal.HeaderAuditID, auditID)
+		}
+
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// withClusterRequestInfo replaces the request info of a request to a logical cluster
+// with the request info of the path within the logical cluster, e.g. of /api/v1/pods
+// for /clusters/root:org/api/v1/pods, so that audit policy rules match on verbs and
+// resources.
+func withClusterRequestInfo(handler http.Handler) http.Handler {
+	factory := requestinfo.NewFactory()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		const clustersPrefix = "/clusters/"
+		idx := strings.Index(req.URL.Path, clustersPrefix)
+		if idx == -1 {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		trail := req.URL.Path[idx+len(clustersPrefix):]
+		if i := strings.Index(trail, "/"); i != -1 {
+			trail = trail[i:]
+		} else {
+			trail = "/"
+		}
+
+		info, err := factory.NewRequestInfo(&http.Request{
+			Method: req.Method,
+			URL:    &url.URL{Path: trail, RawQuery: req.URL.RawQuery},
+		})
+		if err != nil {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		info.Path = req.URL.Path
+
+		handler.ServeHTTP(w, req.WithContext(request.WithRequestInfo(req.Context(), info)))
+	})
+}
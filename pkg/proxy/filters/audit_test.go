@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filters
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+	"k8s.io/apiserver/pkg/audit/policy"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapifilters "k8s.io/apiserver/pkg/endpoints/filters"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+
+	"github.com/kcp-dev/kcp/pkg/proxy/lookup"
+	"github.com/kcp-dev/kcp/pkg/server/requestinfo"
+)
+
+type fakeAuditSink struct {
+	lock   sync.Mutex
+	events []*auditinternal.Event
+}
+
+func (s *fakeAuditSink) ProcessEvents(events ...*auditinternal.Event) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, e := range events {
+		s.events = append(s.events, e.DeepCopy())
+	}
+	return true
+}
+
+func TestWithAuditing(t *testing.T) {
+	sink := &fakeAuditSink{}
+	evaluator := policy.NewPolicyRuleEvaluator(&auditinternal.Policy{
+		OmitStages: []auditinternal.Stage{auditinternal.StageRequestReceived},
+		Rules: []auditinternal.PolicyRule{
+			{
+				Level:     auditinternal.LevelMetadata,
+				Verbs:     []string{"list"},
+				Resources: []auditinternal.GroupResources{{Group: "", Resources: []string{"configmaps"}}},
+			},
+		},
+	})
+
+	var forwardedAuditID string
+	handler := WithAuditing(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		forwardedAuditID = req.Header.Get(auditinternal.HeaderAuditID)
+		w.WriteHeader(http.StatusTeapot)
+	}), sink, evaluator)
+
+	// simulate the cluster resolver and the authentication in front of the auditing.
+	handler = func(delegate http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			ctx := lookup.WithRequestedClusterPath(req.Context(), logicalcluster.NewPath("root:org"))
+			ctx = lookup.WithClusterName(ctx, "2x9tf5gh")
+			ctx = lookup.WithShardName(ctx, "shard-1")
+			ctx = request.WithUser(ctx, &user.DefaultInfo{
+				Name:   "oidc:alice",
+				Groups: []string{"oidc:team"},
+				Extra:  map[string][]string{"authentication.kcp.io/cluster-name": {"2x9tf5gh"}},
+			})
+			delegate.ServeHTTP(w, req.WithContext(ctx))
+		})
+	}(handler)
+	handler = genericapifilters.WithAuditInit(handler)
+	handler = genericapifilters.WithRequestInfo(handler, requestinfo.NewFactory())
+
+	t.Log("Requests not matched by the policy are not audited")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/clusters/root:org/api/v1/namespaces/default/secrets", nil))
+	require.Equal(t, http.StatusTeapot, rec.Code)
+	require.Empty(t, sink.events)
+
+	t.Log("Requests matched by the policy are audited with the resolved cluster, shard and user")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/clusters/root:org/api/v1/namespaces/default/configmaps?limit=10", nil))
+	require.Equal(t, http.StatusTeapot, rec.Code)
+	require.Len(t, sink.events, 1)
+
+	event := sink.events[0]
+	require.Equal(t, auditinternal.StageResponseComplete, event.Stage)
+	require.Equal(t, "list", event.Verb)
+	require.Equal(t, "/clusters/root:org/api/v1/namespaces/default/configmaps?limit=10", event.RequestURI)
+	require.NotNil(t, event.ObjectRef)
+	require.Equal(t, "configmaps", event.ObjectRef.Resource)
+	require.Equal(t, "default", event.ObjectRef.Namespace)
+	require.Equal(t, "oidc:alice", event.User.Username)
+	require.Equal(t, []string{"oidc:team"}, event.User.Groups)
+	require.Equal(t, int32(http.StatusTeapot), event.ResponseStatus.Code)
+	require.Equal(t, map[string]string{
+		"kcp.io/path":    "root:org",
+		"kcp.io/cluster": "2x9tf5gh",
+		"kcp.io/shard":   "shard-1",
+	}, event.Annotations)
+
+	require.Equal(t, string(event.AuditID), forwardedAuditID, "the audit ID is passed on to the shard")
+	require.Equal(t, string(event.AuditID), rec.Header().Get(auditinternal.HeaderAuditID))
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filters
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/lru"
+
+	"github.com/kcp-dev/kcp/pkg/proxy/lookup"
+	"github.com/kcp-dev/kcp/pkg/proxy/metrics"
+	proxyoptions "github.com/kcp-dev/kcp/pkg/proxy/options"
+)
+
+// maxRateLimitKeys bounds the number of token buckets kept per limit. The least
+// recently used bucket is dropped first, which starts over with a full bucket
+// the next time its key shows up.
+const maxRateLimitKeys = 10000
+
+// WithRateLimiting rejects requests with 429 Too Many Requests that exceed the
+// token bucket of their logical cluster, their user or one of their groups.
+// It must run after authentication; requests without a user in the context are
+// only subject to the logical cluster limit.
+func WithRateLimiting(handler http.Handler, opts *proxyoptions.RateLimiting) http.Handler {
+	if !opts.Enabled() {
+		return handler
+	}
+
+	var (
+		clusterLimits = newKeyedLimiter("cluster", opts.ClusterQPS, opts.ClusterBurst)
+		userLimits    = newKeyedLimiter("user", opts.UserQPS, opts.UserBurst)
+		groupLimits   = newKeyedLimiter("group", opts.GroupQPS, opts.GroupBurst)
+		limitedGroups = opts.Groups
+		exemptGroups  = opts.ExemptGroups
+	)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		u, hasUser := request.UserFrom(req.Context())
+		if hasUser && slices.ContainsFunc(u.GetGroups(), func(g string) bool { return slices.Contains(exemptGroups, g) }) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+
+		now := time.Now()
+		var reservations []*rate.Reservation
+		reject := func(limiter *keyedLimiter, key string, delay time.Duration) {
+			// give back the tokens taken from the other buckets, the request is not served.
+			for _, r := range reservations {
+				r.CancelAt(now)
+			}
+
+			metrics.RecordRateLimitedRequest(limiter.name)
+			klog.FromContext(req.Context()).V(4).Info("Rate limited request", "limit", limiter.name, "key", key, "delay", delay)
+
+			retryAfter := int(math.Ceil(delay.Seconds()))
+			if retryAfter < 1 {
+				retryAfter = 1
+			}
+			err := apierrors.NewTooManyRequests(fmt.Sprintf("the %s rate limit of the front-proxy has been exceeded, please try again later", limiter.name), retryAfter)
+			responsewriters.ErrorNegotiated(err, kubernetesscheme.Codecs, schema.GroupVersion{}, w, req)
+		}
+		take := func(limiter *keyedLimiter, key string) bool {
+			if limiter == nil || key == "" {
+				return true
+			}
+			r, delay := limiter.reserve(key, now)
+			if delay > 0 {
+				reject(limiter, key, delay)
+				return false
+			}
+			reservations = append(reservations, r)
+			return true
+		}
+
+		if !take(clusterLimits, lookup.ClusterPathFrom(req)) {
+			return
+		}
+		if hasUser {
+			if !take(userLimits, u.GetName()) {
+				return
+			}
+			for _, g := range u.GetGroups() {
+				if slices.Contains(limitedGroups, g) && !take(groupLimits, g) {
+					return
+				}
+			}
+		}
+
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// keyedLimiter is a set of token buckets with the same rate and burst, one per key.
+type keyedLimiter struct {
+	name  string
+	limit rate.Limit
+	burst int
+
+	lock     sync.Mutex
+	limiters *lru.Cache
+}
+
+func newKeyedLimiter(name string, qps float32, burst int) *keyedLimiter {
+	if qps <= 0 {
+		return nil
+	}
+	return &keyedLimiter{
+		name:     name,
+		limit:    rate.Limit(qps),
+		burst:    burst,
+		limiters: lru.New(maxRateLimitKeys),
+	}
+}
+
+// reserve takes a token from the bucket of the given key. If no token is
+// available, the reservation is cancelled and the time until the next token
+// is available is returned.
+func (l *keyedLimiter) reserve(key string, now time.Time) (*rate.Reservation, time.Duration) {
+	l.lock.Lock()
+	var limiter *rate.Limiter
+	if obj, found := l.limiters.Get(key); found {
+		limiter = obj.(*rate.Limiter)
+	} else {
+		limiter = rate.NewLimiter(l.limit, l.burst)
+		l.limiters.Add(key, limiter)
+	}
+	l.lock.Unlock()
+
+	r := limiter.ReserveN(now, 1)
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return nil, delay
+	}
+	return r, 0
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filters
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	proxyoptions "github.com/kcp-dev/kcp/pkg/proxy/options"
+)
+
+func TestWithRateLimiting(t *testing.T) {
+	type call struct {
+		path   string
+		user   *user.DefaultInfo
+		status int
+	}
+
+	alice := &user.DefaultInfo{Name: "alice", Groups: []string{"team-a", "system:authenticated"}}
+	bob := &user.DefaultInfo{Name: "bob", Groups: []string{"team-a", "system:authenticated"}}
+	admin := &user.DefaultInfo{Name: "admin", Groups: []string{"admins"}}
+
+	tests := map[string]struct {
+		opts  proxyoptions.RateLimiting
+		calls []call
+	}{
+		"disabled": {
+			opts: proxyoptions.RateLimiting{},
+			calls: []call{
+				{path: "/clusters/root:a/api", user: alice, status: http.StatusOK},
+				{path: "/clusters/root:a/api", user: alice, status: http.StatusOK},
+			},
+		},
+		"per cluster": {
+			opts: proxyoptions.RateLimiting{ClusterQPS: 0.001, ClusterBurst: 1},
+			calls: []call{
+				{path: "/clusters/root:a/api", user: alice, status: http.StatusOK},
+				{path: "/clusters/root:a/api", user: bob, status: http.StatusTooManyRequests},
+				{path: "/clusters/root:b/api", user: alice, status: http.StatusOK},
+				{path: "/clusters/root:b/api", status: http.StatusTooManyRequests},
+			},
+		},
+		"per user": {
+			opts: proxyoptions.RateLimiting{UserQPS: 0.001, UserBurst: 1},
+			calls: []call{
+				{path: "/clusters/root:a/api", user: alice, status: http.StatusOK},
+				{path: "/clusters/root:b/api", user: alice, status: http.StatusTooManyRequests},
+				{path: "/clusters/root:a/api", user: bob, status: http.StatusOK},
+				{path: "/clusters/root:a/api", status: http.StatusOK},
+			},
+		},
+		"per group": {
+			opts: proxyoptions.RateLimiting{GroupQPS: 0.001, GroupBurst: 1, Groups: []string{"team-a"}},
+			calls: []call{
+				{path: "/clusters/root:a/api", user: alice, status: http.StatusOK},
+				{path: "/clusters/root:a/api", user: bob, status: http.StatusTooManyRequests},
+				{path: "/clusters/root:a/api", user: admin, status: http.StatusOK},
+				{path: "/clusters/root:a/api", user: admin, status: http.StatusOK},
+			},
+		},
+		"rejected request does not take from other buckets": {
+			opts: proxyoptions.RateLimiting{ClusterQPS: 0.001, ClusterBurst: 2, UserQPS: 0.001, UserBurst: 1},
+			calls: []call{
+				{path: "/clusters/root:a/api", user: alice, status: http.StatusOK},
+				{path: "/clusters/root:a/api", user: alice, status: http.StatusTooManyRequests},
+				{path: "/clusters/root:a/api", user: bob, status: http.StatusOK},
+				{path: "/clusters/root:a/api", user: admin, status: http.StatusTooManyRequests},
+			},
+		},
+		"exempt groups": {
+			opts: proxyoptions.RateLimiting{ClusterQPS: 0.001, ClusterBurst: 1, ExemptGroups: []string{"admins"}},
+			calls: []call{
+				{path: "/clusters/root:a/api", user: admin, status: http.StatusOK},
+				{path: "/clusters/root:a/api", user: admin, status: http.StatusOK},
+				{path: "/clusters/root:a/api", user: alice, status: http.StatusOK},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			handler := WithRateLimiting(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}), &tc.opts)
+
+			for i, c := range tc.calls {
+				req := httptest.NewRequest(http.MethodGet, c.path, nil)
+				if c.user != nil {
+					req = req.WithContext(request.WithUser(req.Context(), c.user))
+				}
+				rec := httptest.NewRecorder()
+				handler.ServeHTTP(rec, req)
+
+				require.Equal(t, c.status, rec.Code, "call %d", i)
+				if c.status == http.StatusTooManyRequests {
+					require.NotEmpty(t, rec.Header().Get("Retry-After"), "call %d", i)
+				}
+			}
+		})
+	}
+}
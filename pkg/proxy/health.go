@@ -0,0 +1,329 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/kcp/pkg/proxy/metrics"
+)
+
+const (
+	shardHealthKind   = "shard"
+	backendHealthKind = "backend"
+
+	// healthProbeTimeout is the timeout of a single active health check.
+	healthProbeTimeout = 5 * time.Second
+)
+
+// healthTarget is an endpoint checked actively by the health checker.
+type healthTarget struct {
+	kind      string
+	name      string
+	url       *url.URL
+	transport http.RoundTripper
+}
+
+// healthTargetName identifies a shard or mapping backend in the metrics.
+type healthTargetName struct {
+	kind string
+	name string
+}
+
+// endpointHealth is the health of one endpoint. Several shards and mapping
+// backends can share the endpoint, e.g. a shard that is also a mapping backend,
+// and all of them report its health.
+type endpointHealth struct {
+	targets  sets.Set[healthTargetName]
+	failures int
+	// unhealthyUntil is the time until no traffic is sent to the endpoint. After
+	// that, requests are let through again, and the next failure marks it
+	// unhealthy again right away.
+	unhealthyUntil time.Time
+}
+
+// healthChecker tracks the health of shards and mapping backends. Endpoints are
+// checked passively by the requests proxied to them: after failureThreshold
+// consecutive connection errors, an endpoint is considered unhealthy for the
+// cooldown. Active checks probe the /readyz endpoint of all known shards and
+// backends periodically. A nil healthChecker considers all endpoints healthy.
+type healthChecker struct {
+	failureThreshold int
+	cooldown         time.Duration
+	shardURLs        func() (map[string]string, error)
+	now              func() time.Time
+
+	lock           sync.Mutex
+	endpoints      map[string]*endpointHealth
+	backends       []healthTarget
+	shardTransport http.RoundTripper
+}
+
+func newHealthChecker(failureThreshold int, cooldown time.Duration, shardURLs func() (map[string]string, error)) *healthChecker {
+	return &healthChecker{
+		failureThreshold: failureThreshold,
+		cooldown:         cooldown,
+		shardURLs:        shardURLs,
+		now:              time.Now,
+		endpoints:        map[string]*endpointHealth{},
+	}
+}
+
+// setBackends sets the mapping backends to check actively, and the transport
+// to check the shards with, nil if there is no shard mapping.
+func (h *healthChecker) setBackends(backends []healthTarget, shardTransport http.RoundTripper) {
+	if h == nil {
+		return
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.backends = backends
+	h.shardTransport = shardTransport
+}
+
+// retryAfter returns how long the endpoint is still considered unhealthy, or
+// zero if it is healthy.
+func (h *healthChecker) retryAfter(u *url.URL) time.Duration {
+	if h == nil {
+		return 0
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	e, ok := h.endpoints[endpointKey(u)]
+	if !ok {
+		return 0
+	}
+	if d := e.unhealthyUntil.Sub(h.now()); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// observe records the outcome of a request proxied to the endpoint. Errors
+// caused by the client going away are ignored.
+func (h *healthChecker) observe(ctx context.Context, kind, name string, u *url.URL, err error) {
+	if h == nil || ctx.Err() != nil {
+		return
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	e := h.endpointLocked(kind, name, u)
+	if err == nil {
+		h.setHealthyLocked(e)
+		return
+	}
+
+	e.failures++
+	if e.failures >= h.failureThreshold {
+		if e.unhealthyUntil.IsZero() {
+			klog.FromContext(ctx).Info("Marking endpoint unhealthy", "kind", kind, "name", name, "url", endpointKey(u), "err", err)
+		}
+		h.setUnhealthyLocked(e)
+	}
+}
+
+// Start probes all shards and mapping backends every interval until the context is done.
+func (h *healthChecker) Start(ctx context.Context, interval time.Duration) {
+	logger := klog.FromContext(ctx).WithValues("component", "health-checker")
+	ctx = klog.NewContext(ctx, logger)
+
+	logger.Info("Starting active health checks", "interval", interval)
+	defer logger.Info("Stopped active health checks")
+
+	wait.UntilWithContext(ctx, h.probeAll, interval)
+}
+
+// probeAll probes all targets concurrently and forgets endpoints that are not a target anymore.
+func (h *healthChecker) probeAll(ctx context.Context) {
+	targets := h.targets(ctx)
+
+	var wg sync.WaitGroup
+	for _, t := range targets {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			h.probe(ctx, t)
+		}()
+	}
+	wg.Wait()
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	current := make(map[string]sets.Set[healthTargetName], len(targets))
+	for _, t := range targets {
+		key := endpointKey(t.url)
+		if current[key] == nil {
+			current[key] = sets.New[healthTargetName]()
+		}
+		current[key].Insert(healthTargetName{kind: t.kind, name: t.name})
+	}
+	for key, e := range h.endpoints {
+		for n := range e.targets {
+			if !current[key].Has(n) {
+				e.targets.Delete(n)
+				metrics.DeleteBackendHealth(n.kind, n.name)
+			}
+		}
+		if e.targets.Len() == 0 {
+			delete(h.endpoints, key)
+		}
+	}
+}
+
+func (h *healthChecker) targets(ctx context.Context) []healthTarget {
+	h.lock.Lock()
+	targets := append([]healthTarget(nil), h.backends...)
+	shardTransport := h.shardTransport
+	h.lock.Unlock()
+
+	if shardTransport == nil {
+		return targets
+	}
+	shardURLs, err := h.shardURLs()
+	if err != nil {
+		klog.FromContext(ctx).Error(err, "Failed to list shards for health checks")
+		return targets
+	}
+	for name, baseURL := range shardURLs {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			continue
+		}
+		targets = append(targets, healthTarget{kind: shardHealthKind, name: name, url: u, transport: shardTransport})
+	}
+	return targets
+}
+
+// probe sends a readiness request to the target. Any response but a server
+// error means that the endpoint is reachable and ready.
+func (h *healthChecker) probe(ctx context.Context, t healthTarget) {
+	ctx, cancel := context.WithTimeout(ctx, healthProbeTimeout)
+	defer cancel()
+
+	probeURL := *t.url
+	probeURL.Path = "/readyz"
+	probeURL.RawQuery = ""
+	err := func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, probeURL.String(), nil)
+		if err != nil {
+			return err
+		}
+		resp, err := t.transport.RoundTrip(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("readiness check returned %s", resp.Status)
+		}
+		return nil
+	}()
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	e := h.endpointLocked(t.kind, t.name, t.url)
+	if err == nil {
+		if !e.unhealthyUntil.IsZero() {
+			klog.FromContext(ctx).Info("Endpoint is healthy again", "kind", t.kind, "name", t.name, "url", endpointKey(t.url))
+		}
+		h.setHealthyLocked(e)
+		return
+	}
+	if e.unhealthyUntil.IsZero() {
+		klog.FromContext(ctx).Info("Marking endpoint unhealthy", "kind", t.kind, "name", t.name, "url", endpointKey(t.url), "err", err)
+	}
+	e.failures = max(e.failures, h.failureThreshold)
+	h.setUnhealthyLocked(e)
+}
+
+func (h *healthChecker) endpointLocked(kind, name string, u *url.URL) *endpointHealth {
+	key := endpointKey(u)
+	e, ok := h.endpoints[key]
+	if !ok {
+		e = &endpointHealth{targets: sets.New[healthTargetName]()}
+		h.endpoints[key] = e
+	}
+	if n := (healthTargetName{kind: kind, name: name}); !e.targets.Has(n) {
+		e.targets.Insert(n)
+		metrics.SetBackendHealth(kind, name, e.unhealthyUntil.IsZero())
+	}
+	return e
+}
+
+func (h *healthChecker) setHealthyLocked(e *endpointHealth) {
+	e.failures = 0
+	e.unhealthyUntil = time.Time{}
+	for n := range e.targets {
+		metrics.SetBackendHealth(n.kind, n.name, true)
+	}
+}
+
+func (h *healthChecker) setUnhealthyLocked(e *endpointHealth) {
+	e.unhealthyUntil = h.now().Add(h.cooldown)
+	for n := range e.targets {
+		metrics.SetBackendHealth(n.kind, n.name, false)
+	}
+}
+
+// endpointKey identifies an endpoint by scheme and host, independent of the request path.
+func endpointKey(u *url.URL) string {
+	return u.Scheme + "://" + u.Host
+}
+
+// errEndpointUnhealthy is returned for requests not sent because the endpoint is unhealthy.
+var errEndpointUnhealthy = errors.New("endpoint is unhealthy")
+
+// writeUnavailable responds with 503 Service Unavailable, telling the client to
+// retry after the given duration, but at least after a second.
+func writeUnavailable(w http.ResponseWriter, req *http.Request, message string, retryAfter time.Duration) {
+	seconds := int32(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	err := &apierrors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusServiceUnavailable,
+		Reason:  metav1.StatusReasonServiceUnavailable,
+		Message: message,
+		Details: &metav1.StatusDetails{RetryAfterSeconds: seconds},
+	}}
+	responsewriters.ErrorNegotiated(err, kubernetesscheme.Codecs, schema.GroupVersion{}, w, req)
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/component-base/metrics/legacyregistry"
+
+	"github.com/kcp-dev/kcp/pkg/proxy/lookup"
+)
+
+func TestHealthCheckerPassive(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	h := newHealthChecker(2, 10*time.Second, nil)
+	h.now = func() time.Time { return now }
+
+	u, err := url.Parse("https://shard-1:6443/clusters/root")
+	require.NoError(t, err)
+	other, err := url.Parse("https://shard-1:6443/clusters/other")
+	require.NoError(t, err)
+
+	require.Zero(t, h.retryAfter(u), "unknown endpoints are healthy")
+
+	h.observe(ctx, shardHealthKind, "shard-1", u, errors.New("connection refused"))
+	require.Zero(t, h.retryAfter(u), "a single failure is below the threshold")
+
+	h.observe(ctx, shardHealthKind, "shard-1", u, nil)
+	h.observe(ctx, shardHealthKind, "shard-1", u, errors.New("connection refused"))
+	require.Zero(t, h.retryAfter(u), "a success resets the failures")
+
+	h.observe(ctx, shardHealthKind, "shard-1", u, errors.New("connection refused"))
+	require.Equal(t, 10*time.Second, h.retryAfter(u))
+	require.Equal(t, 10*time.Second, h.retryAfter(other), "endpoints are identified by scheme and host")
+
+	now = now.Add(4 * time.Second)
+	require.Equal(t, 6*time.Second, h.retryAfter(u))
+
+	now = now.Add(6 * time.Second)
+	require.Zero(t, h.retryAfter(u), "requests are let through after the cooldown")
+	h.observe(ctx, shardHealthKind, "shard-1", u, errors.New("connection refused"))
+	require.Equal(t, 10*time.Second, h.retryAfter(u), "the next failure marks it unhealthy again")
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	h.observe(canceled, shardHealthKind, "shard-1", u, nil)
+	require.Equal(t, 10*time.Second, h.retryAfter(u), "requests of clients that went away are ignored")
+}
+
+func TestHealthCheckerActive(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "/readyz", req.URL.Path)
+		w.WriteHeader(int(status.Load()))
+	}))
+	defer backend.Close()
+	shard := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer shard.Close()
+
+	backendURL, err := url.Parse(backend.URL + "/services/foo")
+	require.NoError(t, err)
+	shardURL, err := url.Parse(shard.URL)
+	require.NoError(t, err)
+	goneURL, err := url.Parse("https://gone:6443")
+	require.NoError(t, err)
+
+	h := newHealthChecker(3, time.Minute, func() (map[string]string, error) {
+		return map[string]string{"shard-1": shard.URL}, nil
+	})
+	h.setBackends([]healthTarget{{kind: backendHealthKind, name: backendURL.String(), url: backendURL, transport: http.DefaultTransport}}, http.DefaultTransport)
+
+	ctx := context.Background()
+	for range 3 {
+		h.observe(ctx, backendHealthKind, "gone", goneURL, errors.New("connection refused"))
+	}
+	require.NotZero(t, h.retryAfter(goneURL))
+
+	h.probeAll(ctx)
+	require.Zero(t, h.retryAfter(backendURL))
+	require.Zero(t, h.retryAfter(shardURL), "any response but a server error means the endpoint is reachable")
+	require.Zero(t, h.retryAfter(goneURL), "endpoints that are no target anymore are forgotten")
+	require.Len(t, h.endpoints, 2)
+
+	status.Store(http.StatusInternalServerError)
+	h.probeAll(ctx)
+	require.NotZero(t, h.retryAfter(backendURL), "a failed probe marks the endpoint unhealthy right away")
+
+	status.Store(http.StatusOK)
+	h.probeAll(ctx)
+	require.Zero(t, h.retryAfter(backendURL), "a successful probe marks the endpoint healthy again")
+}
+
+func TestHealthCheckerSharedEndpoint(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusInternalServerError)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(int(status.Load()))
+	}))
+	defer server.Close()
+
+	backendURL, err := url.Parse(server.URL + "/services/foo")
+	require.NoError(t, err)
+	backend := healthTarget{kind: backendHealthKind, name: "shared-backend", url: backendURL, transport: http.DefaultTransport}
+
+	h := newHealthChecker(3, time.Minute, func() (map[string]string, error) {
+		return map[string]string{"shared-shard": server.URL}, nil
+	})
+	h.setBackends([]healthTarget{backend}, http.DefaultTransport)
+
+	ctx := context.Background()
+	h.probeAll(ctx)
+	require.Len(t, h.endpoints, 1, "the shard and the backend share the endpoint")
+	for _, n := range []healthTargetName{{shardHealthKind, "shared-shard"}, {backendHealthKind, "shared-backend"}} {
+		value, found := backendHealthMetric(t, n.kind, n.name)
+		require.True(t, found, "%s %s has its own series", n.kind, n.name)
+		require.Zero(t, value, "%s %s is unhealthy", n.kind, n.name)
+	}
+
+	status.Store(http.StatusOK)
+	h.setBackends(nil, http.DefaultTransport)
+	h.probeAll(ctx)
+	require.Len(t, h.endpoints, 1, "the shard still uses the endpoint")
+	_, found := backendHealthMetric(t, backendHealthKind, "shared-backend")
+	require.False(t, found, "the series of the removed backend is deleted")
+	value, found := backendHealthMetric(t, shardHealthKind, "shared-shard")
+	require.True(t, found)
+	require.Equal(t, 1.0, value)
+
+	h.setBackends(nil, nil)
+	h.probeAll(ctx)
+	require.Empty(t, h.endpoints)
+	_, found = backendHealthMetric(t, shardHealthKind, "shared-shard")
+	require.False(t, found)
+}
+
+// backendHealthMetric returns the value of the health metric of the given shard or mapping backend.
+func backendHealthMetric(t *testing.T, kind, name string) (float64, bool) {
+	t.Helper()
+	families, err := legacyregistry.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	for _, f := range families {
+		if f.GetName() != "proxy_backend_healthy" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			labels := map[string]string{}
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			if labels["kind"] == kind && labels["name"] == name {
+				return m.GetGauge().GetValue(), true
+			}
+		}
+	}
+	return 0, false
+}
+
+func TestFailoverProxy(t *testing.T) {
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte("up " + req.URL.Path)) //nolint:errcheck
+	}))
+	defer up.Close()
+
+	downURL, err := url.Parse(down.URL)
+	require.NoError(t, err)
+	upURL, err := url.Parse(up.URL)
+	require.NoError(t, err)
+
+	h := newHealthChecker(2, time.Minute, nil)
+	proxy := newFailoverProxy([]*url.URL{downURL, upURL}, http.DefaultTransport, h)
+
+	t.Log("Idempotent requests are retried against the next backend")
+	rec := httptest.NewRecorder()
+	proxy.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/services/foo", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "up /services/foo", rec.Body.String())
+
+	t.Log("Other requests are not retried")
+	rec = httptest.NewRecorder()
+	proxy.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/services/foo", strings.NewReader("{}")))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.NotEmpty(t, rec.Header().Get("Retry-After"))
+	require.NotZero(t, h.retryAfter(downURL), "the backend is unhealthy after two failures")
+
+	t.Log("Unhealthy backends are skipped")
+	rec = httptest.NewRecorder()
+	proxy.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/services/foo", strings.NewReader("{}")))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	t.Log("Without healthy backends, clients are told when to retry")
+	proxy = newFailoverProxy([]*url.URL{downURL}, http.DefaultTransport, h)
+	rec = httptest.NewRecorder()
+	proxy.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/services/foo", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Equal(t, "60", rec.Header().Get("Retry-After"))
+}
+
+func TestShardHandlerUnavailable(t *testing.T) {
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+	shardURL, err := url.Parse(down.URL + "/clusters/root/api")
+	require.NoError(t, err)
+
+	h := newHealthChecker(1, time.Minute, nil)
+	handler := newShardHandler(http.DefaultTransport, h)
+
+	serve := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/clusters/root/api", nil)
+		ctx := lookup.WithShardURL(req.Context(), shardURL)
+		ctx = lookup.WithShardName(ctx, "shard-1")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req.WithContext(ctx))
+		return rec
+	}
+
+	rec := serve()
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code, "dial errors are answered with 503")
+	require.Contains(t, rec.Body.String(), `shard \"shard-1\" is unavailable`)
+	require.Equal(t, "60", rec.Header().Get("Retry-After"))
+
+	rec = serve()
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code, "unhealthy shards are not tried")
+	require.NotEmpty(t, rec.Header().Get("Retry-After"))
+}
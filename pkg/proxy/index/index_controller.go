@@ -23,6 +23,8 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
@@ -35,7 +37,9 @@ import (
 	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
 	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
 	tenancyv1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/tenancy/v1alpha1"
+	topologyv1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/topology/v1alpha1"
 	corev1alpha1listers "github.com/kcp-dev/sdk/client/listers/core/v1alpha1"
+	topologyv1alpha1listers "github.com/kcp-dev/sdk/client/listers/topology/v1alpha1"
 
 	"github.com/kcp-dev/kcp/pkg/index"
 	indexrewriters "github.com/kcp-dev/kcp/pkg/index/rewriters"
@@ -50,6 +54,9 @@ const (
 
 type Index interface {
 	LookupURL(path logicalcluster.Path) (index.Result, bool)
+	// LookupShardURLs returns the base URLs of all shards keyed by shard name, or of the shards
+	// selected by the Partition of the given name in the root workspace.
+	LookupShardURLs(partition string) (map[string]string, error)
 }
 
 type ClusterClientGetter func(shard *corev1alpha1.Shard) (kcpclientset.ClusterInterface, error)
@@ -57,6 +64,7 @@ type ClusterClientGetter func(shard *corev1alpha1.Shard) (kcpclientset.ClusterIn
 func NewController(
 	ctx context.Context,
 	shardInformer corev1alpha1informers.ShardInformer,
+	partitionInformer topologyv1alpha1informers.PartitionInformer,
 	clientGetter ClusterClientGetter,
 ) *Controller {
 	queue := workqueue.NewTypedRateLimitingQueueWithConfig(
@@ -74,6 +82,8 @@ func NewController(
 		shardIndexer: shardInformer.Informer().GetIndexer(),
 		shardLister:  shardInformer.Lister(),
 
+		partitionLister: partitionInformer.Lister(),
+
 		shardWorkspaceInformers:      map[string]cache.SharedIndexInformer{},
 		shardLogicalClusterInformers: map[string]cache.SharedIndexInformer{},
 		shardWorkspaceStopCh:         map[string]chan struct{}{},
@@ -122,6 +132,8 @@ type Controller struct {
 	shardIndexer cache.Indexer
 	shardLister  corev1alpha1listers.ShardLister
 
+	partitionLister topologyv1alpha1listers.PartitionLister
+
 	lock                         sync.RWMutex
 	shardWorkspaceInformers      map[string]cache.SharedIndexInformer
 	shardLogicalClusterInformers map[string]cache.SharedIndexInformer
@@ -294,3 +306,28 @@ func (c *Controller) stopShard(shardName string) {
 func (c *Controller) LookupURL(path logicalcluster.Path) (index.Result, bool) {
 	return c.state.LookupURL(path)
 }
+
+func (c *Controller) LookupShardURLs(partition string) (map[string]string, error) {
+	selector := labels.Everything()
+	if partition != "" {
+		p, err := c.partitionLister.Get(partition)
+		if err != nil {
+			return nil, err
+		}
+		selector, err = metav1.LabelSelectorAsSelector(p.Spec.Selector)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	shards, err := c.shardLister.List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make(map[string]string, len(shards))
+	for _, shard := range shards {
+		urls[shard.Name] = shard.Spec.BaseURL
+	}
+	return urls, nil
+}
@@ -18,10 +18,13 @@ package lookup
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apiserver/pkg/endpoints/filters"
 	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
 	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
@@ -33,8 +36,13 @@ import (
 	"github.com/kcp-dev/kcp/pkg/index"
 	proxyindex "github.com/kcp-dev/kcp/pkg/proxy/index"
 	"github.com/kcp-dev/kcp/pkg/server/proxy/types"
+	"github.com/kcp-dev/kcp/pkg/server/requestinfo"
 )
 
+// PartitionHeader restricts a wildcard request fanned out to multiple shards to the shards
+// selected by the Partition of the given name in the root workspace.
+const PartitionHeader = "X-Kcp-Partition"
+
 func WithClusterResolver(delegate http.Handler, mappings []types.PathMapping, index proxyindex.Index) http.Handler {
 	mux := http.NewServeMux()
 
@@ -82,6 +90,18 @@ func newClusterResolveHandler(delegate http.Handler, index proxyindex.Index) htt
 	return func(w http.ResponseWriter, req *http.Request) {
 		clusterName := req.PathValue("cluster")
 
+		// wildcard list and watch requests are fanned out to all shards.
+		if clusterName == logicalcluster.Wildcard.String() {
+			if watch, ok := isFanOutRequest(req); ok {
+				req = resolveFanOut(w, req, index, watch)
+				if req == nil {
+					return
+				}
+				delegate.ServeHTTP(w, req)
+				return
+			}
+		}
+
 		req, result := resolveClusterName(w, req, index, clusterName)
 		if req == nil {
 			return
@@ -105,6 +125,7 @@ func newClusterResolveHandler(delegate http.Handler, index proxyindex.Index) htt
 		}
 
 		ctx = WithShardURL(ctx, shardURL)
+		ctx = WithShardName(ctx, result.Shard)
 		req = req.WithContext(ctx)
 
 		delegate.ServeHTTP(w, req)
@@ -165,6 +186,66 @@ func extractClusterFromPath(path string) string {
 	return rest[:endIdx]
 }
 
+// ClusterPathFrom returns the logical cluster a request targets. This is the
+// cluster name resolved by the cluster resolver if any, and otherwise the
+// cluster in the request URL, e.g. "*" for wildcard requests.
+func ClusterPathFrom(req *http.Request) string {
+	if cluster := ClusterNameFrom(req.Context()); !cluster.Empty() {
+		return cluster.String()
+	}
+	return extractClusterFromPath(req.URL.Path)
+}
+
+// isFanOutRequest returns whether the wildcard request is a list or watch request of resources, which
+// is fanned out to multiple shards, and whether it is a watch.
+func isFanOutRequest(req *http.Request) (watch bool, ok bool) {
+	info, err := requestinfo.NewFactory().NewRequestInfo(&http.Request{
+		Method: req.Method,
+		URL:    &url.URL{Path: "/" + req.PathValue("trail"), RawQuery: req.URL.RawQuery},
+	})
+	if err != nil || !info.IsResourceRequest {
+		return false, false
+	}
+
+	switch info.Verb {
+	case "list":
+		return false, true
+	case "watch":
+		return true, true
+	default:
+		return false, false
+	}
+}
+
+func resolveFanOut(w http.ResponseWriter, req *http.Request, index proxyindex.Index, watch bool) *http.Request {
+	ctx := req.Context()
+	logger := klog.FromContext(ctx)
+
+	shardURLs, err := index.LookupShardURLs(req.Header.Get(PartitionHeader))
+	if err != nil {
+		responsewriters.ErrorNegotiated(err, kubernetesscheme.Codecs, schema.GroupVersion{}, w, req)
+		return nil
+	}
+
+	shards := make([]Shard, 0, len(shardURLs))
+	for name, baseURL := range shardURLs {
+		shardURL, err := url.Parse(baseURL)
+		if err != nil {
+			responsewriters.InternalError(w, req, err)
+			return nil
+		}
+		shardURL.Path = strings.TrimSuffix(shardURL.Path, "/") + "/clusters/*/" + req.PathValue("trail")
+		shards = append(shards, Shard{Name: name, URL: shardURL})
+	}
+	slices.SortFunc(shards, func(a, b Shard) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	logger.WithValues("from", req.URL.Path, "shards", len(shards), "watch", watch).V(4).Info("Fanning out")
+
+	return req.WithContext(WithFanOut(ctx, &FanOut{Shards: shards, Watch: watch}))
+}
+
 func resolveClusterName(w http.ResponseWriter, req *http.Request, index proxyindex.Index, clusterName string) (*http.Request, *index.Result) {
 	ctx := req.Context()
 	logger := klog.FromContext(ctx)
@@ -193,6 +274,12 @@ func resolveClusterName(w http.ResponseWriter, req *http.Request, index proxyind
 		return nil, nil
 	}
 
+	if !result.MovedTo.Empty() {
+		// the old path of a moved workspace is only served for a grace period.
+		w.Header().Add("Warning", fmt.Sprintf(`299 - "workspace %s has been moved to %s"`, clusterPath, result.MovedTo))
+	}
+
+	ctx = WithRequestedClusterPath(ctx, clusterPath)
 	ctx = WithClusterName(ctx, result.Cluster)
 	ctx = WithWorkspaceType(ctx, result.Type)
 
@@ -203,10 +290,28 @@ type lookupKey int
 
 const (
 	shardContextKey lookupKey = iota
+	shardNameContextKey
+	fanOutContextKey
+	clusterPathContextKey
 	clusterContextKey
 	workspaceTypeContextKey
 )
 
+// Shard is a target shard of a wildcard request fanned out to multiple shards.
+type Shard struct {
+	Name string
+	// URL is the URL of the request on the shard.
+	URL *url.URL
+}
+
+// FanOut is a wildcard list or watch request fanned out to multiple shards.
+type FanOut struct {
+	// Shards are the target shards, sorted by name.
+	Shards []Shard
+	// Watch is true for watch requests and false for list requests.
+	Watch bool
+}
+
 func WithShardURL(parent context.Context, shardURL *url.URL) context.Context {
 	return context.WithValue(parent, shardContextKey, shardURL)
 }
@@ -219,6 +324,44 @@ func ShardURLFrom(ctx context.Context) *url.URL {
 	return shardURL
 }
 
+func WithShardName(parent context.Context, shardName string) context.Context {
+	return context.WithValue(parent, shardNameContextKey, shardName)
+}
+
+func ShardNameFrom(ctx context.Context) string {
+	shardName, ok := ctx.Value(shardNameContextKey).(string)
+	if !ok {
+		return ""
+	}
+	return shardName
+}
+
+func WithFanOut(parent context.Context, fanOut *FanOut) context.Context {
+	return context.WithValue(parent, fanOutContextKey, fanOut)
+}
+
+func FanOutFrom(ctx context.Context) *FanOut {
+	fanOut, ok := ctx.Value(fanOutContextKey).(*FanOut)
+	if !ok {
+		return nil
+	}
+	return fanOut
+}
+
+// WithRequestedClusterPath stores the logical cluster path of the request URL, before it is resolved
+// to a logical cluster name.
+func WithRequestedClusterPath(parent context.Context, path logicalcluster.Path) context.Context {
+	return context.WithValue(parent, clusterPathContextKey, path)
+}
+
+func RequestedClusterPathFrom(ctx context.Context) logicalcluster.Path {
+	path, ok := ctx.Value(clusterPathContextKey).(logicalcluster.Path)
+	if !ok {
+		return logicalcluster.Path{}
+	}
+	return path
+}
+
 func WithClusterName(parent context.Context, cluster logicalcluster.Name) context.Context {
 	return context.WithValue(parent, clusterContextKey, cluster)
 }
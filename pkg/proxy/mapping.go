@@ -20,7 +20,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"net/http/httputil"
 	"net/url"
 	"os"
 
@@ -51,7 +50,9 @@ func isShardMapping(m types.PathMapping) bool {
 	return m.Path == "/clusters/"
 }
 
-func NewHandler(ctx context.Context, mappings []types.PathMapping) (http.Handler, error) {
+// NewHandler returns a handler proxying requests to the backends of the given mappings.
+// The backends are registered with the health checker for active health checks.
+func NewHandler(ctx context.Context, mappings []types.PathMapping, health *healthChecker) (http.Handler, error) {
 	handlers := proxy.HttpHandler{
 		Mappings: types.HttpHandlerMappings{
 			{
@@ -62,13 +63,22 @@ func NewHandler(ctx context.Context, mappings []types.PathMapping) (http.Handler
 		},
 	}
 
+	var (
+		healthTargets  []healthTarget
+		shardTransport http.RoundTripper
+	)
+
 	logger := klog.FromContext(ctx)
 	for _, m := range mappings {
 		logger.WithValues("mapping", m).V(2).Info("adding mapping")
 
-		u, err := url.Parse(m.Backend)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create path mapping for path %q: failed to parse URL %q: %w", m.Path, m.Backend, err)
+		var urls []*url.URL
+		for _, backend := range append([]string{m.Backend}, m.Backends...) {
+			u, err := url.Parse(backend)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create path mapping for path %q: failed to parse URL %q: %w", m.Path, backend, err)
+			}
+			urls = append(urls, u)
 		}
 
 		transport, err := newTransport(m.ProxyClientCert, m.ProxyClientKey, m.BackendServerCA)
@@ -78,14 +88,14 @@ func NewHandler(ctx context.Context, mappings []types.PathMapping) (http.Handler
 
 		var handler http.Handler
 		if isShardMapping(m) {
-			clusterProxy := newShardReverseProxy()
-			clusterProxy.Transport = transport
-			handler = clusterProxy
+			handler = newShardHandler(transport, health)
+			shardTransport = transport
 		} else {
 			// TODO: handle virtual workspace apiservers per shard
-			proxy := httputil.NewSingleHostReverseProxy(u)
-			proxy.Transport = transport
-			handler = proxy
+			handler = newFailoverProxy(urls, transport, health)
+			for _, u := range urls {
+				healthTargets = append(healthTargets, healthTarget{kind: backendHealthKind, name: u.String(), url: u, transport: transport})
+			}
 		}
 
 		userHeader := "X-Remote-User"
@@ -116,6 +126,7 @@ func NewHandler(ctx context.Context, mappings []types.PathMapping) (http.Handler
 	}
 
 	handlers.Mappings.Sort()
+	health.setBackends(healthTargets, shardTransport)
 
 	return &handlers, nil
 }
@@ -32,6 +32,45 @@ func WithLatencyTracking(delegate http.Handler) http.Handler {
 	return promhttp.InstrumentHandlerDuration(requestLatencies.HistogramVec, delegate)
 }
 
+// RecordRateLimitedRequest records a request rejected by the given limit,
+// one of "cluster", "user" or "group".
+func RecordRateLimitedRequest(limit string) {
+	rateLimitedRequests.WithLabelValues(limit).Inc()
+}
+
+// SetMappingGeneration sets the generation of the active mapping file, which
+// is increased every time a changed mapping file is loaded successfully.
+func SetMappingGeneration(generation int64) {
+	mappingGeneration.Set(float64(generation))
+}
+
+// RecordMappingReload records an attempt to load a changed mapping file, with
+// result "success" or "failure".
+func RecordMappingReload(result string) {
+	mappingReloads.WithLabelValues(result).Inc()
+}
+
+// SetBackendHealth sets the health of a shard or mapping backend, with kind
+// "shard" or "backend".
+func SetBackendHealth(kind, name string, healthy bool) {
+	value := 0.0
+	if healthy {
+		value = 1
+	}
+	backendHealth.WithLabelValues(kind, name).Set(value)
+}
+
+// DeleteBackendHealth removes the health of a shard or mapping backend that is gone.
+func DeleteBackendHealth(kind, name string) {
+	backendHealth.DeleteLabelValues(kind, name)
+}
+
+// RecordBackendFailover records a request retried against another replica of a
+// mapping backend.
+func RecordBackendFailover() {
+	backendFailovers.Inc()
+}
+
 // TODO(csams): enhance metrics to include shard url.
 var (
 	requestLatencies = compbasemetrics.NewHistogramVec(
@@ -44,6 +83,49 @@ var (
 		},
 		[]string{"method", "code"},
 	)
+
+	rateLimitedRequests = compbasemetrics.NewCounterVec(
+		&compbasemetrics.CounterOpts{
+			Name:           "proxy_rate_limited_requests_total",
+			Help:           "Number of requests rejected by the front-proxy rate limits, by the limit that was exceeded.",
+			StabilityLevel: compbasemetrics.ALPHA,
+		},
+		[]string{"limit"},
+	)
+
+	mappingGeneration = compbasemetrics.NewGauge(
+		&compbasemetrics.GaugeOpts{
+			Name:           "proxy_mapping_generation",
+			Help:           "Generation of the active mapping file, increased on every successful reload.",
+			StabilityLevel: compbasemetrics.ALPHA,
+		},
+	)
+
+	mappingReloads = compbasemetrics.NewCounterVec(
+		&compbasemetrics.CounterOpts{
+			Name:           "proxy_mapping_reloads_total",
+			Help:           "Number of attempts to load a changed mapping file, by result.",
+			StabilityLevel: compbasemetrics.ALPHA,
+		},
+		[]string{"result"},
+	)
+
+	backendHealth = compbasemetrics.NewGaugeVec(
+		&compbasemetrics.GaugeOpts{
+			Name:           "proxy_backend_healthy",
+			Help:           "Whether a shard or mapping backend is considered healthy (1) or not (0).",
+			StabilityLevel: compbasemetrics.ALPHA,
+		},
+		[]string{"kind", "name"},
+	)
+
+	backendFailovers = compbasemetrics.NewCounter(
+		&compbasemetrics.CounterOpts{
+			Name:           "proxy_backend_failovers_total",
+			Help:           "Number of requests retried against another replica of a mapping backend.",
+			StabilityLevel: compbasemetrics.ALPHA,
+		},
+	)
 )
 
 var registerMetrics sync.Once
@@ -52,6 +134,11 @@ var registerMetrics sync.Once
 func Register() {
 	registerMetrics.Do(func() {
 		legacyregistry.MustRegister(requestLatencies)
+		legacyregistry.MustRegister(rateLimitedRequests)
+		legacyregistry.MustRegister(mappingGeneration)
+		legacyregistry.MustRegister(mappingReloads)
+		legacyregistry.MustRegister(backendHealth)
+		legacyregistry.MustRegister(backendFailovers)
 	})
 }
 
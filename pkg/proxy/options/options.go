@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	apiserveroptions "k8s.io/apiserver/pkg/server/options"
 	cliflag "k8s.io/component-base/cli/flag"
@@ -28,20 +29,35 @@ import (
 type Options struct {
 	SecureServing         apiserveroptions.SecureServingOptionsWithLoopback
 	Authentication        Authentication
+	RateLimiting          RateLimiting
+	Audit                 apiserveroptions.AuditOptions
 	MappingFile           string
+	MappingReloadInterval time.Duration
 	RootDirectory         string
 	RootKubeconfig        string
 	ShardsKubeconfig      string
 	ProfilerAddress       string
 	CorsAllowedOriginList []string
+
+	BackendHealthCheckInterval time.Duration
+	BackendFailureThreshold    int
+	BackendUnhealthyCooldown   time.Duration
 }
 
 func NewOptions() *Options {
 	o := &Options{
 		SecureServing:  *apiserveroptions.NewSecureServingOptions().WithLoopback(),
 		Authentication: *NewAuthentication(),
+		RateLimiting:   *NewRateLimiting(),
+		Audit:          *apiserveroptions.NewAuditOptions(),
 		RootKubeconfig: "",
 		RootDirectory:  ".kcp",
+
+		MappingReloadInterval: 30 * time.Second,
+
+		BackendHealthCheckInterval: 10 * time.Second,
+		BackendFailureThreshold:    3,
+		BackendUnhealthyCooldown:   10 * time.Second,
 	}
 
 	// override all the things
@@ -54,9 +70,15 @@ func NewOptions() *Options {
 func (o *Options) AddFlags(fss *cliflag.NamedFlagSets) {
 	o.SecureServing.AddFlags(fss.FlagSet("secure-serving"))
 	o.Authentication.AddFlags(fss.FlagSet("authentication"))
+	o.RateLimiting.AddFlags(fss.FlagSet("rate limiting"))
+	o.Audit.AddFlags(fss.FlagSet("auditing"))
 
 	fs := fss.FlagSet("proxy")
 	fs.StringVar(&o.MappingFile, "mapping-file", o.MappingFile, "Config file mapping paths to backends")
+	fs.DurationVar(&o.MappingReloadInterval, "mapping-reload-interval", o.MappingReloadInterval, "Interval in which the mapping file and the certificates it references are checked for changes and reloaded. Zero disables reloading.")
+	fs.DurationVar(&o.BackendHealthCheckInterval, "backend-health-check-interval", o.BackendHealthCheckInterval, "Interval in which the readiness of all shards and mapping backends is checked. Zero disables active health checks.")
+	fs.IntVar(&o.BackendFailureThreshold, "backend-failure-threshold", o.BackendFailureThreshold, "Number of consecutive failed requests after which a shard or mapping backend is considered unhealthy.")
+	fs.DurationVar(&o.BackendUnhealthyCooldown, "backend-unhealthy-cooldown", o.BackendUnhealthyCooldown, "Duration for which no requests are sent to an unhealthy shard or mapping backend, unless a health check succeeds before.")
 	fs.StringVar(&o.RootDirectory, "root-directory", o.RootDirectory, "Root directory.")
 	fs.StringVar(&o.RootKubeconfig, "root-kubeconfig", o.RootKubeconfig, "The path to the kubeconfig of the root shard.")
 	fs.StringVar(&o.ShardsKubeconfig, "shards-kubeconfig", o.ShardsKubeconfig, "The path to the kubeconfig used for communication with all shards. The server name if provided is replaced with a shard's hostname.")
@@ -89,12 +111,26 @@ func (o *Options) Validate() []error {
 	if o.MappingFile == "" {
 		errs = append(errs, fmt.Errorf("--mapping-file is required"))
 	}
+	if o.MappingReloadInterval < 0 {
+		errs = append(errs, fmt.Errorf("--mapping-reload-interval must not be negative"))
+	}
+	if o.BackendHealthCheckInterval < 0 {
+		errs = append(errs, fmt.Errorf("--backend-health-check-interval must not be negative"))
+	}
+	if o.BackendFailureThreshold < 1 {
+		errs = append(errs, fmt.Errorf("--backend-failure-threshold must be positive"))
+	}
+	if o.BackendUnhealthyCooldown <= 0 {
+		errs = append(errs, fmt.Errorf("--backend-unhealthy-cooldown must be positive"))
+	}
 	if len(o.ShardsKubeconfig) == 0 {
 		errs = append(errs, fmt.Errorf("--shards-kubeconfig is required"))
 	}
 
 	errs = append(errs, o.SecureServing.Validate()...)
 	errs = append(errs, o.Authentication.Validate()...)
+	errs = append(errs, o.RateLimiting.Validate()...)
+	errs = append(errs, o.Audit.Validate()...)
 
 	return errs
 }
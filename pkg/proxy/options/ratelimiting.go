@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+)
+
+// RateLimiting configures the token bucket limits the front-proxy applies to
+// incoming requests before they are forwarded to a shard. A QPS of zero
+// disables the respective limit.
+type RateLimiting struct {
+	// ClusterQPS and ClusterBurst limit the requests per logical cluster.
+	ClusterQPS   float32
+	ClusterBurst int
+
+	// UserQPS and UserBurst limit the requests per authenticated user.
+	UserQPS   float32
+	UserBurst int
+
+	// GroupQPS and GroupBurst limit the requests per group listed in Groups.
+	// All members of a group share its bucket.
+	GroupQPS   float32
+	GroupBurst int
+	Groups     []string
+
+	// ExemptGroups are groups whose members are never rate limited.
+	ExemptGroups []string
+}
+
+// NewRateLimiting creates a default RateLimiting with all limits disabled.
+func NewRateLimiting() *RateLimiting {
+	return &RateLimiting{
+		ClusterBurst: 100,
+		UserBurst:    100,
+		GroupBurst:   100,
+	}
+}
+
+// Enabled returns whether any limit is configured.
+func (r *RateLimiting) Enabled() bool {
+	return r.ClusterQPS > 0 || r.UserQPS > 0 || (r.GroupQPS > 0 && len(r.Groups) > 0)
+}
+
+func (r *RateLimiting) AddFlags(fs *pflag.FlagSet) {
+	fs.Float32Var(&r.ClusterQPS, "rate-limit-cluster-qps", r.ClusterQPS, "Maximum sustained requests per second per logical cluster. Zero disables the limit.")
+	fs.IntVar(&r.ClusterBurst, "rate-limit-cluster-burst", r.ClusterBurst, "Maximum burst of requests per logical cluster.")
+	fs.Float32Var(&r.UserQPS, "rate-limit-user-qps", r.UserQPS, "Maximum sustained requests per second per authenticated user. Zero disables the limit.")
+	fs.IntVar(&r.UserBurst, "rate-limit-user-burst", r.UserBurst, "Maximum burst of requests per authenticated user.")
+	fs.Float32Var(&r.GroupQPS, "rate-limit-group-qps", r.GroupQPS, "Maximum sustained requests per second per group listed in --rate-limit-groups, shared by all members of the group. Zero disables the limit.")
+	fs.IntVar(&r.GroupBurst, "rate-limit-group-burst", r.GroupBurst, "Maximum burst of requests per group listed in --rate-limit-groups.")
+	fs.StringSliceVar(&r.Groups, "rate-limit-groups", r.Groups, "Groups limited by --rate-limit-group-qps, comma separated.")
+	fs.StringSliceVar(&r.ExemptGroups, "rate-limit-exempt-groups", r.ExemptGroups, "Groups whose members are never rate limited, comma separated.")
+}
+
+func (r *RateLimiting) Validate() []error {
+	var errs []error
+
+	if r.ClusterQPS < 0 {
+		errs = append(errs, fmt.Errorf("--rate-limit-cluster-qps must not be negative"))
+	}
+	if r.ClusterQPS > 0 && r.ClusterBurst <= 0 {
+		errs = append(errs, fmt.Errorf("--rate-limit-cluster-burst must be positive"))
+	}
+	if r.UserQPS < 0 {
+		errs = append(errs, fmt.Errorf("--rate-limit-user-qps must not be negative"))
+	}
+	if r.UserQPS > 0 && r.UserBurst <= 0 {
+		errs = append(errs, fmt.Errorf("--rate-limit-user-burst must be positive"))
+	}
+	if r.GroupQPS < 0 {
+		errs = append(errs, fmt.Errorf("--rate-limit-group-qps must not be negative"))
+	}
+	if r.GroupQPS > 0 && r.GroupBurst <= 0 {
+		errs = append(errs, fmt.Errorf("--rate-limit-group-burst must be positive"))
+	}
+	if r.GroupQPS > 0 && len(r.Groups) == 0 {
+		errs = append(errs, fmt.Errorf("--rate-limit-groups is required when --rate-limit-group-qps is set"))
+	}
+
+	return errs
+}
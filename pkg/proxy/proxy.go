@@ -28,6 +28,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	userinfo "k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/klog/v2"
 
 	"github.com/kcp-dev/kcp/pkg/proxy/lookup"
 )
@@ -84,6 +85,43 @@ func appendClientCertAuthHeaders(header http.Header, user userinfo.Info, userHea
 	}
 }
 
+// newShardHandler proxies requests to the shard resolved for them, and fans wildcard list and watch
+// requests out to all target shards. Requests to a shard that is unhealthy or cannot be reached are
+// answered with 503 Service Unavailable.
+func newShardHandler(transport http.RoundTripper, health *healthChecker) http.Handler {
+	clusterProxy := newShardReverseProxy()
+	clusterProxy.Transport = transport
+	clusterProxy.ModifyResponse = func(resp *http.Response) error {
+		ctx := resp.Request.Context()
+		health.observe(ctx, shardHealthKind, lookup.ShardNameFrom(ctx), resp.Request.URL, nil)
+		return nil
+	}
+	clusterProxy.ErrorHandler = func(w http.ResponseWriter, req *http.Request, err error) {
+		ctx := req.Context()
+		shardName := lookup.ShardNameFrom(ctx)
+		klog.FromContext(ctx).V(2).Info("Failed to proxy request to shard", "shard", shardName, "err", err)
+		health.observe(ctx, shardHealthKind, shardName, req.URL, err)
+		writeUnavailable(w, req, fmt.Sprintf("shard %q is unavailable", shardName), health.retryAfter(req.URL))
+	}
+	proxy := withCompositeResourceVersions(clusterProxy)
+
+	fanOut := newFanOutHandler(transport, health)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if lookup.FanOutFrom(req.Context()) != nil {
+			fanOut.ServeHTTP(w, req)
+			return
+		}
+		if shardURL := lookup.ShardURLFrom(req.Context()); shardURL != nil {
+			if d := health.retryAfter(shardURL); d > 0 {
+				writeUnavailable(w, req, fmt.Sprintf("shard %q is unavailable", lookup.ShardNameFrom(req.Context())), d)
+				return
+			}
+		}
+		proxy.ServeHTTP(w, req)
+	})
+}
+
 func newShardReverseProxy() *httputil.ReverseProxy {
 	director := func(req *http.Request) {
 		shardURL := lookup.ShardURLFrom(req.Context())
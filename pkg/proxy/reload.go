@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/kcp/pkg/proxy/metrics"
+	"github.com/kcp-dev/kcp/pkg/server/proxy/types"
+)
+
+// mappingGeneration is the handler chain built from one version of the mapping file.
+type mappingGeneration struct {
+	generation int64
+	// frontend is the entry point of the generation, i.e. the cluster resolver if
+	// there is a shard mapping.
+	frontend http.Handler
+	// backends proxies requests to the mapped backends.
+	backends http.Handler
+}
+
+// buildMappingGenerationFunc builds the handlers of a mapping generation. The
+// generation number is set by the caller.
+type buildMappingGenerationFunc func(ctx context.Context, mappings []types.PathMapping) (*mappingGeneration, error)
+
+// mappingReloader serves requests with the handlers built from the mapping file.
+// It reloads the mapping file when it or one of the certificates it references
+// changes, and swaps the handlers atomically. A request is served by a single
+// generation end to end, also if a reload happens while it passes through the
+// handler chain. A changed mapping file that fails validation is not applied,
+// and the previous generation keeps serving.
+type mappingReloader struct {
+	filename        string
+	hasShardMapping bool
+	build           buildMappingGenerationFunc
+
+	current atomic.Pointer[mappingGeneration]
+
+	// lock serializes reloads and protects checksum.
+	lock     sync.Mutex
+	checksum []byte
+}
+
+func newMappingReloader(filename string, hasShardMapping bool, build buildMappingGenerationFunc) *mappingReloader {
+	return &mappingReloader{
+		filename:        filename,
+		hasShardMapping: hasShardMapping,
+		build:           build,
+	}
+}
+
+// ServeHTTP serves the request with the current generation.
+func (r *mappingReloader) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	gen := r.current.Load()
+	if gen == nil {
+		responsewriters.InternalError(w, req, fmt.Errorf("no mappings loaded"))
+		return
+	}
+	gen.frontend.ServeHTTP(w, req.WithContext(withMappingGeneration(req.Context(), gen)))
+}
+
+// serveMappedBackends proxies the request to the backends of the generation the
+// request started with.
+func serveMappedBackends(w http.ResponseWriter, req *http.Request) {
+	gen := mappingGenerationFrom(req.Context())
+	if gen == nil {
+		responsewriters.InternalError(w, req, fmt.Errorf("no mappings loaded"))
+		return
+	}
+	gen.backends.ServeHTTP(w, req)
+}
+
+// Start checks for changes every interval until the context is done.
+func (r *mappingReloader) Start(ctx context.Context, interval time.Duration) {
+	logger := klog.FromContext(ctx).WithValues("mappingFile", r.filename)
+	ctx = klog.NewContext(ctx, logger)
+
+	logger.Info("Watching mapping file for changes", "interval", interval)
+	defer logger.Info("Stopped watching mapping file")
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if _, err := r.Reload(ctx); err != nil {
+			logger.Error(err, "Failed to reload mapping file, keeping the previous mappings")
+		}
+	}, interval)
+}
+
+// Reload loads the mapping file if it or one of the certificates it references
+// changed since the last attempt, and swaps the handlers if it is valid. It
+// returns whether a new generation has been applied.
+func (r *mappingReloader) Reload(ctx context.Context) (bool, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	data, err := os.ReadFile(r.filename)
+	if err != nil {
+		return false, err
+	}
+	// an unparseable mapping file is remembered by the checksum of its data alone.
+	dataChecksum := mappingChecksum(data, nil)
+	if bytes.Equal(dataChecksum, r.checksum) {
+		return false, nil
+	}
+	var mappings []types.PathMapping
+	if err := yaml.Unmarshal(data, &mappings); err != nil {
+		return false, r.failed(dataChecksum, fmt.Errorf("failed to parse mapping file %q: %w", r.filename, err))
+	}
+
+	checksum := mappingChecksum(data, mappings)
+	if bytes.Equal(checksum, r.checksum) {
+		return false, nil
+	}
+
+	if err := validateMappings(mappings, r.hasShardMapping); err != nil {
+		return false, r.failed(checksum, fmt.Errorf("invalid mapping file %q: %w", r.filename, err))
+	}
+	gen, err := r.build(ctx, mappings)
+	if err != nil {
+		return false, r.failed(checksum, fmt.Errorf("failed to build handlers for mapping file %q: %w", r.filename, err))
+	}
+
+	gen.generation = 1
+	if prev := r.current.Load(); prev != nil {
+		gen.generation = prev.generation + 1
+	}
+	r.current.Store(gen)
+	r.checksum = checksum
+
+	if gen.generation > 1 {
+		metrics.RecordMappingReload("success")
+	}
+	metrics.SetMappingGeneration(gen.generation)
+	klog.FromContext(ctx).Info("Loaded mapping file", "mappingFile", r.filename, "generation", gen.generation, "mappings", len(mappings))
+
+	return true, nil
+}
+
+// failed remembers the checksum of a change that cannot be applied, so that it
+// is not retried until the files change again.
+func (r *mappingReloader) failed(checksum []byte, err error) error {
+	if r.current.Load() != nil {
+		metrics.RecordMappingReload("failure")
+	}
+	r.checksum = checksum
+	return err
+}
+
+// mappingChecksum returns a checksum of the mapping file data and of the files
+// referenced by the mappings. Files that cannot be read are ignored here, they
+// fail the reload when the handlers are built.
+func mappingChecksum(data []byte, mappings []types.PathMapping) []byte {
+	h := sha256.New()
+	h.Write(data)
+	for _, m := range mappings {
+		for _, f := range []string{m.BackendServerCA, m.ProxyClientCert, m.ProxyClientKey} {
+			if f == "" {
+				continue
+			}
+			if content, err := os.ReadFile(f); err == nil {
+				h.Write(content)
+			}
+		}
+	}
+	return h.Sum(nil)
+}
+
+// validateMappings checks the mappings for errors that would only surface when
+// requests are served. The presence of the shard mapping cannot change at
+// runtime because it decides which controllers the front-proxy runs.
+func validateMappings(mappings []types.PathMapping, hasShardMapping bool) error {
+	paths := sets.New[string]()
+	shardMapping := false
+	for i, m := range mappings {
+		if !strings.HasPrefix(m.Path, "/") {
+			return fmt.Errorf("mapping %d: path %q must start with a slash", i, m.Path)
+		}
+		if paths.Has(m.Path) {
+			return fmt.Errorf("mapping %d: duplicate path %q", i, m.Path)
+		}
+		paths.Insert(m.Path)
+
+		for _, backend := range append([]string{m.Backend}, m.Backends...) {
+			u, err := url.Parse(backend)
+			if err != nil {
+				return fmt.Errorf("mapping %d: failed to parse backend URL %q: %w", i, backend, err)
+			}
+			if u.Scheme == "" || u.Host == "" {
+				return fmt.Errorf("mapping %d: backend URL %q must have a scheme and a host", i, backend)
+			}
+		}
+		if isShardMapping(m) && len(m.Backends) > 0 {
+			return fmt.Errorf("mapping %d: backends cannot be set for the %q mapping, shards are resolved per request", i, m.Path)
+		}
+
+		shardMapping = shardMapping || isShardMapping(m)
+	}
+
+	if shardMapping != hasShardMapping {
+		return fmt.Errorf("adding or removing the %q mapping requires a restart", "/clusters/")
+	}
+
+	return nil
+}
+
+type mappingGenerationContextKeyType int
+
+const mappingGenerationContextKey mappingGenerationContextKeyType = iota
+
+func withMappingGeneration(parent context.Context, gen *mappingGeneration) context.Context {
+	return context.WithValue(parent, mappingGenerationContextKey, gen)
+}
+
+func mappingGenerationFrom(ctx context.Context) *mappingGeneration {
+	gen, _ := ctx.Value(mappingGenerationContextKey).(*mappingGeneration)
+	return gen
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/component-base/metrics/legacyregistry"
+
+	"github.com/kcp-dev/kcp/pkg/server/proxy/types"
+)
+
+func TestMappingReloader(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "mapping.yaml")
+	caFile := filepath.Join(dir, "ca.crt")
+
+	write := func(t *testing.T, name, content string) {
+		t.Helper()
+		require.NoError(t, os.WriteFile(name, []byte(content), 0o600))
+	}
+	serve := func(t *testing.T, r *mappingReloader) string {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/clusters/root/api", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		return rec.Body.String()
+	}
+
+	// swapDuringRequest reloads while a request is between the frontend and the backends.
+	var swapDuringRequest func()
+	r := newMappingReloader(filename, true, func(ctx context.Context, mappings []types.PathMapping) (*mappingGeneration, error) {
+		backends := make([]string, 0, len(mappings))
+		for _, m := range mappings {
+			backends = append(backends, m.Backend)
+		}
+		return &mappingGeneration{
+			frontend: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if swapDuringRequest != nil {
+					swapDuringRequest()
+				}
+				serveMappedBackends(w, req)
+			}),
+			backends: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.Write([]byte(strings.Join(backends, ","))) //nolint:errcheck
+			}),
+		}, nil
+	})
+
+	write(t, caFile, "ca-1")
+	write(t, filename, `
+- path: /clusters/
+  backend: https://shard-1:6443
+  backend_server_ca: `+caFile+`
+`)
+	changed, err := r.Reload(ctx)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, int64(1), r.current.Load().generation)
+	require.Equal(t, "https://shard-1:6443", serve(t, r))
+
+	t.Log("Reloading unchanged files does nothing")
+	changed, err = r.Reload(ctx)
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	t.Log("A changed certificate creates a new generation")
+	write(t, caFile, "ca-2")
+	changed, err = r.Reload(ctx)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, int64(2), r.current.Load().generation)
+
+	t.Log("A changed mapping file swaps the backends")
+	write(t, filename, `
+- path: /clusters/
+  backend: https://shard-1:6443
+- path: /services/
+  backend: https://vw:6444
+`)
+	changed, err = r.Reload(ctx)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, "https://shard-1:6443,https://vw:6444", serve(t, r))
+
+	t.Log("An invalid mapping file is rolled back")
+	write(t, filename, `
+- path: /clusters/
+  backend: https://shard-1:6443
+- path: /clusters/
+  backend: https://shard-2:6443
+`)
+	_, err = r.Reload(ctx)
+	require.ErrorContains(t, err, "duplicate path")
+	require.Equal(t, int64(3), r.current.Load().generation)
+	require.Equal(t, "https://shard-1:6443,https://vw:6444", serve(t, r))
+
+	t.Log("The invalid mapping file is not retried until it changes")
+	changed, err = r.Reload(ctx)
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	t.Log("An unparseable mapping file fails once and is not retried until it changes")
+	failures := mappingReloadFailures(t)
+	write(t, filename, `- path: [`)
+	_, err = r.Reload(ctx)
+	require.ErrorContains(t, err, "failed to parse mapping file")
+	changed, err = r.Reload(ctx)
+	require.NoError(t, err)
+	require.False(t, changed)
+	require.Equal(t, failures+1, mappingReloadFailures(t))
+	require.Equal(t, int64(3), r.current.Load().generation)
+
+	t.Log("Removing the shard mapping requires a restart")
+	write(t, filename, `
+- path: /services/
+  backend: https://vw:6444
+`)
+	_, err = r.Reload(ctx)
+	require.ErrorContains(t, err, "requires a restart")
+	require.Equal(t, int64(3), r.current.Load().generation)
+
+	t.Log("A request is served by the generation it started with")
+	write(t, filename, `
+- path: /clusters/
+  backend: https://shard-2:6443
+`)
+	swapDuringRequest = func() {
+		swapDuringRequest = nil
+		changed, err := r.Reload(ctx)
+		require.NoError(t, err)
+		require.True(t, changed)
+	}
+	require.Equal(t, "https://shard-1:6443,https://vw:6444", serve(t, r))
+	require.Equal(t, "https://shard-2:6443", serve(t, r))
+	require.Equal(t, int64(4), r.current.Load().generation)
+}
+
+func TestValidateMappings(t *testing.T) {
+	tests := map[string]struct {
+		mappings        []types.PathMapping
+		hasShardMapping bool
+		wantErr         string
+	}{
+		"valid": {
+			mappings: []types.PathMapping{
+				{Path: "/clusters/", Backend: "https://localhost:6443"},
+				{Path: "/services/", Backend: "https://localhost:6444"},
+			},
+			hasShardMapping: true,
+		},
+		"relative path": {
+			mappings: []types.PathMapping{{Path: "services/", Backend: "https://localhost:6444"}},
+			wantErr:  "must start with a slash",
+		},
+		"duplicate path": {
+			mappings: []types.PathMapping{
+				{Path: "/services/", Backend: "https://localhost:6444"},
+				{Path: "/services/", Backend: "https://localhost:6445"},
+			},
+			wantErr: "duplicate path",
+		},
+		"backend without host": {
+			mappings: []types.PathMapping{{Path: "/services/", Backend: "localhost"}},
+			wantErr:  "must have a scheme and a host",
+		},
+		"shard mapping added": {
+			mappings: []types.PathMapping{{Path: "/clusters/", Backend: "https://localhost:6443"}},
+			wantErr:  "requires a restart",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateMappings(tc.mappings, tc.hasShardMapping)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// mappingReloadFailures returns the number of failed mapping reloads recorded in the metrics.
+func mappingReloadFailures(t *testing.T) float64 {
+	t.Helper()
+	families, err := legacyregistry.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	for _, f := range families {
+		if f.GetName() != "proxy_mapping_reloads_total" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "result" && l.GetValue() == "failure" {
+					return m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
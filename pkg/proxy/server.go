@@ -41,6 +41,7 @@ import (
 	"github.com/kcp-dev/kcp/pkg/proxy/lookup"
 	"github.com/kcp-dev/kcp/pkg/proxy/metrics"
 	kcpfilters "github.com/kcp-dev/kcp/pkg/server/filters"
+	"github.com/kcp-dev/kcp/pkg/server/proxy/types"
 	"github.com/kcp-dev/kcp/pkg/server/requestinfo"
 
 	_ "k8s.io/component-base/metrics/prometheus/workqueue"
@@ -52,6 +53,9 @@ type Server struct {
 	IndexController          *index.Controller
 	AuthController           *authentication.Controller
 	KcpSharedInformerFactory kcpinformers.SharedScopedInformerFactory
+
+	mappingReloader *mappingReloader
+	healthChecker   *healthChecker
 }
 
 func NewServer(ctx context.Context, c CompletedConfig) (*Server, error) {
@@ -92,18 +96,25 @@ func NewServer(ctx context.Context, c CompletedConfig) (*Server, error) {
 	// This controller is responsible for watching all Shards, connecting to each of them and
 	// watching a number of resources on each. The controller is then also satisfying the Index
 	// interface.
-	s.IndexController = index.NewController(ctx, s.KcpSharedInformerFactory.Core().V1alpha1().Shards(), getClientFunc)
+	s.IndexController = index.NewController(ctx, s.KcpSharedInformerFactory.Core().V1alpha1().Shards(), s.KcpSharedInformerFactory.Topology().V1alpha1().Partitions(), getClientFunc)
 
-	handler, err := NewHandler(ctx, mappings)
-	if err != nil {
-		return s, err
-	}
+	// The handlers up to the mapped backends do not depend on the mappings. The backends
+	// are taken from the mapping generation the request started with.
+	handler := http.Handler(http.HandlerFunc(serveMappedBackends))
+
+	// Rate limits are keyed on the user, so they have to be applied after authentication.
+	handler = frontproxyfilters.WithRateLimiting(handler, &c.Options.RateLimiting)
+
+	// Audit events include the user and the resolved cluster and shard, so they are
+	// recorded after authentication.
+	handler = frontproxyfilters.WithAuditing(handler, c.AuditBackend, c.AuditPolicyRuleEvaluator)
 
 	// The optional auth handler will call the underlying authenticator only if
 	// auth methods are configured directly on the front-proxy *or* if there is
 	// a custom workspace authenticator, i.e. the AdditionalAuthEnabled field
 	// only represents the CLI flag state.
 	failedHandler := frontproxyfilters.NewUnauthorizedHandler()
+	failedHandler = frontproxyfilters.WithFailedAuthenticationAuditing(failedHandler, c.AuditBackend, c.AuditPolicyRuleEvaluator)
 	handler = frontproxyfilters.WithOptionalAuthentication(
 		handler,
 		failedHandler,
@@ -130,10 +141,31 @@ func NewServer(ctx context.Context, c CompletedConfig) (*Server, error) {
 		handler = authentication.WithWorkspaceAuthResolver(handler, s.AuthController)
 	}
 
-	if hasShardMapping {
-		// This middleware must happen before the authentication.
-		handler = lookup.WithClusterResolver(handler, mappings, s.IndexController)
+	// The health checker keeps its state across mapping reloads, as the backends are identified by URL.
+	s.healthChecker = newHealthChecker(c.Options.BackendFailureThreshold, c.Options.BackendUnhealthyCooldown, func() (map[string]string, error) {
+		return s.IndexController.LookupShardURLs("")
+	})
+
+	authenticatedHandler := handler
+	s.mappingReloader = newMappingReloader(c.Options.MappingFile, hasShardMapping, func(ctx context.Context, mappings []types.PathMapping) (*mappingGeneration, error) {
+		backends, err := NewHandler(ctx, mappings, s.healthChecker)
+		if err != nil {
+			return nil, err
+		}
+
+		frontend := authenticatedHandler
+		if hasShardMapping {
+			// This middleware must happen before the authentication.
+			frontend = lookup.WithClusterResolver(frontend, mappings, s.IndexController)
+		}
+
+		return &mappingGeneration{frontend: frontend, backends: backends}, nil
+	})
+	if _, err := s.mappingReloader.Reload(ctx); err != nil {
+		return nil, err
 	}
+	handler = s.mappingReloader
+	handler = genericapifilters.WithAuditInit(handler) // Must run before any audit annotation is made
 
 	requestInfoFactory := requestinfo.NewFactory()
 	handler = kcpfilters.WithInClusterServiceAccountRequestRewrite(handler)
@@ -191,10 +223,25 @@ func (s preparedServer) Run(ctx context.Context) error {
 	// start indexes
 	go s.IndexController.Start(ctx, 2)
 
+	if s.Options.BackendHealthCheckInterval > 0 {
+		go s.healthChecker.Start(ctx, s.Options.BackendHealthCheckInterval)
+	}
+
+	if s.Options.MappingReloadInterval > 0 {
+		go s.mappingReloader.Start(ctx, s.Options.MappingReloadInterval)
+	}
+
 	if s.AuthController != nil {
 		go s.AuthController.Start(ctx, 2)
 	}
 
+	if s.AuditBackend != nil {
+		if err := s.AuditBackend.Run(ctx.Done()); err != nil {
+			return fmt.Errorf("failed to run the audit backend: %w", err)
+		}
+		defer s.AuditBackend.Shutdown()
+	}
+
 	s.KcpSharedInformerFactory.Start(ctx.Done())
 	s.KcpSharedInformerFactory.WaitForCacheSync(ctx.Done())
 
@@ -19,6 +19,7 @@ package apibinding
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -75,10 +76,12 @@ func NewController(
 	apiExportInformer apisv1alpha2informers.APIExportClusterInformer,
 	apiResourceSchemaInformer apisv1alpha1informers.APIResourceSchemaClusterInformer,
 	apiConversionInformer apisv1alpha1informers.APIConversionClusterInformer,
+	apiDeploymentInformer apisv1alpha2informers.APIDeploymentClusterInformer,
 	logicalClusterInformer corev1alpha1informers.LogicalClusterClusterInformer,
 	globalAPIExportInformer apisv1alpha2informers.APIExportClusterInformer,
 	globalAPIResourceSchemaInformer apisv1alpha1informers.APIResourceSchemaClusterInformer,
 	globalAPIConversionInformer apisv1alpha1informers.APIConversionClusterInformer,
+	globalAPIDeploymentInformer apisv1alpha2informers.APIDeploymentClusterInformer,
 	crdInformer kcpapiextensionsv1informers.CustomResourceDefinitionClusterInformer,
 ) (*controller, error) {
 	c := &controller{
@@ -139,6 +142,10 @@ func NewController(
 			return indexers.ByIndexWithFallback[*apisv1alpha2.APIExport](apiExportInformer.Informer().GetIndexer(), globalAPIExportInformer.Informer().GetIndexer(), indexAPIExportsByAPIResourceSchema, key)
 		},
 
+		listAPIDeployments: func(apiExport *apisv1alpha2.APIExport) ([]*apisv1alpha2.APIDeployment, error) {
+			return indexers.ByIndexWithFallback[*apisv1alpha2.APIDeployment](apiDeploymentInformer.Informer().GetIndexer(), globalAPIDeploymentInformer.Informer().GetIndexer(), indexers.APIDeploymentByAPIExport, logicalcluster.From(apiExport).Path().Join(apiExport.Name).String())
+		},
+
 		getAPIResourceSchema: informer.NewScopedGetterWithFallback[*apisv1alpha1.APIResourceSchema, apisv1alpha1listers.APIResourceSchemaLister](apiResourceSchemaInformer.Lister(), globalAPIResourceSchemaInformer.Lister()),
 
 		getAPIConversion: informer.NewScopedGetterWithFallback[*apisv1alpha1.APIConversion, apisv1alpha1listers.APIConversionLister](apiConversionInformer.Lister(), globalAPIConversionInformer.Lister()),
@@ -169,12 +176,15 @@ func NewController(
 	_, _ = apiBindingInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			c.enqueueAPIBinding(tombstone.Obj[*apisv1alpha2.APIBinding](obj), logger, "")
+			c.enqueueDependentAPIBindings(tombstone.Obj[*apisv1alpha2.APIBinding](obj), logger)
 		},
 		UpdateFunc: func(_, obj interface{}) {
 			c.enqueueAPIBinding(tombstone.Obj[*apisv1alpha2.APIBinding](obj), logger, "")
+			c.enqueueDependentAPIBindings(tombstone.Obj[*apisv1alpha2.APIBinding](obj), logger)
 		},
 		DeleteFunc: func(obj interface{}) {
 			c.enqueueAPIBinding(tombstone.Obj[*apisv1alpha2.APIBinding](obj), logger, "")
+			c.enqueueDependentAPIBindings(tombstone.Obj[*apisv1alpha2.APIBinding](obj), logger)
 		},
 	})
 
@@ -279,6 +289,21 @@ func NewController(
 		},
 	}))
 
+	// APIDeployment handlers
+	for _, inf := range []apisv1alpha2informers.APIDeploymentClusterInformer{apiDeploymentInformer, globalAPIDeploymentInformer} {
+		_, _ = inf.Informer().AddEventHandler(events.WithoutSyncs(cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				c.enqueueAPIDeployment(tombstone.Obj[*apisv1alpha2.APIDeployment](obj), logger)
+			},
+			UpdateFunc: func(_, obj interface{}) {
+				c.enqueueAPIDeployment(tombstone.Obj[*apisv1alpha2.APIDeployment](obj), logger)
+			},
+			DeleteFunc: func(obj interface{}) {
+				c.enqueueAPIDeployment(tombstone.Obj[*apisv1alpha2.APIDeployment](obj), logger)
+			},
+		}))
+	}
+
 	return c, nil
 }
 
@@ -305,6 +330,8 @@ type controller struct {
 	getAPIExportByPath    func(path logicalcluster.Path, name string) (*apisv1alpha2.APIExport, error)
 	getAPIExportsBySchema func(schema *apisv1alpha1.APIResourceSchema) ([]*apisv1alpha2.APIExport, error)
 
+	listAPIDeployments func(apiExport *apisv1alpha2.APIExport) ([]*apisv1alpha2.APIDeployment, error)
+
 	getAPIResourceSchema func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIResourceSchema, error)
 
 	getAPIConversion func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIConversion, error)
@@ -332,6 +359,21 @@ func (c *controller) enqueueAPIBinding(apiBinding *apisv1alpha2.APIBinding, logg
 	c.queue.Add(key)
 }
 
+// enqueueDependentAPIBindings enqueues the APIBindings in the same logical cluster that depend on the given one.
+func (c *controller) enqueueDependentAPIBindings(apiBinding *apisv1alpha2.APIBinding, logger logr.Logger) {
+	bindings, err := c.listAPIBindings(logicalcluster.From(apiBinding))
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+
+	for _, binding := range bindings {
+		if slices.Contains(binding.Status.Dependencies, apiBinding.Name) {
+			c.enqueueAPIBinding(binding, logging.WithObject(logger, apiBinding), " because of dependency APIBinding")
+		}
+	}
+}
+
 // enqueueAPIExport enqueues maps an APIExport to APIBindings for enqueuing.
 func (c *controller) enqueueAPIExport(export *apisv1alpha2.APIExport, logger logr.Logger, logSuffix string) {
 	bindings, err := c.listAPIBindingsByAPIExport(export)
@@ -411,6 +453,21 @@ func (c *controller) enqueueAPIConversion(apiConversion *apisv1alpha1.APIConvers
 	c.enqueueAPIResourceSchema(apiResourceSchema, logger, "")
 }
 
+// enqueueAPIDeployment maps an APIDeployment to the APIBindings of its APIExport for enqueuing.
+func (c *controller) enqueueAPIDeployment(apiDeployment *apisv1alpha2.APIDeployment, logger logr.Logger) {
+	logger = logging.WithObject(logger, apiDeployment)
+
+	apiExport, err := c.getAPIExportByPath(logicalcluster.From(apiDeployment).Path(), apiDeployment.Spec.ExportName)
+	if apierrors.IsNotFound(err) {
+		return
+	} else if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+
+	c.enqueueAPIExport(apiExport, logger, " because of APIDeployment")
+}
+
 // Start starts the controller, which stops when ctx.Done() is closed.
 func (c *controller) Start(ctx context.Context, numThreads int) {
 	defer utilruntime.HandleCrash()
@@ -506,6 +563,8 @@ func InstallIndexers(
 	apiBindingInformer apisv1alpha2informers.APIBindingClusterInformer,
 	apiExportInformer apisv1alpha2informers.APIExportClusterInformer,
 	globalAPIExportInformer apisv1alpha2informers.APIExportClusterInformer,
+	apiDeploymentInformer apisv1alpha2informers.APIDeploymentClusterInformer,
+	globalAPIDeploymentInformer apisv1alpha2informers.APIDeploymentClusterInformer,
 ) {
 	// APIBinding indexers
 	indexers.AddIfNotPresentOrDie(apiBindingInformer.Informer().GetIndexer(), cache.Indexers{
@@ -521,4 +580,12 @@ func InstallIndexers(
 		indexers.ByLogicalClusterPathAndName: indexers.IndexByLogicalClusterPathAndName,
 		indexAPIExportsByAPIResourceSchema:   indexAPIExportsByAPIResourceSchemasFunc,
 	})
+
+	// APIDeployment indexers
+	indexers.AddIfNotPresentOrDie(apiDeploymentInformer.Informer().GetIndexer(), cache.Indexers{
+		indexers.APIDeploymentByAPIExport: indexers.IndexAPIDeploymentByAPIExport,
+	})
+	indexers.AddIfNotPresentOrDie(globalAPIDeploymentInformer.Informer().GetIndexer(), cache.Indexers{
+		indexers.APIDeploymentByAPIExport: indexers.IndexAPIDeploymentByAPIExport,
+	})
 }
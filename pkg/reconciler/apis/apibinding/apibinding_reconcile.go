@@ -43,6 +43,7 @@ import (
 	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
 
 	"github.com/kcp-dev/kcp/pkg/logging"
+	"github.com/kcp-dev/kcp/pkg/reconciler/apis/apideployment"
 )
 
 type reconcileStatus int
@@ -62,6 +63,7 @@ func (c *controller) reconcile(ctx context.Context, apiBinding *apisv1alpha2.API
 			newReconciler:     &newReconciler{controller: c},
 			bindingReconciler: &bindingReconciler{controller: c},
 		},
+		&dependencyReconciler{controller: c},
 		&summaryReconciler{controller: c},
 	}
 
@@ -210,10 +212,30 @@ func (r *bindingReconciler) reconcile(ctx context.Context, apiBinding *apisv1alp
 	// The full path is unreliable for this purpose.
 	apiBinding.Status.APIExportClusterName = logicalcluster.From(apiExport).String()
 
+	// A release pinned or followed via a channel replaces the resources of the APIExport.
+	resources, ok, err := r.releaseResources(apiBinding, apiExport)
+	if err != nil || !ok {
+		return reconcileStatusContinue, err
+	}
+	if resources == nil {
+		// An APIDeployment rolling out new schemas to this binding takes precedence over the APIExport.
+		deployments, err := r.listAPIDeployments(apiExport)
+		if err != nil {
+			return reconcileStatusContinue, err
+		}
+		resources = apideployment.BindingResources(apiExport, deployments, apiBinding)
+	}
+
+	// Only bind the resources selected by the APIBinding.
+	resources, ok = selectedResources(apiBinding, apiExport, resources)
+	if !ok {
+		return reconcileStatusContinue, nil
+	}
+
 	// Collect the schemas.
 	schemas := make(map[string]*apisv1alpha1.APIResourceSchema)
 	grs := sets.New[schema.GroupResource]()
-	for _, resourceSchema := range apiExport.Spec.Resources {
+	for _, resourceSchema := range resources {
 		sch, err := r.getAPIResourceSchema(logicalcluster.From(apiExport), resourceSchema.Schema)
 		if err != nil {
 			logger.Error(err, "error binding")
@@ -233,7 +255,8 @@ func (r *bindingReconciler) reconcile(ctx context.Context, apiBinding *apisv1alp
 			return reconcileStatusContinue, err
 		}
 		schemas[resourceSchema.Schema] = sch
-		grs = grs.Insert(schema.GroupResource{Group: sch.Spec.Group, Resource: sch.Spec.Names.Plural})
+		// Lock the resource under the name it is served as in this workspace.
+		grs = grs.Insert(servedGroupResource(apiBinding, sch))
 	}
 
 	crds, err := r.listCRDs(logicalcluster.From(apiBinding))
@@ -326,18 +349,18 @@ func (r *bindingReconciler) reconcile(ctx context.Context, apiBinding *apisv1alp
 		return reconcileStatusContinue, err
 	}
 	var needToWaitForRequeueWhenEstablished []string
-	for _, resourceSchema := range apiExport.Spec.Resources {
+	for _, resourceSchema := range resources {
 		sch := schemas[resourceSchema.Schema]
 		logger := logging.WithObject(logger, sch)
 
-		if _, ok := skipped[schema.GroupResource{Group: sch.Spec.Group, Resource: sch.Spec.Names.Plural}]; ok {
+		if _, ok := skipped[servedGroupResource(apiBinding, sch)]; ok {
 			// This resource was skipped because it's already locked by another binding.
 			continue
 		}
 
 		// A resource will be served if the group resource is locked by this binding AND there are no
 		// naming conflicts with other bindings or CRDs. The former is critical, the latter is advisory.
-		if err := checker.Check(apiBinding, sch); err != nil {
+		if err := checker.Check(apiBinding, servedSchema(apiBinding, sch)); err != nil {
 			conditions.MarkFalse(
 				apiBinding,
 				apisv1alpha2.BindingUpToDate,
@@ -531,6 +554,9 @@ func (r *bindingReconciler) reconcile(ctx context.Context, apiBinding *apisv1alp
 			},
 			StorageVersions: sortedStorageVersions,
 		}
+		if rename := apiBinding.Spec.FindResourceRename(sch.Spec.Group, sch.Spec.Names.Plural); rename != nil {
+			newBoundResource.ServedAs = &apisv1alpha2.GroupResource{Group: rename.As.Group, Resource: rename.As.Resource}
+		}
 
 		found := false
 		for i, r := range apiBinding.Status.BoundResources {
@@ -598,6 +624,149 @@ func (r *bindingReconciler) reconcile(ctx context.Context, apiBinding *apisv1alp
 	return reconcileStatusContinue, nil
 }
 
+// dependencyReconciler makes sure that the dependencies of the referenced APIExport are
+// bound in the workspace. Missing APIBindings are reported, but not created.
+type dependencyReconciler struct {
+	*controller
+}
+
+func (r *dependencyReconciler) reconcile(ctx context.Context, apiBinding *apisv1alpha2.APIBinding) (reconcileStatus, error) {
+	// Wait for the bindingReconciler to find a valid APIExport.
+	workspaceRef := apiBinding.Spec.Reference.Export
+	if workspaceRef == nil || apiBinding.Status.APIExportClusterName == "" || !apiBinding.DeletionTimestamp.IsZero() {
+		return reconcileStatusContinue, nil
+	}
+	apiExportPath := logicalcluster.NewPath(workspaceRef.Path)
+	if apiExportPath.Empty() {
+		apiExportPath = logicalcluster.From(apiBinding).Path()
+	}
+	apiExport, err := r.getAPIExportByPath(apiExportPath, workspaceRef.Name)
+	if apierrors.IsNotFound(err) {
+		return reconcileStatusContinue, nil
+	} else if err != nil {
+		return reconcileStatusContinue, err
+	}
+
+	if len(apiExport.Spec.Dependencies) == 0 {
+		apiBinding.Status.Dependencies = nil
+		conditions.Delete(apiBinding, apisv1alpha2.DependenciesValid)
+		return reconcileStatusContinue, nil
+	}
+
+	clusterName := logicalcluster.From(apiBinding)
+	bindings, err := r.listAPIBindings(clusterName)
+	if err != nil {
+		return reconcileStatusContinue, err
+	}
+
+	dependencies := sets.New[string]()
+	var notFound, notBound, waiting []string
+	for _, dependency := range apiExport.Spec.Dependencies {
+		// An empty path refers to the workspace of the depending APIExport.
+		dependencyPath := logicalcluster.NewPath(dependency.Path)
+		if dependencyPath.Empty() {
+			dependencyPath = apiExportPath
+		}
+		dependencyExport, err := r.getAPIExportByPath(dependencyPath, dependency.Name)
+		if apierrors.IsNotFound(err) {
+			notFound = append(notFound, fmt.Sprintf("%s|%s", dependencyPath, dependency.Name))
+			continue
+		} else if err != nil {
+			return reconcileStatusContinue, err
+		}
+		if logicalcluster.From(dependencyExport) == logicalcluster.From(apiExport) && dependencyExport.Name == apiExport.Name {
+			continue
+		}
+
+		binding, err := r.findAPIBindingForAPIExport(bindings, dependencyExport)
+		if err != nil {
+			return reconcileStatusContinue, err
+		}
+		if binding == nil {
+			// APIBindings for dependencies are not created on behalf of the user, who might not be
+			// allowed to bind the APIExport, e.g. if it was added to the dependencies later.
+			notBound = append(notBound, fmt.Sprintf("%s|%s", dependencyPath, dependency.Name))
+			continue
+		}
+
+		dependencies.Insert(binding.Name)
+		if binding.Status.Phase != apisv1alpha2.APIBindingPhaseBound {
+			waiting = append(waiting, binding.Name)
+		}
+	}
+
+	apiBinding.Status.Dependencies = nil
+	if dependencies.Len() > 0 {
+		apiBinding.Status.Dependencies = sets.List[string](dependencies)
+	}
+
+	switch {
+	case len(notFound) > 0:
+		conditions.MarkFalse(
+			apiBinding,
+			apisv1alpha2.DependenciesValid,
+			apisv1alpha2.DependencyNotFoundReason,
+			conditionsv1alpha1.ConditionSeverityError,
+			"APIExport dependencies not found: %s",
+			strings.Join(notFound, ", "),
+		)
+	case len(notBound) > 0:
+		conditions.MarkFalse(
+			apiBinding,
+			apisv1alpha2.DependenciesValid,
+			apisv1alpha2.DependencyNotBoundReason,
+			conditionsv1alpha1.ConditionSeverityError,
+			"APIExport dependencies must be bound by APIBindings in this workspace: %s",
+			strings.Join(notBound, ", "),
+		)
+	case len(waiting) > 0:
+		conditions.MarkFalse(
+			apiBinding,
+			apisv1alpha2.DependenciesValid,
+			apisv1alpha2.WaitingForDependenciesReason,
+			conditionsv1alpha1.ConditionSeverityInfo,
+			"Waiting for APIBindings of dependencies to be bound: %s",
+			strings.Join(waiting, ", "),
+		)
+	default:
+		conditions.MarkTrue(apiBinding, apisv1alpha2.DependenciesValid)
+	}
+
+	return reconcileStatusContinue, nil
+}
+
+// findAPIBindingForAPIExport returns the APIBinding that binds the given APIExport, or nil if there is none.
+func (r *dependencyReconciler) findAPIBindingForAPIExport(bindings []*apisv1alpha2.APIBinding, apiExport *apisv1alpha2.APIExport) (*apisv1alpha2.APIBinding, error) {
+	for _, binding := range bindings {
+		ref := binding.Spec.Reference.Export
+		if ref == nil || ref.Name != apiExport.Name {
+			continue
+		}
+		if binding.Status.APIExportClusterName != "" {
+			if binding.Status.APIExportClusterName == logicalcluster.From(apiExport).String() {
+				return binding, nil
+			}
+			continue
+		}
+
+		// not bound yet, resolve the reference.
+		path := logicalcluster.NewPath(ref.Path)
+		if path.Empty() {
+			path = logicalcluster.From(binding).Path()
+		}
+		export, err := r.getAPIExportByPath(path, ref.Name)
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if logicalcluster.From(export) == logicalcluster.From(apiExport) {
+			return binding, nil
+		}
+	}
+	return nil, nil
+}
+
 func boundCRDName(schema *apisv1alpha1.APIResourceSchema) string {
 	return string(schema.UID)
 }
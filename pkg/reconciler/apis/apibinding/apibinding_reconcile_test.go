@@ -616,6 +616,9 @@ func TestReconcileBinding(t *testing.T) {
 					require.Equal(t, "org:some-workspace", path.String())
 					return apiExports[name], tc.getAPIExportError
 				},
+				listAPIDeployments: func(apiExport *apisv1alpha2.APIExport) ([]*apisv1alpha2.APIDeployment, error) {
+					return nil, nil
+				},
 				getAPIResourceSchema: func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIResourceSchema, error) {
 					if tc.getAPIResourceSchemaError != nil {
 						return nil, tc.getAPIResourceSchemaError
@@ -796,6 +799,124 @@ func TestReconcileBinding(t *testing.T) {
 	}
 }
 
+func TestReconcileDependencies(t *testing.T) {
+	newExport := func(clusterName logicalcluster.Name, name string, dependencies ...apisv1alpha2.APIExportDependency) *apisv1alpha2.APIExport {
+		return &apisv1alpha2.APIExport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Annotations: map[string]string{logicalcluster.AnnotationKey: clusterName.String()},
+			},
+			Spec: apisv1alpha2.APIExportSpec{Dependencies: dependencies},
+		}
+	}
+	newBinding := func(name, exportName string, phase apisv1alpha2.APIBindingPhaseType) *apisv1alpha2.APIBinding {
+		binding := newBindingBuilder().
+			WithClusterName("org-ws").
+			WithName(name).
+			WithExportReference(logicalcluster.NewPath("org:providers"), exportName).
+			WithPhase(phase).
+			Build()
+		binding.Status.APIExportClusterName = "org-providers"
+		return binding
+	}
+
+	apiExports := map[string]*apisv1alpha2.APIExport{
+		"org:providers|workloads": newExport("org-providers", "workloads",
+			apisv1alpha2.APIExportDependency{Name: "networking"},
+			apisv1alpha2.APIExportDependency{Path: "org:storage", Name: "volumes"},
+		),
+		"org:providers|networking": newExport("org-providers", "networking"),
+		"org:storage|volumes":      newExport("org-storage", "volumes"),
+		"org:providers|missing":    newExport("org-providers", "missing", apisv1alpha2.APIExportDependency{Name: "gone"}),
+		"org:providers|standalone": newExport("org-providers", "standalone"),
+	}
+
+	tests := map[string]struct {
+		apiBinding          *apisv1alpha2.APIBinding
+		existingAPIBindings []*apisv1alpha2.APIBinding
+
+		wantDependencies []string
+		wantCondition    *conditionsv1alpha1.Condition
+	}{
+		"no dependencies": {
+			apiBinding: newBinding("standalone", "standalone", apisv1alpha2.APIBindingPhaseBound),
+		},
+		"dependencies are bound": {
+			apiBinding: newBinding("workloads", "workloads", apisv1alpha2.APIBindingPhaseBound),
+			existingAPIBindings: []*apisv1alpha2.APIBinding{
+				newBinding("my-networking", "networking", apisv1alpha2.APIBindingPhaseBound),
+				newBindingBuilder().WithClusterName("org-ws").WithName("volumes").WithExportReference(logicalcluster.NewPath("org:storage"), "volumes").WithPhase(apisv1alpha2.APIBindingPhaseBound).Build(),
+			},
+			wantDependencies: []string{"my-networking", "volumes"},
+			wantCondition:    &conditionsv1alpha1.Condition{Type: apisv1alpha2.DependenciesValid, Status: corev1.ConditionTrue},
+		},
+		"missing dependency bindings are not created": {
+			apiBinding: newBinding("workloads", "workloads", apisv1alpha2.APIBindingPhaseBound),
+			existingAPIBindings: []*apisv1alpha2.APIBinding{
+				newBinding("other-networking", "networking", apisv1alpha2.APIBindingPhaseBound),
+			},
+			wantDependencies: []string{"other-networking"},
+			wantCondition: &conditionsv1alpha1.Condition{
+				Type:    apisv1alpha2.DependenciesValid,
+				Status:  corev1.ConditionFalse,
+				Reason:  apisv1alpha2.DependencyNotBoundReason,
+				Message: "org:storage|volumes",
+			},
+		},
+		"waiting for dependencies": {
+			apiBinding: newBinding("workloads", "workloads", apisv1alpha2.APIBindingPhaseBound),
+			existingAPIBindings: []*apisv1alpha2.APIBinding{
+				newBinding("other-networking", "networking", apisv1alpha2.APIBindingPhaseBinding),
+				newBindingBuilder().WithClusterName("org-ws").WithName("volumes").WithExportReference(logicalcluster.NewPath("org:storage"), "volumes").Build(),
+			},
+			wantDependencies: []string{"other-networking", "volumes"},
+			wantCondition: &conditionsv1alpha1.Condition{
+				Type:    apisv1alpha2.DependenciesValid,
+				Status:  corev1.ConditionFalse,
+				Reason:  apisv1alpha2.WaitingForDependenciesReason,
+				Message: "other-networking, volumes",
+			},
+		},
+		"dependency not found": {
+			apiBinding: newBinding("missing", "missing", apisv1alpha2.APIBindingPhaseBound),
+			wantCondition: &conditionsv1alpha1.Condition{
+				Type:    apisv1alpha2.DependenciesValid,
+				Status:  corev1.ConditionFalse,
+				Reason:  apisv1alpha2.DependencyNotFoundReason,
+				Message: "org:providers|gone",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &dependencyReconciler{controller: &controller{
+				listAPIBindings: func(clusterName logicalcluster.Name) ([]*apisv1alpha2.APIBinding, error) {
+					require.Equal(t, "org-ws", clusterName.String())
+					return append(tc.existingAPIBindings, tc.apiBinding), nil
+				},
+				getAPIExportByPath: func(path logicalcluster.Path, name string) (*apisv1alpha2.APIExport, error) {
+					if export, ok := apiExports[path.String()+"|"+name]; ok {
+						return export, nil
+					}
+					return nil, apierrors.NewNotFound(apisv1alpha2.Resource("apiexports"), name)
+				},
+			}}
+
+			apiBinding := tc.apiBinding.DeepCopy()
+			_, err := r.reconcile(context.Background(), apiBinding)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.wantDependencies, apiBinding.Status.Dependencies)
+			if tc.wantCondition == nil {
+				require.Nil(t, conditions.Get(apiBinding, apisv1alpha2.DependenciesValid))
+			} else {
+				requireConditionMatches(t, apiBinding, tc.wantCondition)
+			}
+		})
+	}
+}
+
 func TestCRDFromAPIResourceSchema(t *testing.T) {
 	tests := map[string]struct {
 		schema  *apisv1alpha1.APIResourceSchema
@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apibinding
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+	"github.com/kcp-dev/sdk/schemacompat"
+)
+
+// releaseResources returns the resources of the APIExport release the APIBinding is bound to, and records
+// that release in the status. An APIBinding following a channel is moved to the release the channel points
+// at according to its upgrade policy.
+//
+// If the APIBinding does not reference a release or channel, no resources are returned and the caller binds
+// the resources of the APIExport. If the referenced release or channel does not exist, the APIExportValid
+// condition is set and ok is false.
+func (r *bindingReconciler) releaseResources(apiBinding *apisv1alpha2.APIBinding, apiExport *apisv1alpha2.APIExport) (resources []apisv1alpha2.ResourceSchema, ok bool, err error) {
+	ref := apiBinding.Spec.Reference.Export
+
+	if ref.Release == "" && ref.Channel == "" {
+		apiBinding.Status.Release = ""
+		conditions.Delete(apiBinding, apisv1alpha2.ReleaseUpToDate)
+		return nil, true, nil
+	}
+
+	if ref.Release != "" {
+		release := apiExport.Spec.FindRelease(ref.Release)
+		if release == nil {
+			conditions.MarkFalse(
+				apiBinding,
+				apisv1alpha2.APIExportValid,
+				apisv1alpha2.ReleaseNotFoundReason,
+				conditionsv1alpha1.ConditionSeverityError,
+				"Release %q not found in APIExport %s|%s",
+				ref.Release,
+				logicalcluster.From(apiExport),
+				apiExport.Name,
+			)
+			return nil, false, nil
+		}
+
+		// A pinned release is never moved, hence there is nothing to be up-to-date with.
+		apiBinding.Status.Release = release.Name
+		conditions.Delete(apiBinding, apisv1alpha2.ReleaseUpToDate)
+		return release.Resources, true, nil
+	}
+
+	channel := apiExport.Spec.FindChannel(ref.Channel)
+	if channel == nil {
+		conditions.MarkFalse(
+			apiBinding,
+			apisv1alpha2.APIExportValid,
+			apisv1alpha2.ReleaseNotFoundReason,
+			conditionsv1alpha1.ConditionSeverityError,
+			"Channel %q not found in APIExport %s|%s",
+			ref.Channel,
+			logicalcluster.From(apiExport),
+			apiExport.Name,
+		)
+		return nil, false, nil
+	}
+	target := apiExport.Spec.FindRelease(channel.Release)
+	if target == nil {
+		conditions.MarkFalse(
+			apiBinding,
+			apisv1alpha2.APIExportValid,
+			apisv1alpha2.ReleaseNotFoundReason,
+			conditionsv1alpha1.ConditionSeverityError,
+			"Release %q of channel %q not found in APIExport %s|%s",
+			channel.Release,
+			ref.Channel,
+			logicalcluster.From(apiExport),
+			apiExport.Name,
+		)
+		return nil, false, nil
+	}
+
+	// With the Compatible policy, stay on the current release if the schemas of the target release
+	// would break existing objects. A current release that is gone cannot be stayed on.
+	if current := apiExport.Spec.FindRelease(apiBinding.Status.Release); current != nil && current.Name != target.Name && ref.UpgradePolicy == apisv1alpha2.ReleaseUpgradePolicyCompatible {
+		incompatibility, err := r.releasesCompatible(logicalcluster.From(apiExport), current, target)
+		if err != nil {
+			return nil, false, err
+		}
+		if incompatibility != nil {
+			conditions.MarkFalse(
+				apiBinding,
+				apisv1alpha2.ReleaseUpToDate,
+				apisv1alpha2.IncompatibleReleaseReason,
+				conditionsv1alpha1.ConditionSeverityWarning,
+				"Not moving from release %q to release %q of channel %q: %v",
+				current.Name,
+				target.Name,
+				ref.Channel,
+				incompatibility,
+			)
+			return current.Resources, true, nil
+		}
+	}
+
+	apiBinding.Status.Release = target.Name
+	conditions.MarkTrue(apiBinding, apisv1alpha2.ReleaseUpToDate)
+	return target.Resources, true, nil
+}
+
+// releasesCompatible checks that every resource of the current release is still part of the target
+// release, with a compatible schema. It returns the incompatibility, or an error if a schema could not be
+// retrieved.
+func (r *bindingReconciler) releasesCompatible(exportCluster logicalcluster.Name, current, target *apisv1alpha2.APIExportRelease) (incompatibility, err error) {
+	for _, currentResource := range current.Resources {
+		var targetResource *apisv1alpha2.ResourceSchema
+		for i := range target.Resources {
+			if target.Resources[i].Group == currentResource.Group && target.Resources[i].Name == currentResource.Name {
+				targetResource = &target.Resources[i]
+				break
+			}
+		}
+		if targetResource == nil {
+			return fmt.Errorf("resource %s is removed", schema.GroupResource{Group: currentResource.Group, Resource: currentResource.Name}), nil
+		}
+		if targetResource.Schema == currentResource.Schema {
+			continue
+		}
+
+		existing, err := r.getAPIResourceSchema(exportCluster, currentResource.Schema)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := r.getAPIResourceSchema(exportCluster, targetResource.Schema)
+		if err != nil {
+			return nil, err
+		}
+		if err := schemacompat.EnsureAPIResourceSchemaCompatibility(existing, updated); err != nil {
+			return fmt.Errorf("resource %s: %w", schema.GroupResource{Group: currentResource.Group, Resource: currentResource.Name}, err), nil
+		}
+	}
+
+	return nil, nil
+}
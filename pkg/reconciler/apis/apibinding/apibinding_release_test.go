@@ -0,0 +1,248 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apibinding
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+)
+
+func TestReleaseResources(t *testing.T) {
+	newSchema := func(name, properties string) *apisv1alpha1.APIResourceSchema {
+		return &apisv1alpha1.APIResourceSchema{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: apisv1alpha1.APIResourceSchemaSpec{
+				Group: "kcp.io",
+				Names: apiextensionsv1.CustomResourceDefinitionNames{
+					Plural:   "widgets",
+					Singular: "widget",
+					Kind:     "Widget",
+					ListKind: "WidgetList",
+				},
+				Scope: "Namespaced",
+				Versions: []apisv1alpha1.APIResourceVersion{{
+					Name:    "v1",
+					Served:  true,
+					Storage: true,
+					Schema: runtime.RawExtension{
+						Raw: []byte(fmt.Sprintf(`{"type":"object","properties":{"spec":{"type":"object","properties":%s}}}`, properties)),
+					},
+				}},
+			},
+		}
+	}
+	schemas := map[string]*apisv1alpha1.APIResourceSchema{
+		"v1.widgets.kcp.io": newSchema("v1.widgets.kcp.io", `{"size":{"type":"string"}}`),
+		"v2.widgets.kcp.io": newSchema("v2.widgets.kcp.io", `{"size":{"type":"string"},"color":{"type":"string"}}`),
+		"v3.widgets.kcp.io": newSchema("v3.widgets.kcp.io", `{"size":{"type":"integer"}}`),
+		"v1.gadgets.kcp.io": newSchema("v1.gadgets.kcp.io", `{}`),
+	}
+
+	widgets := func(schema string) apisv1alpha2.ResourceSchema {
+		return apisv1alpha2.ResourceSchema{Group: "kcp.io", Name: "widgets", Schema: schema}
+	}
+	gadgets := apisv1alpha2.ResourceSchema{Group: "kcp.io", Name: "gadgets", Schema: "v1.gadgets.kcp.io"}
+
+	apiExport := &apisv1alpha2.APIExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "widgets",
+			Annotations: map[string]string{logicalcluster.AnnotationKey: "org-providers"},
+		},
+		Spec: apisv1alpha2.APIExportSpec{
+			Resources: []apisv1alpha2.ResourceSchema{widgets("default.widgets.kcp.io")},
+			Releases: []apisv1alpha2.APIExportRelease{
+				{Name: "v1", Resources: []apisv1alpha2.ResourceSchema{widgets("v1.widgets.kcp.io"), gadgets}},
+				{Name: "v2", Resources: []apisv1alpha2.ResourceSchema{widgets("v2.widgets.kcp.io"), gadgets}},
+				{Name: "v3", Resources: []apisv1alpha2.ResourceSchema{widgets("v3.widgets.kcp.io"), gadgets}},
+				{Name: "v4", Resources: []apisv1alpha2.ResourceSchema{widgets("v2.widgets.kcp.io")}},
+				{Name: "broken", Resources: []apisv1alpha2.ResourceSchema{widgets("missing.widgets.kcp.io"), gadgets}},
+			},
+			Channels: []apisv1alpha2.APIExportChannel{
+				{Name: "stable", Release: "v2"},
+				{Name: "incompatible", Release: "v3"},
+				{Name: "removal", Release: "v4"},
+				{Name: "broken", Release: "broken"},
+				{Name: "dangling", Release: "gone"},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		release       string
+		channel       string
+		upgradePolicy apisv1alpha2.ReleaseUpgradePolicy
+		boundRelease  string
+
+		wantResources         []apisv1alpha2.ResourceSchema
+		wantNotOK             bool
+		wantErr               bool
+		wantRelease           string
+		wantAPIExportValid    string
+		wantReleaseUpToDate   corev1.ConditionStatus
+		wantReleaseReason     string
+		wantNoReleaseUpToDate bool
+	}{
+		"no release or channel": {
+			boundRelease:          "v1",
+			wantNoReleaseUpToDate: true,
+		},
+		"pinned release": {
+			release:               "v1",
+			wantResources:         []apisv1alpha2.ResourceSchema{widgets("v1.widgets.kcp.io"), gadgets},
+			wantRelease:           "v1",
+			wantNoReleaseUpToDate: true,
+		},
+		"pinned release not found": {
+			release:            "gone",
+			wantNotOK:          true,
+			wantAPIExportValid: apisv1alpha2.ReleaseNotFoundReason,
+		},
+		"channel not found": {
+			channel:            "gone",
+			wantNotOK:          true,
+			wantAPIExportValid: apisv1alpha2.ReleaseNotFoundReason,
+		},
+		"release of channel not found": {
+			channel:            "dangling",
+			wantNotOK:          true,
+			wantAPIExportValid: apisv1alpha2.ReleaseNotFoundReason,
+		},
+		"channel, initial binding": {
+			channel:             "incompatible",
+			upgradePolicy:       apisv1alpha2.ReleaseUpgradePolicyCompatible,
+			wantResources:       []apisv1alpha2.ResourceSchema{widgets("v3.widgets.kcp.io"), gadgets},
+			wantRelease:         "v3",
+			wantReleaseUpToDate: corev1.ConditionTrue,
+		},
+		"channel, automatic upgrade to incompatible release": {
+			channel:             "incompatible",
+			boundRelease:        "v1",
+			wantResources:       []apisv1alpha2.ResourceSchema{widgets("v3.widgets.kcp.io"), gadgets},
+			wantRelease:         "v3",
+			wantReleaseUpToDate: corev1.ConditionTrue,
+		},
+		"channel, compatible upgrade": {
+			channel:             "stable",
+			upgradePolicy:       apisv1alpha2.ReleaseUpgradePolicyCompatible,
+			boundRelease:        "v1",
+			wantResources:       []apisv1alpha2.ResourceSchema{widgets("v2.widgets.kcp.io"), gadgets},
+			wantRelease:         "v2",
+			wantReleaseUpToDate: corev1.ConditionTrue,
+		},
+		"channel, incompatible schema is not upgraded": {
+			channel:             "incompatible",
+			upgradePolicy:       apisv1alpha2.ReleaseUpgradePolicyCompatible,
+			boundRelease:        "v1",
+			wantResources:       []apisv1alpha2.ResourceSchema{widgets("v1.widgets.kcp.io"), gadgets},
+			wantRelease:         "v1",
+			wantReleaseUpToDate: corev1.ConditionFalse,
+			wantReleaseReason:   apisv1alpha2.IncompatibleReleaseReason,
+		},
+		"channel, removed resource is not upgraded": {
+			channel:             "removal",
+			upgradePolicy:       apisv1alpha2.ReleaseUpgradePolicyCompatible,
+			boundRelease:        "v2",
+			wantResources:       []apisv1alpha2.ResourceSchema{widgets("v2.widgets.kcp.io"), gadgets},
+			wantRelease:         "v2",
+			wantReleaseUpToDate: corev1.ConditionFalse,
+			wantReleaseReason:   apisv1alpha2.IncompatibleReleaseReason,
+		},
+		"channel, bound release is gone": {
+			channel:             "stable",
+			upgradePolicy:       apisv1alpha2.ReleaseUpgradePolicyCompatible,
+			boundRelease:        "v0",
+			wantResources:       []apisv1alpha2.ResourceSchema{widgets("v2.widgets.kcp.io"), gadgets},
+			wantRelease:         "v2",
+			wantReleaseUpToDate: corev1.ConditionTrue,
+		},
+		"channel, missing schema": {
+			channel:       "broken",
+			upgradePolicy: apisv1alpha2.ReleaseUpgradePolicyCompatible,
+			boundRelease:  "v1",
+			wantNotOK:     true,
+			wantErr:       true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			apiBinding := newBindingBuilder().
+				WithClusterName("org-ws").
+				WithName("widgets").
+				WithExportReference(logicalcluster.NewPath("org:providers"), "widgets").
+				Build()
+			apiBinding.Spec.Reference.Export.Release = tc.release
+			apiBinding.Spec.Reference.Export.Channel = tc.channel
+			apiBinding.Spec.Reference.Export.UpgradePolicy = tc.upgradePolicy
+			apiBinding.Status.Release = tc.boundRelease
+
+			r := &bindingReconciler{
+				controller: &controller{
+					getAPIResourceSchema: func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIResourceSchema, error) {
+						require.Equal(t, logicalcluster.Name("org-providers"), clusterName)
+						sch, ok := schemas[name]
+						if !ok {
+							return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+						}
+						return sch, nil
+					},
+				},
+			}
+
+			resources, ok, err := r.releaseResources(apiBinding, apiExport)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, !tc.wantNotOK, ok)
+			require.Equal(t, tc.wantResources, resources)
+			if tc.wantErr {
+				return
+			}
+
+			if tc.wantAPIExportValid != "" {
+				require.Equal(t, tc.wantAPIExportValid, conditions.GetReason(apiBinding, apisv1alpha2.APIExportValid))
+				return
+			}
+			require.Equal(t, tc.wantRelease, apiBinding.Status.Release)
+
+			if tc.wantNoReleaseUpToDate {
+				require.Nil(t, conditions.Get(apiBinding, apisv1alpha2.ReleaseUpToDate))
+				return
+			}
+			cond := conditions.Get(apiBinding, apisv1alpha2.ReleaseUpToDate)
+			require.NotNil(t, cond)
+			require.Equal(t, tc.wantReleaseUpToDate, cond.Status)
+			require.Equal(t, tc.wantReleaseReason, cond.Reason)
+		})
+	}
+}
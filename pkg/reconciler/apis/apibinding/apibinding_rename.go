@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apibinding
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+)
+
+// ServedCRD returns the bound CRD of the given bound resource as it is served in the workspace, i.e. with
+// the group and names the APIBinding renames it to. The bound CRD itself is returned if the resource is not
+// renamed. Objects are still stored under the group and resource of the bound CRD.
+func ServedCRD(crd *apiextensionsv1.CustomResourceDefinition, boundResource apisv1alpha2.BoundAPIResource) *apiextensionsv1.CustomResourceDefinition {
+	if boundResource.ServedAs == nil {
+		return crd
+	}
+
+	out := crd.DeepCopy()
+	out.Spec.Group = boundResource.ServedAs.Group
+	out.Spec.Names = servedNames(crd.Spec.Names, boundResource.ServedAs.Resource)
+	out.Status.AcceptedNames = servedNames(crd.Status.AcceptedNames, boundResource.ServedAs.Resource)
+	return out
+}
+
+// servedSchema returns the schema as it is served in the workspace of the APIBinding, i.e. renamed
+// according to spec.resourceRenames. The schema itself is returned if it is not renamed.
+func servedSchema(apiBinding *apisv1alpha2.APIBinding, sch *apisv1alpha1.APIResourceSchema) *apisv1alpha1.APIResourceSchema {
+	rename := apiBinding.Spec.FindResourceRename(sch.Spec.Group, sch.Spec.Names.Plural)
+	if rename == nil {
+		return sch
+	}
+
+	out := sch.DeepCopy()
+	out.Spec.Group = rename.As.Group
+	out.Spec.Names = servedNames(sch.Spec.Names, rename.As.Resource)
+	return out
+}
+
+// servedGroupResource returns the group and resource the schema is served as in the workspace of the APIBinding.
+func servedGroupResource(apiBinding *apisv1alpha2.APIBinding, sch *apisv1alpha1.APIResourceSchema) schema.GroupResource {
+	if rename := apiBinding.Spec.FindResourceRename(sch.Spec.Group, sch.Spec.Names.Plural); rename != nil {
+		return schema.GroupResource{Group: rename.As.Group, Resource: rename.As.Resource}
+	}
+	return schema.GroupResource{Group: sch.Spec.Group, Resource: sch.Spec.Names.Plural}
+}
+
+// servedNames returns the names of a resource served as the given plural. Short names are dropped because they
+// would be ambiguous with the original resource, and a renamed plural replaces the singular. The kind is kept as
+// it is part of the stored objects.
+func servedNames(names apiextensionsv1.CustomResourceDefinitionNames, plural string) apiextensionsv1.CustomResourceDefinitionNames {
+	out := *names.DeepCopy()
+	if out.Plural != plural {
+		out.Singular = plural
+	}
+	out.Plural = plural
+	out.ShortNames = nil
+	return out
+}
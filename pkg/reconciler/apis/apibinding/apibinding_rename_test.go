@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apibinding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+)
+
+func TestResourceRenames(t *testing.T) {
+	widgetNames := apiextensionsv1.CustomResourceDefinitionNames{
+		Plural:     "widgets",
+		Singular:   "widget",
+		ShortNames: []string{"wd"},
+		Kind:       "Widget",
+		ListKind:   "WidgetList",
+	}
+	sch := &apisv1alpha1.APIResourceSchema{
+		ObjectMeta: metav1.ObjectMeta{Name: "today.widgets.example.io"},
+		Spec: apisv1alpha1.APIResourceSchemaSpec{
+			Group: "example.io",
+			Names: widgetNames,
+		},
+	}
+
+	tests := map[string]struct {
+		renames []apisv1alpha2.ResourceRename
+
+		wantGroupResource schema.GroupResource
+		wantNames         apiextensionsv1.CustomResourceDefinitionNames
+	}{
+		"not renamed": {
+			wantGroupResource: schema.GroupResource{Group: "example.io", Resource: "widgets"},
+			wantNames:         widgetNames,
+		},
+		"other resource renamed": {
+			renames: []apisv1alpha2.ResourceRename{{
+				GroupResource: apisv1alpha2.GroupResource{Group: "example.io", Resource: "gadgets"},
+				As:            apisv1alpha2.GroupResource{Group: "acme.example.com", Resource: "gadgets"},
+			}},
+			wantGroupResource: schema.GroupResource{Group: "example.io", Resource: "widgets"},
+			wantNames:         widgetNames,
+		},
+		"group renamed": {
+			renames: []apisv1alpha2.ResourceRename{{
+				GroupResource: apisv1alpha2.GroupResource{Group: "example.io", Resource: "widgets"},
+				As:            apisv1alpha2.GroupResource{Group: "acme.example.com", Resource: "widgets"},
+			}},
+			wantGroupResource: schema.GroupResource{Group: "acme.example.com", Resource: "widgets"},
+			wantNames: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:   "widgets",
+				Singular: "widget",
+				Kind:     "Widget",
+				ListKind: "WidgetList",
+			},
+		},
+		"resource renamed": {
+			renames: []apisv1alpha2.ResourceRename{{
+				GroupResource: apisv1alpha2.GroupResource{Group: "example.io", Resource: "widgets"},
+				As:            apisv1alpha2.GroupResource{Group: "acme.example.com", Resource: "acmewidgets"},
+			}},
+			wantGroupResource: schema.GroupResource{Group: "acme.example.com", Resource: "acmewidgets"},
+			wantNames: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:   "acmewidgets",
+				Singular: "acmewidgets",
+				Kind:     "Widget",
+				ListKind: "WidgetList",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			apiBinding := newBindingBuilder().WithName("widgets").Build()
+			apiBinding.Spec.ResourceRenames = tc.renames
+
+			require.Equal(t, tc.wantGroupResource, servedGroupResource(apiBinding, sch))
+
+			served := servedSchema(apiBinding, sch)
+			require.Equal(t, tc.wantGroupResource.Group, served.Spec.Group)
+			require.Equal(t, tc.wantNames, served.Spec.Names)
+			require.Equal(t, "example.io", sch.Spec.Group, "schema must not be mutated")
+
+			crd := &apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "uid"},
+				Spec:       apiextensionsv1.CustomResourceDefinitionSpec{Group: "example.io", Names: widgetNames},
+				Status:     apiextensionsv1.CustomResourceDefinitionStatus{AcceptedNames: widgetNames},
+			}
+			boundResource := apisv1alpha2.BoundAPIResource{Group: "example.io", Resource: "widgets"}
+			if rename := apiBinding.Spec.FindResourceRename("example.io", "widgets"); rename != nil {
+				boundResource.ServedAs = &apisv1alpha2.GroupResource{Group: rename.As.Group, Resource: rename.As.Resource}
+			}
+			servedCRD := ServedCRD(crd, boundResource)
+			require.Equal(t, tc.wantGroupResource.Group, servedCRD.Spec.Group)
+			require.Equal(t, tc.wantNames, servedCRD.Spec.Names)
+			require.Equal(t, tc.wantNames, servedCRD.Status.AcceptedNames)
+			require.Equal(t, "example.io", crd.Spec.Group, "CRD must not be mutated")
+		})
+	}
+}
+
+func TestResourceRenameResolvesNamingConflict(t *testing.T) {
+	names := apiextensionsv1.CustomResourceDefinitionNames{Plural: "widgets", Singular: "widget", Kind: "Widget", ListKind: "WidgetList"}
+
+	existing := newBindingBuilder().
+		WithClusterName("org-ws").
+		WithName("existing").
+		WithExportReference(logicalcluster.NewPath("org:provider-a"), "widgets").
+		WithBoundResources(apisv1alpha2.BoundAPIResource{
+			Group:    "example.io",
+			Resource: "widgets",
+			Schema:   apisv1alpha2.BoundAPIResourceSchema{UID: "a"},
+		}).
+		Build()
+	incoming := newBindingBuilder().
+		WithClusterName("org-ws").
+		WithName("incoming").
+		WithExportReference(logicalcluster.NewPath("org:provider-b"), "widgets").
+		Build()
+
+	checker, err := newConflictChecker("org-ws",
+		func(clusterName logicalcluster.Name) ([]*apisv1alpha2.APIBinding, error) {
+			return []*apisv1alpha2.APIBinding{existing, incoming}, nil
+		},
+		nil,
+		func(clusterName logicalcluster.Name, name string) (*apiextensionsv1.CustomResourceDefinition, error) {
+			return &apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec:       apiextensionsv1.CustomResourceDefinitionSpec{Group: "example.io", Names: names},
+				Status:     apiextensionsv1.CustomResourceDefinitionStatus{AcceptedNames: names},
+			}, nil
+		},
+		func(clusterName logicalcluster.Name) ([]*apiextensionsv1.CustomResourceDefinition, error) {
+			return nil, nil
+		},
+	)
+	require.NoError(t, err)
+
+	sch := &apisv1alpha1.APIResourceSchema{
+		Spec: apisv1alpha1.APIResourceSchemaSpec{Group: "example.io", Names: names},
+	}
+	require.Error(t, checker.Check(incoming, servedSchema(incoming, sch)), "expected a naming conflict without rename")
+
+	incoming.Spec.ResourceRenames = []apisv1alpha2.ResourceRename{{
+		GroupResource: apisv1alpha2.GroupResource{Group: "example.io", Resource: "widgets"},
+		As:            apisv1alpha2.GroupResource{Group: "b.example.com", Resource: "widgets"},
+	}}
+	require.NoError(t, checker.Check(incoming, servedSchema(incoming, sch)))
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apibinding
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+)
+
+// selectedResources returns the resources selected by spec.resources of the APIBinding. All resources are
+// returned if the APIBinding does not select any. If a selected resource is not exported, the APIExportValid
+// condition is set and ok is false.
+func selectedResources(apiBinding *apisv1alpha2.APIBinding, apiExport *apisv1alpha2.APIExport, resources []apisv1alpha2.ResourceSchema) (selected []apisv1alpha2.ResourceSchema, ok bool) {
+	if len(apiBinding.Spec.Resources) == 0 {
+		return resources, true
+	}
+
+	exported := map[apisv1alpha2.GroupResource]bool{}
+	for _, resource := range resources {
+		exported[apisv1alpha2.GroupResource{Group: resource.Group, Resource: resource.Name}] = true
+		if apiBinding.Spec.SelectsResource(resource.Group, resource.Name) {
+			selected = append(selected, resource)
+		}
+	}
+
+	var missing []string
+	for _, gr := range apiBinding.Spec.Resources {
+		if !exported[gr] {
+			missing = append(missing, schema.GroupResource{Group: gr.Group, Resource: gr.Resource}.String())
+		}
+	}
+	if len(missing) > 0 {
+		conditions.MarkFalse(
+			apiBinding,
+			apisv1alpha2.APIExportValid,
+			apisv1alpha2.ResourceNotFoundReason,
+			conditionsv1alpha1.ConditionSeverityError,
+			"Resources %s not found in APIExport %s|%s",
+			strings.Join(missing, ", "),
+			logicalcluster.From(apiExport),
+			apiExport.Name,
+		)
+		return nil, false
+	}
+
+	return selected, true
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apibinding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+)
+
+func TestSelectedResources(t *testing.T) {
+	widgets := apisv1alpha2.ResourceSchema{Group: "example.io", Name: "widgets", Schema: "today.widgets.example.io"}
+	gadgets := apisv1alpha2.ResourceSchema{Group: "example.io", Name: "gadgets", Schema: "today.gadgets.example.io"}
+	resources := []apisv1alpha2.ResourceSchema{widgets, gadgets}
+
+	apiExport := &apisv1alpha2.APIExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "widgets",
+			Annotations: map[string]string{logicalcluster.AnnotationKey: "org-providers"},
+		},
+	}
+
+	tests := map[string]struct {
+		selected []apisv1alpha2.GroupResource
+
+		wantResources      []apisv1alpha2.ResourceSchema
+		wantNotOK          bool
+		wantAPIExportValid string
+	}{
+		"no selection": {
+			wantResources: resources,
+		},
+		"selected resource": {
+			selected:      []apisv1alpha2.GroupResource{{Group: "example.io", Resource: "gadgets"}},
+			wantResources: []apisv1alpha2.ResourceSchema{gadgets},
+		},
+		"all resources selected": {
+			selected:      []apisv1alpha2.GroupResource{{Group: "example.io", Resource: "gadgets"}, {Group: "example.io", Resource: "widgets"}},
+			wantResources: resources,
+		},
+		"selected resource not exported": {
+			selected:           []apisv1alpha2.GroupResource{{Group: "example.io", Resource: "widgets"}, {Group: "other.io", Resource: "widgets"}},
+			wantNotOK:          true,
+			wantAPIExportValid: apisv1alpha2.ResourceNotFoundReason,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			apiBinding := newBindingBuilder().
+				WithClusterName("org-ws").
+				WithName("widgets").
+				WithExportReference(logicalcluster.NewPath("org:providers"), "widgets").
+				Build()
+			apiBinding.Spec.Resources = tc.selected
+
+			got, ok := selectedResources(apiBinding, apiExport, resources)
+			require.Equal(t, !tc.wantNotOK, ok)
+			require.Equal(t, tc.wantResources, got)
+			require.Equal(t, tc.wantAPIExportValid, conditions.GetReason(apiBinding, apisv1alpha2.APIExportValid))
+		})
+	}
+}
@@ -74,7 +74,8 @@ func newConflictChecker(clusterName logicalcluster.Name,
 				return nil, err
 			}
 
-			ncc.crds = append(ncc.crds, crd)
+			// Check against the names the resource is served as in the workspace.
+			ncc.crds = append(ncc.crds, ServedCRD(crd, br))
 			ncc.crdToBinding[crd.Name] = b
 		}
 	}
@@ -20,12 +20,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -66,6 +68,10 @@ const (
 	// ResourceFinalizersRemainReason is the reason for condition BindingResourceDeleteSuccess that finalizers on some
 	// CRs still exist.
 	ResourceFinalizersRemainReason = "SomeFinalizersRemain"
+
+	// DependentAPIBindingsRemainReason is the reason for condition BindingResourceDeleteSuccess that other APIBindings
+	// still depend on the APIBinding.
+	DependentAPIBindingsRemainReason = "DependentAPIBindingsRemain"
 )
 
 func NewController(
@@ -91,6 +97,9 @@ func NewController(
 		getAPIBinding: func(cluster logicalcluster.Name, name string) (*apisv1alpha2.APIBinding, error) {
 			return apiBindingInformer.Lister().Cluster(cluster).Get(name)
 		},
+		listAPIBindings: func(cluster logicalcluster.Name) ([]*apisv1alpha2.APIBinding, error) {
+			return apiBindingInformer.Lister().Cluster(cluster).List(labels.Everything())
+		},
 		commit: committer.NewCommitter[*APIBinding, Patcher, *APIBindingSpec, *APIBindingStatus](kcpClusterClient.ApisV1alpha2().APIBindings()),
 	}
 
@@ -125,8 +134,9 @@ type Controller struct {
 	listResources   func(ctx context.Context, cluster logicalcluster.Path, gvr schema.GroupVersionResource) (*metav1.PartialObjectMetadataList, error)
 	deleteResources func(ctx context.Context, cluster logicalcluster.Path, gvr schema.GroupVersionResource, namespace string) error
 
-	getAPIBinding func(cluster logicalcluster.Name, name string) (*apisv1alpha2.APIBinding, error)
-	commit        CommitFunc
+	getAPIBinding   func(cluster logicalcluster.Name, name string) (*apisv1alpha2.APIBinding, error)
+	listAPIBindings func(cluster logicalcluster.Name) ([]*apisv1alpha2.APIBinding, error)
+	commit          CommitFunc
 }
 
 func (c *Controller) enqueue(obj interface{}) {
@@ -232,6 +242,33 @@ func (c *Controller) process(ctx context.Context, key string) error {
 
 	oldResource := &Resource{ObjectMeta: apibinding.ObjectMeta, Spec: &apibinding.Spec, Status: &apibinding.Status}
 	apibindingCopy := apibinding.DeepCopy()
+
+	// Keep the resources while other APIBindings depend on this one.
+	dependents, err := c.dependentAPIBindings(apibinding)
+	if err != nil {
+		return err
+	}
+	if len(dependents) > 0 {
+		conditions.MarkFalse(
+			apibindingCopy,
+			apisv1alpha2.BindingResourceDeleteSuccess,
+			DependentAPIBindingsRemainReason,
+			conditionsv1alpha1.ConditionSeverityError,
+			"APIBinding is a dependency of APIBindings: %s",
+			strings.Join(dependents, ", "),
+		)
+
+		newResource := &Resource{ObjectMeta: apibindingCopy.ObjectMeta, Spec: &apibindingCopy.Spec, Status: &apibindingCopy.Status}
+		if err := c.commit(ctx, oldResource, newResource); err != nil {
+			return err
+		}
+
+		return &deletion.ResourcesRemainingError{
+			Estimate: DeletionRecheckEstimateSeconds,
+			Message:  fmt.Sprintf("dependent APIBindings %s remaining", strings.Join(dependents, ", ")),
+		}
+	}
+
 	resourceRemaining, deleteErr := c.deleteAllCRs(ctx, apibindingCopy)
 	if deleteErr != nil {
 		conditions.MarkFalse(
@@ -278,6 +315,28 @@ func (c *Controller) process(ctx context.Context, key string) error {
 	return c.commit(ctx, oldResource, newResource)
 }
 
+// dependentAPIBindings returns the sorted names of the APIBindings in the same logical cluster that are not
+// being deleted and depend on the given APIBinding.
+func (c *Controller) dependentAPIBindings(apibinding *apisv1alpha2.APIBinding) ([]string, error) {
+	bindings, err := c.listAPIBindings(logicalcluster.From(apibinding))
+	if err != nil {
+		return nil, err
+	}
+
+	var dependents []string
+	for _, binding := range bindings {
+		if binding.Name == apibinding.Name || !binding.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if slices.Contains(binding.Status.Dependencies, apibinding.Name) {
+			dependents = append(dependents, binding.Name)
+		}
+	}
+	sort.Strings(dependents)
+
+	return dependents, nil
+}
+
 func (c *Controller) mutateResourceRemainingStatus(resourceRemaining gvrDeletionMetadataTotal, apibinding *apisv1alpha2.APIBinding) (*apisv1alpha2.APIBinding, error) {
 	if len(resourceRemaining.finalizersToNumRemaining) != 0 {
 		// requeue if there are still remaining finalizers
@@ -225,6 +225,72 @@ func TestAPIBindingTerminating(t *testing.T) {
 	}
 }
 
+func TestDependentAPIBindings(t *testing.T) {
+	now := metav1.Now()
+	newBinding := func(name string, deleting bool, dependencies ...string) *apisv1alpha2.APIBinding {
+		binding := &apisv1alpha2.APIBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     apisv1alpha2.APIBindingStatus{Dependencies: dependencies},
+		}
+		if deleting {
+			binding.DeletionTimestamp = &now
+		}
+		return binding
+	}
+
+	tests := []struct {
+		name     string
+		bindings []*apisv1alpha2.APIBinding
+		expected []string
+	}{
+		{
+			name:     "no other bindings",
+			bindings: []*apisv1alpha2.APIBinding{newBinding("networking", true)},
+		},
+		{
+			name: "unrelated bindings",
+			bindings: []*apisv1alpha2.APIBinding{
+				newBinding("networking", true),
+				newBinding("workloads", false, "storage"),
+			},
+		},
+		{
+			name: "dependents",
+			bindings: []*apisv1alpha2.APIBinding{
+				newBinding("networking", true),
+				newBinding("workloads", false, "storage", "networking"),
+				newBinding("gateways", false, "networking"),
+			},
+			expected: []string{"gateways", "workloads"},
+		},
+		{
+			name: "dependents being deleted",
+			bindings: []*apisv1alpha2.APIBinding{
+				newBinding("networking", true, "workloads"),
+				newBinding("workloads", true, "networking"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := &Controller{
+				listAPIBindings: func(cluster logicalcluster.Name) ([]*apisv1alpha2.APIBinding, error) {
+					return tt.bindings, nil
+				},
+			}
+
+			dependents, err := controller.dependentAPIBindings(tt.bindings[0])
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(dependents, tt.expected) {
+				t.Errorf("expected dependents %v, got %v", tt.expected, dependents)
+			}
+		})
+	}
+}
+
 func newPartialObject(apiversion, kind, name, namespace string, finlizers []string) *metav1.PartialObjectMetadata {
 	return &metav1.PartialObjectMetadata{
 		TypeMeta: metav1.TypeMeta{
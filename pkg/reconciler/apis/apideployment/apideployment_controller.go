@@ -0,0 +1,359 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apideployment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	kcpcache "github.com/kcp-dev/apimachinery/v2/pkg/cache"
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	topologyv1alpha1 "github.com/kcp-dev/sdk/apis/topology/v1alpha1"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+	apisv1alpha2client "github.com/kcp-dev/sdk/client/clientset/versioned/typed/apis/v1alpha2"
+	apisv1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/apis/v1alpha1"
+	apisv1alpha2informers "github.com/kcp-dev/sdk/client/informers/externalversions/apis/v1alpha2"
+	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
+	topologyinformers "github.com/kcp-dev/sdk/client/informers/externalversions/topology/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/indexers"
+	"github.com/kcp-dev/kcp/pkg/logging"
+	"github.com/kcp-dev/kcp/pkg/reconciler/committer"
+	"github.com/kcp-dev/kcp/pkg/reconciler/events"
+	"github.com/kcp-dev/kcp/pkg/tombstone"
+)
+
+const (
+	ControllerName = "kcp-apideployment"
+)
+
+// NewController returns a new controller for APIDeployments. APIExports, APIResourceSchemas and
+// Partitions are read from the workspace of the APIDeployment. APIBindings and Shards are read
+// from the cache server.
+func NewController(
+	kcpClusterClient kcpclientset.ClusterInterface,
+	apiDeploymentInformer apisv1alpha2informers.APIDeploymentClusterInformer,
+	apiExportInformer apisv1alpha2informers.APIExportClusterInformer,
+	apiResourceSchemaInformer apisv1alpha1informers.APIResourceSchemaClusterInformer,
+	partitionInformer topologyinformers.PartitionClusterInformer,
+	globalAPIBindingInformer apisv1alpha2informers.APIBindingClusterInformer,
+	globalShardInformer corev1alpha1informers.ShardClusterInformer,
+) (*controller, error) {
+	c := &controller{
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: ControllerName,
+			},
+		),
+		getAPIDeployment: func(clusterName logicalcluster.Name, name string) (*apisv1alpha2.APIDeployment, error) {
+			return apiDeploymentInformer.Lister().Cluster(clusterName).Get(name)
+		},
+		listAPIDeployments: func(clusterName logicalcluster.Name) ([]*apisv1alpha2.APIDeployment, error) {
+			return apiDeploymentInformer.Lister().Cluster(clusterName).List(labels.Everything())
+		},
+		listAPIDeploymentsByAPIExport: func(clusterName logicalcluster.Name, exportName string) ([]*apisv1alpha2.APIDeployment, error) {
+			return indexers.ByIndex[*apisv1alpha2.APIDeployment](apiDeploymentInformer.Informer().GetIndexer(), indexers.APIDeploymentByAPIExport, clusterName.Path().Join(exportName).String())
+		},
+		getAPIExport: func(clusterName logicalcluster.Name, name string) (*apisv1alpha2.APIExport, error) {
+			return apiExportInformer.Lister().Cluster(clusterName).Get(name)
+		},
+		updateAPIExport: func(ctx context.Context, apiExport *apisv1alpha2.APIExport) error {
+			_, err := kcpClusterClient.Cluster(logicalcluster.From(apiExport).Path()).ApisV1alpha2().APIExports().Update(ctx, apiExport, metav1.UpdateOptions{})
+			return err
+		},
+		getAPIResourceSchema: func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIResourceSchema, error) {
+			return apiResourceSchemaInformer.Lister().Cluster(clusterName).Get(name)
+		},
+		getPartition: func(clusterName logicalcluster.Name, name string) (*topologyv1alpha1.Partition, error) {
+			return partitionInformer.Lister().Cluster(clusterName).Get(name)
+		},
+		listShards: func() ([]*corev1alpha1.Shard, error) {
+			return globalShardInformer.Lister().List(labels.Everything())
+		},
+		listAPIBindings: func(apiExport *apisv1alpha2.APIExport) ([]*apisv1alpha2.APIBinding, error) {
+			// binding keys by full path
+			keys := sets.New[string]()
+			if path := logicalcluster.NewPath(apiExport.Annotations[core.LogicalClusterPathAnnotationKey]); !path.Empty() {
+				pathKeys, err := globalAPIBindingInformer.Informer().GetIndexer().IndexKeys(indexers.APIBindingsByAPIExport, path.Join(apiExport.Name).String())
+				if err != nil {
+					return nil, err
+				}
+				keys.Insert(pathKeys...)
+			}
+
+			clusterKeys, err := globalAPIBindingInformer.Informer().GetIndexer().IndexKeys(indexers.APIBindingsByAPIExport, logicalcluster.From(apiExport).Path().Join(apiExport.Name).String())
+			if err != nil {
+				return nil, err
+			}
+			keys.Insert(clusterKeys...)
+
+			bindings := make([]*apisv1alpha2.APIBinding, 0, keys.Len())
+			for _, key := range sets.List[string](keys) {
+				binding, exists, err := globalAPIBindingInformer.Informer().GetIndexer().GetByKey(key)
+				if err != nil {
+					utilruntime.HandleError(err)
+					continue
+				} else if !exists {
+					continue
+				}
+				bindings = append(bindings, binding.(*apisv1alpha2.APIBinding))
+			}
+			return bindings, nil
+		},
+		commit: committer.NewCommitter[*APIDeployment, Patcher, *APIDeploymentSpec, *APIDeploymentStatus](kcpClusterClient.ApisV1alpha2().APIDeployments()),
+	}
+
+	logger := logging.WithReconciler(klog.Background(), ControllerName)
+
+	_, _ = apiDeploymentInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.enqueueAPIDeployment(tombstone.Obj[*apisv1alpha2.APIDeployment](obj), logger, "")
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			c.enqueueAPIDeployment(tombstone.Obj[*apisv1alpha2.APIDeployment](newObj), logger, "")
+		},
+		DeleteFunc: func(obj interface{}) {
+			// an older APIDeployment of the same APIExport might be waiting for this one.
+			deployment := tombstone.Obj[*apisv1alpha2.APIDeployment](obj)
+			c.enqueueAPIDeploymentsForAPIExport(logicalcluster.From(deployment), deployment.Spec.ExportName, logger, " because of deleted APIDeployment")
+		},
+	})
+
+	_, _ = apiExportInformer.Informer().AddEventHandler(events.WithoutSyncs(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			export := tombstone.Obj[*apisv1alpha2.APIExport](obj)
+			c.enqueueAPIDeploymentsForAPIExport(logicalcluster.From(export), export.Name, logger, " because of APIExport")
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			export := tombstone.Obj[*apisv1alpha2.APIExport](newObj)
+			c.enqueueAPIDeploymentsForAPIExport(logicalcluster.From(export), export.Name, logger, " because of APIExport")
+		},
+	}))
+
+	_, _ = partitionInformer.Informer().AddEventHandler(events.WithoutSyncs(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.enqueuePartition(tombstone.Obj[*topologyv1alpha1.Partition](obj), logger)
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			c.enqueuePartition(tombstone.Obj[*topologyv1alpha1.Partition](newObj), logger)
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.enqueuePartition(tombstone.Obj[*topologyv1alpha1.Partition](obj), logger)
+		},
+	}))
+
+	_, _ = globalAPIBindingInformer.Informer().AddEventHandler(events.WithoutSyncs(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.enqueueAPIBinding(tombstone.Obj[*apisv1alpha2.APIBinding](obj), logger)
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			c.enqueueAPIBinding(tombstone.Obj[*apisv1alpha2.APIBinding](newObj), logger)
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.enqueueAPIBinding(tombstone.Obj[*apisv1alpha2.APIBinding](obj), logger)
+		},
+	}))
+
+	return c, nil
+}
+
+type APIDeployment = apisv1alpha2.APIDeployment
+type APIDeploymentSpec = apisv1alpha2.APIDeploymentSpec
+type APIDeploymentStatus = apisv1alpha2.APIDeploymentStatus
+type Patcher = apisv1alpha2client.APIDeploymentInterface
+type Resource = committer.Resource[*APIDeploymentSpec, *APIDeploymentStatus]
+type CommitFunc = func(context.Context, *Resource, *Resource) error
+
+// controller reconciles APIDeployments. It moves the bindings of the APIExport wave by wave to
+// the new resources, and updates the APIExport when all bindings are upgraded.
+type controller struct {
+	queue workqueue.TypedRateLimitingInterface[string]
+
+	getAPIDeployment              func(clusterName logicalcluster.Name, name string) (*apisv1alpha2.APIDeployment, error)
+	listAPIDeployments            func(clusterName logicalcluster.Name) ([]*apisv1alpha2.APIDeployment, error)
+	listAPIDeploymentsByAPIExport func(clusterName logicalcluster.Name, exportName string) ([]*apisv1alpha2.APIDeployment, error)
+	getAPIExport                  func(clusterName logicalcluster.Name, name string) (*apisv1alpha2.APIExport, error)
+	updateAPIExport               func(ctx context.Context, apiExport *apisv1alpha2.APIExport) error
+	getAPIResourceSchema          func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIResourceSchema, error)
+	getPartition                  func(clusterName logicalcluster.Name, name string) (*topologyv1alpha1.Partition, error)
+	listShards                    func() ([]*corev1alpha1.Shard, error)
+	listAPIBindings               func(apiExport *apisv1alpha2.APIExport) ([]*apisv1alpha2.APIBinding, error)
+
+	commit CommitFunc
+}
+
+// enqueueAPIDeployment enqueues an APIDeployment.
+func (c *controller) enqueueAPIDeployment(deployment *apisv1alpha2.APIDeployment, logger logr.Logger, logSuffix string) {
+	key, err := kcpcache.DeletionHandlingMetaClusterNamespaceKeyFunc(deployment)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+
+	logging.WithQueueKey(logger, key).V(4).Info(fmt.Sprintf("queueing APIDeployment%s", logSuffix))
+	c.queue.Add(key)
+}
+
+// enqueueAPIDeploymentsForAPIExport enqueues the APIDeployments of an APIExport.
+func (c *controller) enqueueAPIDeploymentsForAPIExport(clusterName logicalcluster.Name, exportName string, logger logr.Logger, logSuffix string) {
+	deployments, err := c.listAPIDeploymentsByAPIExport(clusterName, exportName)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	for _, deployment := range deployments {
+		c.enqueueAPIDeployment(deployment, logger, logSuffix)
+	}
+}
+
+// enqueuePartition enqueues the APIDeployments in the workspace of a Partition.
+func (c *controller) enqueuePartition(partition *topologyv1alpha1.Partition, logger logr.Logger) {
+	deployments, err := c.listAPIDeployments(logicalcluster.From(partition))
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	for _, deployment := range deployments {
+		c.enqueueAPIDeployment(deployment, logger, " because of Partition")
+	}
+}
+
+// enqueueAPIBinding enqueues the APIDeployments of the APIExport an APIBinding is bound to.
+func (c *controller) enqueueAPIBinding(binding *apisv1alpha2.APIBinding, logger logr.Logger) {
+	if binding.Status.APIExportClusterName == "" || binding.Spec.Reference.Export == nil {
+		return
+	}
+	c.enqueueAPIDeploymentsForAPIExport(logicalcluster.Name(binding.Status.APIExportClusterName), binding.Spec.Reference.Export.Name, logger, " because of APIBinding")
+}
+
+// Start starts the controller, which stops when ctx.Done() is closed.
+func (c *controller) Start(ctx context.Context, numThreads int) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	logger := logging.WithReconciler(klog.FromContext(ctx), ControllerName)
+	ctx = klog.NewContext(ctx, logger)
+	logger.Info("Starting controller")
+	defer logger.Info("Shutting down controller")
+
+	for range numThreads {
+		go wait.UntilWithContext(ctx, c.startWorker, time.Second)
+	}
+
+	<-ctx.Done()
+}
+
+func (c *controller) startWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+func (c *controller) processNextWorkItem(ctx context.Context) bool {
+	// Wait until there is a new item in the working queue
+	k, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	key := k
+
+	logger := logging.WithQueueKey(klog.FromContext(ctx), key)
+	ctx = klog.NewContext(ctx, logger)
+	logger.V(4).Info("processing key")
+
+	// No matter what, tell the queue we're done with this key, to unblock
+	// other workers.
+	defer c.queue.Done(key)
+
+	if err := c.process(ctx, key); err != nil {
+		utilruntime.HandleError(fmt.Errorf("%q controller failed to sync %q, err: %w", ControllerName, key, err))
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	c.queue.Forget(key)
+	return true
+}
+
+func (c *controller) process(ctx context.Context, key string) error {
+	clusterName, _, name, err := kcpcache.SplitMetaClusterNamespaceKey(key)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return nil
+	}
+	obj, err := c.getAPIDeployment(clusterName, name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil // object deleted before we handled it
+		}
+		return err
+	}
+
+	old := obj
+	obj = obj.DeepCopy()
+
+	logger := logging.WithObject(klog.FromContext(ctx), obj)
+	ctx = klog.NewContext(ctx, logger)
+
+	var errs []error
+	if err := c.reconcile(ctx, obj); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Regardless of whether reconcile returned an error or not, always try to patch status if needed. Return the
+	// reconciliation error at the end.
+
+	// If the object being reconciled changed as a result, update it.
+	oldResource := &Resource{ObjectMeta: old.ObjectMeta, Spec: &old.Spec, Status: &old.Status}
+	newResource := &Resource{ObjectMeta: obj.ObjectMeta, Spec: &obj.Spec, Status: &obj.Status}
+
+	if err := c.commit(ctx, oldResource, newResource); err != nil {
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// InstallIndexers adds the additional indexers that this controller requires to the informers.
+func InstallIndexers(
+	apiDeploymentInformer apisv1alpha2informers.APIDeploymentClusterInformer,
+	globalAPIBindingInformer apisv1alpha2informers.APIBindingClusterInformer,
+) {
+	indexers.AddIfNotPresentOrDie(apiDeploymentInformer.Informer().GetIndexer(), cache.Indexers{
+		indexers.APIDeploymentByAPIExport: indexers.IndexAPIDeploymentByAPIExport,
+	})
+	indexers.AddIfNotPresentOrDie(globalAPIBindingInformer.Informer().GetIndexer(), cache.Indexers{
+		indexers.APIBindingsByAPIExport: indexers.IndexAPIBindingByAPIExport,
+	})
+}
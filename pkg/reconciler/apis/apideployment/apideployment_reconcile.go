@@ -0,0 +1,393 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apideployment
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+	"github.com/kcp-dev/sdk/schemacompat"
+
+	"github.com/kcp-dev/kcp/pkg/cache/client/shard"
+)
+
+// wave selects the bindings of one step of the rollout. Nil selectors match everything.
+type wave struct {
+	name          string
+	shardSelector labels.Selector
+	selector      labels.Selector
+}
+
+func (c *controller) reconcile(ctx context.Context, deployment *apisv1alpha2.APIDeployment) error {
+	logger := klog.FromContext(ctx)
+
+	if deployment.Status.Phase == apisv1alpha2.APIDeploymentPhaseCompleted {
+		return nil
+	}
+	clusterName := logicalcluster.From(deployment)
+
+	apiExport, err := c.getAPIExport(clusterName, deployment.Spec.ExportName)
+	if apierrors.IsNotFound(err) {
+		conditions.MarkFalse(
+			deployment,
+			apisv1alpha2.APIDeploymentReady,
+			apisv1alpha2.APIDeploymentExportNotFoundReason,
+			conditionsv1alpha1.ConditionSeverityError,
+			"APIExport %s not found",
+			deployment.Spec.ExportName,
+		)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// Only one APIDeployment of an APIExport rolls out at a time, the oldest first.
+	deployments, err := c.listAPIDeploymentsByAPIExport(clusterName, deployment.Spec.ExportName)
+	if err != nil {
+		return err
+	}
+	for _, other := range deployments {
+		if other.Name != deployment.Name && other.Status.Phase != apisv1alpha2.APIDeploymentPhaseCompleted && olderThan(other, deployment) {
+			conditions.MarkFalse(
+				deployment,
+				apisv1alpha2.APIDeploymentReady,
+				apisv1alpha2.APIDeploymentWaitingReason,
+				conditionsv1alpha1.ConditionSeverityInfo,
+				"Waiting for APIDeployment %s to complete",
+				other.Name,
+			)
+			return nil
+		}
+	}
+
+	// Check the schemas before every step, they might have changed since the last one.
+	schemas, ok, err := c.checkSchemas(deployment, apiExport)
+	if err != nil || !ok {
+		return err
+	}
+
+	waves, ok, err := c.resolveWaves(deployment)
+	if err != nil || !ok {
+		return err
+	}
+	conditions.MarkTrue(deployment, apisv1alpha2.APIDeploymentReady)
+
+	shards, err := c.listShards()
+	if err != nil {
+		return err
+	}
+	shardLabels := make(map[string]labels.Set, len(shards))
+	for _, s := range shards {
+		shardLabels[s.Name] = s.Labels
+	}
+
+	bindings, err := c.listAPIBindings(apiExport)
+	if err != nil {
+		return err
+	}
+	sort.Slice(bindings, func(i, j int) bool {
+		if ci, cj := logicalcluster.From(bindings[i]), logicalcluster.From(bindings[j]); ci != cj {
+			return ci < cj
+		}
+		return bindings[i].Name < bindings[j].Name
+	})
+	bindingWaves := make([]int, len(bindings))
+	for i, binding := range bindings {
+		bindingWaves[i] = waveOf(binding, waves, shardLabels)
+	}
+
+	// Advance to the next wave as long as all bindings of the current one are upgraded.
+	waveUpgraded := func(w int) bool {
+		for i, binding := range bindings {
+			if bindingWaves[i] == w && !isUpgraded(binding, schemas) {
+				return false
+			}
+		}
+		return true
+	}
+	for !deployment.Spec.Paused && int(deployment.Status.CurrentWave) <= len(waves) && waveUpgraded(int(deployment.Status.CurrentWave)) {
+		deployment.Status.CurrentWave++
+		logger.V(2).Info("advancing to next wave", "wave", deployment.Status.CurrentWave)
+	}
+
+	deployment.Status.Bindings = make([]apisv1alpha2.APIDeploymentBindingStatus, 0, len(bindings))
+	for i, binding := range bindings {
+		status := apisv1alpha2.APIDeploymentBindingStatus{
+			Cluster: logicalcluster.From(binding).String(),
+			Name:    binding.Name,
+			State:   apisv1alpha2.APIDeploymentBindingPending,
+		}
+		if bindingWaves[i] < len(waves) {
+			status.Wave = waves[bindingWaves[i]].name
+		}
+		switch {
+		case bindingWaves[i] > int(deployment.Status.CurrentWave):
+		case isUpgraded(binding, schemas):
+			status.State = apisv1alpha2.APIDeploymentBindingUpgraded
+		case conditions.IsFalse(binding, apisv1alpha2.BindingUpToDate) && isSeverity(binding, apisv1alpha2.BindingUpToDate, conditionsv1alpha1.ConditionSeverityError):
+			status.State = apisv1alpha2.APIDeploymentBindingFailed
+			status.Message = conditions.GetMessage(binding, apisv1alpha2.BindingUpToDate)
+		default:
+			status.State = apisv1alpha2.APIDeploymentBindingUpgrading
+			status.Message = conditions.GetMessage(binding, apisv1alpha2.BindingUpToDate)
+		}
+		deployment.Status.Bindings = append(deployment.Status.Bindings, status)
+	}
+
+	if int(deployment.Status.CurrentWave) <= len(waves) {
+		deployment.Status.Phase = apisv1alpha2.APIDeploymentPhaseProgressing
+		return nil
+	}
+
+	// All waves are done, make the new resources the resources of the APIExport.
+	resources := MergeResources(apiExport.Spec.Resources, deployment.Spec.Resources)
+	if !equality.Semantic.DeepEqual(resources, apiExport.Spec.Resources) {
+		apiExport = apiExport.DeepCopy()
+		apiExport.Spec.Resources = resources
+		logger.V(2).Info("updating APIExport resources")
+		if err := c.updateAPIExport(ctx, apiExport); err != nil {
+			return err
+		}
+	}
+	deployment.Status.Phase = apisv1alpha2.APIDeploymentPhaseCompleted
+
+	return nil
+}
+
+// checkSchemas returns the APIResourceSchemas of the APIDeployment, in the order of its resources. It
+// returns false if they are not found or not compatible with the schemas of the APIExport.
+func (c *controller) checkSchemas(deployment *apisv1alpha2.APIDeployment, apiExport *apisv1alpha2.APIExport) ([]*apisv1alpha1.APIResourceSchema, bool, error) {
+	clusterName := logicalcluster.From(deployment)
+	existing := make(map[string]string, len(apiExport.Spec.Resources))
+	for _, r := range apiExport.Spec.Resources {
+		existing[r.Name+"."+r.Group] = r.Schema
+	}
+
+	schemas := make([]*apisv1alpha1.APIResourceSchema, 0, len(deployment.Spec.Resources))
+	var incompatible []string
+	for _, r := range deployment.Spec.Resources {
+		names := []string{r.Schema}
+		if existingName, found := existing[r.Name+"."+r.Group]; found && existingName != r.Schema {
+			names = append(names, existingName)
+		}
+		var found []*apisv1alpha1.APIResourceSchema
+		for _, name := range names {
+			sch, err := c.getAPIResourceSchema(clusterName, name)
+			if apierrors.IsNotFound(err) {
+				conditions.MarkFalse(
+					deployment,
+					apisv1alpha2.APIDeploymentSchemasCompatible,
+					apisv1alpha2.APIDeploymentSchemaNotFoundReason,
+					conditionsv1alpha1.ConditionSeverityError,
+					"APIResourceSchema %s not found",
+					name,
+				)
+				return nil, false, nil
+			} else if err != nil {
+				return nil, false, err
+			}
+			found = append(found, sch)
+		}
+		schemas = append(schemas, found[0])
+
+		if len(found) > 1 {
+			if err := schemacompat.EnsureAPIResourceSchemaCompatibility(found[1], found[0]); err != nil {
+				incompatible = append(incompatible, found[0].Name+": "+err.Error())
+			}
+		}
+	}
+	if len(incompatible) > 0 {
+		conditions.MarkFalse(
+			deployment,
+			apisv1alpha2.APIDeploymentSchemasCompatible,
+			apisv1alpha2.APIDeploymentIncompatibleSchemasReason,
+			conditionsv1alpha1.ConditionSeverityError,
+			"%s",
+			strings.Join(incompatible, "; "),
+		)
+		return nil, false, nil
+	}
+	conditions.MarkTrue(deployment, apisv1alpha2.APIDeploymentSchemasCompatible)
+
+	return schemas, true, nil
+}
+
+// resolveWaves turns the waves of the APIDeployment into selectors. It returns false if a Partition
+// is not found or a selector is invalid.
+func (c *controller) resolveWaves(deployment *apisv1alpha2.APIDeployment) ([]wave, bool, error) {
+	waves := make([]wave, 0, len(deployment.Spec.Waves))
+	for _, w := range deployment.Spec.Waves {
+		resolved := wave{name: w.Name}
+		if w.Partition != "" {
+			partition, err := c.getPartition(logicalcluster.From(deployment), w.Partition)
+			if apierrors.IsNotFound(err) {
+				conditions.MarkFalse(
+					deployment,
+					apisv1alpha2.APIDeploymentReady,
+					apisv1alpha2.APIDeploymentPartitionNotFoundReason,
+					conditionsv1alpha1.ConditionSeverityError,
+					"Partition %s of wave %s not found",
+					w.Partition, w.Name,
+				)
+				return nil, false, nil
+			} else if err != nil {
+				return nil, false, err
+			}
+			if partition.Spec.Selector != nil {
+				if resolved.shardSelector, err = metav1.LabelSelectorAsSelector(partition.Spec.Selector); err != nil {
+					conditions.MarkFalse(
+						deployment,
+						apisv1alpha2.APIDeploymentReady,
+						apisv1alpha2.APIDeploymentInvalidSelectorReason,
+						conditionsv1alpha1.ConditionSeverityError,
+						"Invalid selector of Partition %s: %v",
+						w.Partition, err,
+					)
+					return nil, false, nil
+				}
+			}
+		}
+		if w.Selector != nil {
+			var err error
+			if resolved.selector, err = metav1.LabelSelectorAsSelector(w.Selector); err != nil {
+				conditions.MarkFalse(
+					deployment,
+					apisv1alpha2.APIDeploymentReady,
+					apisv1alpha2.APIDeploymentInvalidSelectorReason,
+					conditionsv1alpha1.ConditionSeverityError,
+					"Invalid selector of wave %s: %v",
+					w.Name, err,
+				)
+				return nil, false, nil
+			}
+		}
+		waves = append(waves, resolved)
+	}
+	return waves, true, nil
+}
+
+// waveOf returns the index of the first wave matching the binding, or len(waves) if none does.
+// The shard of a binding is taken from the annotation the cache server adds.
+func waveOf(binding *apisv1alpha2.APIBinding, waves []wave, shardLabels map[string]labels.Set) int {
+	for i, w := range waves {
+		if w.shardSelector != nil {
+			set, found := shardLabels[binding.Annotations[shard.AnnotationKey]]
+			if !found || !w.shardSelector.Matches(set) {
+				continue
+			}
+		}
+		if w.selector != nil && !w.selector.Matches(labels.Set(binding.Labels)) {
+			continue
+		}
+		return i
+	}
+	return len(waves)
+}
+
+// isUpgraded returns true if the binding is up-to-date and bound to all the schemas.
+func isUpgraded(binding *apisv1alpha2.APIBinding, schemas []*apisv1alpha1.APIResourceSchema) bool {
+	if !conditions.IsTrue(binding, apisv1alpha2.BindingUpToDate) {
+		return false
+	}
+	for _, sch := range schemas {
+		found := false
+		for _, r := range binding.Status.BoundResources {
+			if r.Group == sch.Spec.Group && r.Resource == sch.Spec.Names.Plural {
+				found = r.Schema.Name == sch.Name && r.Schema.UID == string(sch.UID)
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func isSeverity(binding *apisv1alpha2.APIBinding, t conditionsv1alpha1.ConditionType, severity conditionsv1alpha1.ConditionSeverity) bool {
+	s := conditions.GetSeverity(binding, t)
+	return s != nil && *s == severity
+}
+
+func olderThan(a, b *apisv1alpha2.APIDeployment) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Name < b.Name
+}
+
+// MergeResources returns the existing resources with those of the same name and group replaced
+// by the updated ones. Updated resources without an existing counterpart are appended.
+func MergeResources(existing, updated []apisv1alpha2.ResourceSchema) []apisv1alpha2.ResourceSchema {
+	merged := make([]apisv1alpha2.ResourceSchema, 0, len(existing)+len(updated))
+	replaced := make(map[int]bool, len(updated))
+	for _, e := range existing {
+		r := e
+		for i, u := range updated {
+			if u.Name == e.Name && u.Group == e.Group {
+				r = u
+				replaced[i] = true
+				break
+			}
+		}
+		merged = append(merged, r)
+	}
+	for i, u := range updated {
+		if !replaced[i] {
+			merged = append(merged, u)
+		}
+	}
+	return merged
+}
+
+// BindingResources returns the resources the APIBinding is to be bound to: those of the APIExport,
+// updated by the oldest progressing APIDeployment whose rollout has reached the binding.
+func BindingResources(apiExport *apisv1alpha2.APIExport, deployments []*apisv1alpha2.APIDeployment, binding *apisv1alpha2.APIBinding) []apisv1alpha2.ResourceSchema {
+	var admitting *apisv1alpha2.APIDeployment
+	for _, deployment := range deployments {
+		if deployment.Spec.ExportName != apiExport.Name || deployment.Status.Phase != apisv1alpha2.APIDeploymentPhaseProgressing {
+			continue
+		}
+		if admitting != nil && !olderThan(deployment, admitting) {
+			continue
+		}
+		for _, b := range deployment.Status.Bindings {
+			if b.Cluster == logicalcluster.From(binding).String() && b.Name == binding.Name {
+				if b.State != apisv1alpha2.APIDeploymentBindingPending {
+					admitting = deployment
+				}
+				break
+			}
+		}
+	}
+	if admitting == nil {
+		return apiExport.Spec.Resources
+	}
+	return MergeResources(apiExport.Spec.Resources, admitting.Spec.Resources)
+}
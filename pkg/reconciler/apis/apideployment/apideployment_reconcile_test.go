@@ -0,0 +1,373 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apideployment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+	topologyv1alpha1 "github.com/kcp-dev/sdk/apis/topology/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/cache/client/shard"
+)
+
+func newSchema(t *testing.T, name string, properties map[string]string) *apisv1alpha1.APIResourceSchema {
+	t.Helper()
+
+	version := apisv1alpha1.APIResourceVersion{Name: "v1", Served: true, Storage: true}
+	props := &apiextensionsv1.JSONSchemaProps{Type: "object", Properties: map[string]apiextensionsv1.JSONSchemaProps{}}
+	for k, v := range properties {
+		props.Properties[k] = apiextensionsv1.JSONSchemaProps{Type: v}
+	}
+	require.NoError(t, version.SetSchema(props))
+
+	return &apisv1alpha1.APIResourceSchema{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			UID:         types.UID(name + "-uid"),
+			Annotations: map[string]string{logicalcluster.AnnotationKey: "provider"},
+		},
+		Spec: apisv1alpha1.APIResourceSchemaSpec{
+			Group:    "example.io",
+			Names:    apiextensionsv1.CustomResourceDefinitionNames{Plural: "widgets"},
+			Versions: []apisv1alpha1.APIResourceVersion{version},
+		},
+	}
+}
+
+func newBinding(cluster, shardName string, labels map[string]string) *apisv1alpha2.APIBinding {
+	return &apisv1alpha2.APIBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "widgets",
+			Labels: labels,
+			Annotations: map[string]string{
+				logicalcluster.AnnotationKey: cluster,
+				shard.AnnotationKey:          shardName,
+			},
+		},
+		Spec: apisv1alpha2.APIBindingSpec{
+			Reference: apisv1alpha2.BindingReference{Export: &apisv1alpha2.ExportBindingReference{Path: "provider", Name: "widgets"}},
+		},
+		Status: apisv1alpha2.APIBindingStatus{
+			APIExportClusterName: "provider",
+			BoundResources: []apisv1alpha2.BoundAPIResource{{
+				Group:    "example.io",
+				Resource: "widgets",
+				Schema:   apisv1alpha2.BoundAPIResourceSchema{Name: "v1.widgets.example.io", UID: "v1.widgets.example.io-uid"},
+			}},
+			Conditions: conditionsv1alpha1.Conditions{{Type: apisv1alpha2.BindingUpToDate, Status: corev1.ConditionTrue}},
+		},
+	}
+}
+
+func upgrade(binding *apisv1alpha2.APIBinding) {
+	binding.Status.BoundResources[0].Schema = apisv1alpha2.BoundAPIResourceSchema{Name: "v2.widgets.example.io", UID: "v2.widgets.example.io-uid"}
+}
+
+func fail(binding *apisv1alpha2.APIBinding) {
+	conditions.MarkFalse(binding, apisv1alpha2.BindingUpToDate, apisv1alpha2.NamingConflictsReason, conditionsv1alpha1.ConditionSeverityError, "conflict")
+}
+
+func TestReconcile(t *testing.T) {
+	schemas := map[string]*apisv1alpha1.APIResourceSchema{
+		"v1.widgets.example.io":           newSchema(t, "v1.widgets.example.io", map[string]string{"name": "string"}),
+		"v2.widgets.example.io":           newSchema(t, "v2.widgets.example.io", map[string]string{"name": "string", "size": "integer"}),
+		"incompatible.widgets.example.io": newSchema(t, "incompatible.widgets.example.io", map[string]string{"name": "integer"}),
+	}
+	apiExport := &apisv1alpha2.APIExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "widgets",
+			Annotations: map[string]string{logicalcluster.AnnotationKey: "provider"},
+		},
+		Spec: apisv1alpha2.APIExportSpec{
+			Resources: []apisv1alpha2.ResourceSchema{
+				{Name: "widgets", Group: "example.io", Schema: "v1.widgets.example.io"},
+				{Name: "gadgets", Group: "example.io", Schema: "v1.gadgets.example.io"},
+			},
+		},
+	}
+	shards := []*corev1alpha1.Shard{
+		{ObjectMeta: metav1.ObjectMeta{Name: "eu", Labels: map[string]string{"region": "eu"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "us", Labels: map[string]string{"region": "us"}}},
+	}
+	partition := &topologyv1alpha1.Partition{
+		ObjectMeta: metav1.ObjectMeta{Name: "europe"},
+		Spec: topologyv1alpha1.PartitionSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "eu"}},
+		},
+	}
+	newDeployment := func() *apisv1alpha2.APIDeployment {
+		return &apisv1alpha2.APIDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "widgets-v2",
+				CreationTimestamp: metav1.NewTime(time.Unix(100, 0)),
+				Annotations:       map[string]string{logicalcluster.AnnotationKey: "provider"},
+			},
+			Spec: apisv1alpha2.APIDeploymentSpec{
+				ExportName: "widgets",
+				Resources:  []apisv1alpha2.ResourceSchema{{Name: "widgets", Group: "example.io", Schema: "v2.widgets.example.io"}},
+				Waves: []apisv1alpha2.APIDeploymentWave{
+					{Name: "canary", Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}},
+					{Name: "europe", Partition: "europe"},
+				},
+			},
+		}
+	}
+
+	type state struct {
+		wave, state string
+	}
+	tests := map[string]struct {
+		deployment     func() *apisv1alpha2.APIDeployment
+		others         []*apisv1alpha2.APIDeployment
+		mutateBindings func(canary, eu, us *apisv1alpha2.APIBinding)
+
+		wantWave             int32
+		wantPhase            apisv1alpha2.APIDeploymentPhaseType
+		wantStates           []state
+		wantCondition        *conditionsv1alpha1.Condition
+		wantExportResources  []apisv1alpha2.ResourceSchema
+		wantNoBindingsStatus bool
+	}{
+		"first wave starts": {
+			deployment: newDeployment,
+			wantWave:   0,
+			wantPhase:  apisv1alpha2.APIDeploymentPhaseProgressing,
+			wantStates: []state{{"canary", "Upgrading"}, {"europe", "Pending"}, {"", "Pending"}},
+		},
+		"second wave starts when first is upgraded": {
+			deployment: newDeployment,
+			mutateBindings: func(canary, eu, us *apisv1alpha2.APIBinding) {
+				upgrade(canary)
+			},
+			wantWave:   1,
+			wantPhase:  apisv1alpha2.APIDeploymentPhaseProgressing,
+			wantStates: []state{{"canary", "Upgraded"}, {"europe", "Upgrading"}, {"", "Pending"}},
+		},
+		"paused deployment does not advance": {
+			deployment: func() *apisv1alpha2.APIDeployment {
+				d := newDeployment()
+				d.Spec.Paused = true
+				return d
+			},
+			mutateBindings: func(canary, eu, us *apisv1alpha2.APIBinding) {
+				upgrade(canary)
+			},
+			wantWave:   0,
+			wantPhase:  apisv1alpha2.APIDeploymentPhaseProgressing,
+			wantStates: []state{{"canary", "Upgraded"}, {"europe", "Pending"}, {"", "Pending"}},
+		},
+		"failed binding blocks the rollout": {
+			deployment: newDeployment,
+			mutateBindings: func(canary, eu, us *apisv1alpha2.APIBinding) {
+				upgrade(canary)
+				upgrade(eu)
+				fail(us)
+			},
+			wantWave:   2,
+			wantPhase:  apisv1alpha2.APIDeploymentPhaseProgressing,
+			wantStates: []state{{"canary", "Upgraded"}, {"europe", "Upgraded"}, {"", "Failed"}},
+		},
+		"APIExport is updated when all bindings are upgraded": {
+			deployment: newDeployment,
+			mutateBindings: func(canary, eu, us *apisv1alpha2.APIBinding) {
+				upgrade(canary)
+				upgrade(eu)
+				upgrade(us)
+			},
+			wantWave:   3,
+			wantPhase:  apisv1alpha2.APIDeploymentPhaseCompleted,
+			wantStates: []state{{"canary", "Upgraded"}, {"europe", "Upgraded"}, {"", "Upgraded"}},
+			wantExportResources: []apisv1alpha2.ResourceSchema{
+				{Name: "widgets", Group: "example.io", Schema: "v2.widgets.example.io"},
+				{Name: "gadgets", Group: "example.io", Schema: "v1.gadgets.example.io"},
+			},
+		},
+		"incompatible schema stops the rollout": {
+			deployment: func() *apisv1alpha2.APIDeployment {
+				d := newDeployment()
+				d.Spec.Resources[0].Schema = "incompatible.widgets.example.io"
+				return d
+			},
+			wantCondition: &conditionsv1alpha1.Condition{
+				Type:     apisv1alpha2.APIDeploymentSchemasCompatible,
+				Status:   corev1.ConditionFalse,
+				Severity: conditionsv1alpha1.ConditionSeverityError,
+				Reason:   apisv1alpha2.APIDeploymentIncompatibleSchemasReason,
+				Message:  `incompatible.widgets.example.io: spec.versions[v1].schema.openAPIV3Schema.properties[name].type: Invalid value: "integer": The type changed (was "string", now "integer")`,
+			},
+			wantNoBindingsStatus: true,
+		},
+		"missing partition": {
+			deployment: func() *apisv1alpha2.APIDeployment {
+				d := newDeployment()
+				d.Spec.Waves[1].Partition = "asia"
+				return d
+			},
+			wantCondition: &conditionsv1alpha1.Condition{
+				Type:     apisv1alpha2.APIDeploymentReady,
+				Status:   corev1.ConditionFalse,
+				Severity: conditionsv1alpha1.ConditionSeverityError,
+				Reason:   apisv1alpha2.APIDeploymentPartitionNotFoundReason,
+				Message:  "Partition asia of wave europe not found",
+			},
+			wantNoBindingsStatus: true,
+		},
+		"waits for older deployment": {
+			deployment: newDeployment,
+			others: []*apisv1alpha2.APIDeployment{{
+				ObjectMeta: metav1.ObjectMeta{Name: "widgets-v1", CreationTimestamp: metav1.NewTime(time.Unix(50, 0))},
+				Spec:       apisv1alpha2.APIDeploymentSpec{ExportName: "widgets"},
+			}},
+			wantCondition: &conditionsv1alpha1.Condition{
+				Type:     apisv1alpha2.APIDeploymentReady,
+				Status:   corev1.ConditionFalse,
+				Severity: conditionsv1alpha1.ConditionSeverityInfo,
+				Reason:   apisv1alpha2.APIDeploymentWaitingReason,
+				Message:  "Waiting for APIDeployment widgets-v1 to complete",
+			},
+			wantNoBindingsStatus: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			canary := newBinding("a-canary", "us", map[string]string{"canary": "true"})
+			eu := newBinding("b-eu", "eu", nil)
+			us := newBinding("c-us", "us", nil)
+			if tc.mutateBindings != nil {
+				tc.mutateBindings(canary, eu, us)
+			}
+
+			var updatedExport *apisv1alpha2.APIExport
+			c := &controller{
+				listAPIDeploymentsByAPIExport: func(clusterName logicalcluster.Name, exportName string) ([]*apisv1alpha2.APIDeployment, error) {
+					return tc.others, nil
+				},
+				getAPIExport: func(clusterName logicalcluster.Name, name string) (*apisv1alpha2.APIExport, error) {
+					return apiExport, nil
+				},
+				updateAPIExport: func(ctx context.Context, apiExport *apisv1alpha2.APIExport) error {
+					updatedExport = apiExport
+					return nil
+				},
+				getAPIResourceSchema: func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIResourceSchema, error) {
+					require.Equal(t, "provider", clusterName.String())
+					return schemas[name], nil
+				},
+				getPartition: func(clusterName logicalcluster.Name, name string) (*topologyv1alpha1.Partition, error) {
+					if name != partition.Name {
+						return nil, apierrors.NewNotFound(topologyv1alpha1.Resource("partitions"), name)
+					}
+					return partition, nil
+				},
+				listShards: func() ([]*corev1alpha1.Shard, error) {
+					return shards, nil
+				},
+				listAPIBindings: func(apiExport *apisv1alpha2.APIExport) ([]*apisv1alpha2.APIBinding, error) {
+					return []*apisv1alpha2.APIBinding{us, eu, canary}, nil
+				},
+			}
+
+			deployment := tc.deployment()
+			require.NoError(t, c.reconcile(context.Background(), deployment))
+
+			if tc.wantCondition != nil {
+				got := conditions.Get(deployment, tc.wantCondition.Type)
+				require.NotNil(t, got)
+				got.LastTransitionTime = metav1.Time{}
+				require.Equal(t, tc.wantCondition, got)
+			}
+			if tc.wantNoBindingsStatus {
+				require.Empty(t, deployment.Status.Bindings)
+				require.Empty(t, deployment.Status.Phase)
+				return
+			}
+
+			require.Equal(t, tc.wantWave, deployment.Status.CurrentWave)
+			require.Equal(t, tc.wantPhase, deployment.Status.Phase)
+			var got []state
+			for _, b := range deployment.Status.Bindings {
+				got = append(got, state{b.Wave, string(b.State)})
+			}
+			require.Equal(t, tc.wantStates, got)
+
+			if tc.wantExportResources != nil {
+				require.NotNil(t, updatedExport)
+				require.Equal(t, tc.wantExportResources, updatedExport.Spec.Resources)
+			} else {
+				require.Nil(t, updatedExport)
+			}
+		})
+	}
+}
+
+func TestBindingResources(t *testing.T) {
+	apiExport := &apisv1alpha2.APIExport{
+		ObjectMeta: metav1.ObjectMeta{Name: "widgets"},
+		Spec: apisv1alpha2.APIExportSpec{
+			Resources: []apisv1alpha2.ResourceSchema{
+				{Name: "widgets", Group: "example.io", Schema: "v1.widgets.example.io"},
+				{Name: "gadgets", Group: "example.io", Schema: "v1.gadgets.example.io"},
+			},
+		},
+	}
+	deployment := &apisv1alpha2.APIDeployment{
+		Spec: apisv1alpha2.APIDeploymentSpec{
+			ExportName: "widgets",
+			Resources: []apisv1alpha2.ResourceSchema{
+				{Name: "widgets", Group: "example.io", Schema: "v2.widgets.example.io"},
+				{Name: "gizmos", Group: "example.io", Schema: "v1.gizmos.example.io"},
+			},
+		},
+		Status: apisv1alpha2.APIDeploymentStatus{
+			Phase: apisv1alpha2.APIDeploymentPhaseProgressing,
+			Bindings: []apisv1alpha2.APIDeploymentBindingStatus{
+				{Cluster: "admitted", Name: "widgets", State: apisv1alpha2.APIDeploymentBindingUpgrading},
+				{Cluster: "pending", Name: "widgets", State: apisv1alpha2.APIDeploymentBindingPending},
+			},
+		},
+	}
+
+	admitted := newBinding("admitted", "", nil)
+	require.Equal(t, []apisv1alpha2.ResourceSchema{
+		{Name: "widgets", Group: "example.io", Schema: "v2.widgets.example.io"},
+		{Name: "gadgets", Group: "example.io", Schema: "v1.gadgets.example.io"},
+		{Name: "gizmos", Group: "example.io", Schema: "v1.gizmos.example.io"},
+	}, BindingResources(apiExport, []*apisv1alpha2.APIDeployment{deployment}, admitted))
+
+	pending := newBinding("pending", "", nil)
+	require.Equal(t, apiExport.Spec.Resources, BindingResources(apiExport, []*apisv1alpha2.APIDeployment{deployment}, pending))
+
+	completed := deployment.DeepCopy()
+	completed.Status.Phase = apisv1alpha2.APIDeploymentPhaseCompleted
+	require.Equal(t, apiExport.Spec.Resources, BindingResources(apiExport, []*apisv1alpha2.APIDeployment{completed}, admitted))
+}
@@ -80,6 +80,8 @@ func NewController(
 		},
 
 		commit: committer.NewCommitter[*APIBinding, Patcher, *APIBindingSpec, *APIBindingStatus](kcpClusterClient.ApisV1alpha2().APIBindings()),
+
+		now: time.Now,
 	}
 
 	_, _ = apiBindingInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -115,6 +117,8 @@ type controller struct {
 	getAPIExport      func(path logicalcluster.Path, name string) (*apisv1alpha2.APIExport, error)
 
 	commit CommitFunc
+
+	now func() time.Time
 }
 
 // enqueueAPIBinding enqueues an APIBinding.
@@ -167,29 +171,34 @@ func (c *controller) processNextWorkItem(ctx context.Context) bool {
 	// other workers.
 	defer c.queue.Done(key)
 
-	if err := c.process(ctx, key); err != nil {
+	requeueAfter, err := c.process(ctx, key)
+	if err != nil {
 		utilruntime.HandleError(fmt.Errorf("%q controller failed to sync %q, err: %w", ControllerName, key, err))
 		c.queue.AddRateLimited(key)
 		return true
 	}
 	c.queue.Forget(key)
+	if requeueAfter > 0 {
+		// revoke the next accepted permission claim when it expires.
+		c.queue.AddAfter(key, requeueAfter)
+	}
 	return true
 }
 
-func (c *controller) process(ctx context.Context, key string) error {
+func (c *controller) process(ctx context.Context, key string) (time.Duration, error) {
 	logger := klog.FromContext(ctx)
 	clusterName, _, name, err := kcpcache.SplitMetaClusterNamespaceKey(key)
 	if err != nil {
 		logger.Error(err, "invalid key")
-		return nil
+		return 0, nil
 	}
 
 	obj, err := c.apiBindingsLister.Cluster(clusterName).Get(name)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
-			return nil // object deleted before we handled it
+			return 0, nil // object deleted before we handled it
 		}
-		return err
+		return 0, err
 	}
 
 	old := obj
@@ -213,7 +222,7 @@ func (c *controller) process(ctx context.Context, key string) error {
 		errs = append(errs, err)
 	}
 
-	return utilerrors.NewAggregate(errs)
+	return nextClaimExpiry(obj, c.now()), utilerrors.NewAggregate(errs)
 }
 
 // InstallIndexers adds the additional indexers that this controller requires to the informers.
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -74,14 +75,22 @@ func (c *controller) reconcile(ctx context.Context, apiBinding *apisv1alpha2.API
 		exportedClaims.Insert(setKeyForClaim(claim))
 	}
 
+	now := c.now()
 	acceptedClaims := sets.New[string]()
 	acceptedClaimsMap := make(map[string]apisv1alpha2.ScopedPermissionClaim)
+	expiredClaims := sets.New[string]()
 	for _, claim := range apiBinding.Spec.PermissionClaims {
-		if claim.State == apisv1alpha2.ClaimAccepted {
-			key := setKeyForClaim(claim.PermissionClaim)
-			acceptedClaims.Insert(key)
-			acceptedClaimsMap[key] = claim.ScopedPermissionClaim
+		if claim.State != apisv1alpha2.ClaimAccepted {
+			continue
+		}
+		key := setKeyForClaim(claim.PermissionClaim)
+		if !claim.IsAcceptedAt(now) {
+			// expired claims are removed like rejected ones.
+			expiredClaims.Insert(key)
+			continue
 		}
+		acceptedClaims.Insert(key)
+		acceptedClaimsMap[key] = claim.ScopedPermissionClaim
 	}
 
 	appliedClaims := sets.New[string]()
@@ -106,6 +115,7 @@ func (c *controller) reconcile(ctx context.Context, apiBinding *apisv1alpha2.API
 		"unexpected", unexpectedClaims,
 		"toApply", needToApply,
 		"toRemove", needToRemove,
+		"expired", expiredClaims,
 		"all", allChanges,
 	)
 
@@ -159,6 +169,18 @@ func (c *controller) reconcile(ctx context.Context, apiBinding *apisv1alpha2.API
 				continue
 			}
 
+			if selectorChanges.Has(s) {
+				// only relabel the objects whose selection changed with the selector.
+				changed, err := selectionChanged(appliedClaimsMap[s].Selector, acceptedClaimsMap[s].Selector, u)
+				if err != nil {
+					claimErrs = append(claimErrs, fmt.Errorf("error matching selector of claim %s: %w", s, err))
+					continue
+				}
+				if !changed {
+					continue
+				}
+			}
+
 			actualGVR := gvr
 			if actualVersion := u.GetAnnotations()[handlers.KCPOriginalAPIVersionAnnotation]; actualVersion != "" {
 				actualGV, err := schema.ParseGroupVersion(actualVersion)
@@ -218,12 +240,16 @@ func (c *controller) reconcile(ctx context.Context, apiBinding *apisv1alpha2.API
 		conditions.MarkTrue(apiBinding, apisv1alpha2.PermissionClaimsValid)
 	}
 
-	fullyApplied := expectedClaims.Difference(applyErrors)
 	apiBinding.Status.AppliedPermissionClaims = []apisv1alpha2.ScopedPermissionClaim{}
-	for _, s := range sets.List[string](fullyApplied) {
-		// fullyApplied = (exportedClaims ∩ acceptedClaims) ⊖ applyErrors,
-		// hence s must be in acceptedClaims (and exportedClaims).
-		apiBinding.Status.AppliedPermissionClaims = append(apiBinding.Status.AppliedPermissionClaims, acceptedClaimsMap[s])
+	for _, s := range sets.List[string](expectedClaims) {
+		switch {
+		case !applyErrors.Has(s):
+			// expectedClaims = exportedClaims ∩ acceptedClaims, hence s must be in acceptedClaims.
+			apiBinding.Status.AppliedPermissionClaims = append(apiBinding.Status.AppliedPermissionClaims, acceptedClaimsMap[s])
+		case selectorChanges.Has(s):
+			// keep the previous selector applied until relabeling for the new one succeeds.
+			apiBinding.Status.AppliedPermissionClaims = append(apiBinding.Status.AppliedPermissionClaims, appliedClaimsMap[s])
+		}
 	}
 
 	if len(allErrs) > 0 {
@@ -249,6 +275,16 @@ func (c *controller) reconcile(ctx context.Context, apiBinding *apisv1alpha2.API
 			len(errsToDisplay.Errors()),
 			errsToDisplay,
 		)
+	} else if expiredClaims.Len() > 0 {
+		conditions.MarkFalse(
+			apiBinding,
+			apisv1alpha2.PermissionClaimsApplied,
+			apisv1alpha2.PermissionClaimsExpiredReason,
+			conditionsv1alpha1.ConditionSeverityWarning,
+			"The acceptance of %d permission claim(s) has expired and access has been revoked: %s",
+			expiredClaims.Len(),
+			strings.Join(sets.List[string](expiredClaims), ", "),
+		)
 	} else {
 		conditions.MarkTrue(apiBinding, apisv1alpha2.PermissionClaimsApplied)
 	}
@@ -297,6 +333,35 @@ func (c *controller) patchGenericObject(ctx context.Context, obj metav1.Object,
 	return nil
 }
 
+// nextClaimExpiry returns the duration until the acceptance of the next accepted permission
+// claim expires, or 0 if no accepted claim expires in the future.
+func nextClaimExpiry(apiBinding *apisv1alpha2.APIBinding, now time.Time) time.Duration {
+	var next time.Duration
+	for _, claim := range apiBinding.Spec.PermissionClaims {
+		if !claim.IsAcceptedAt(now) || claim.ExpiresAt == nil {
+			continue
+		}
+		if d := claim.ExpiresAt.Sub(now); next == 0 || d < next {
+			next = d
+		}
+	}
+	return next
+}
+
+// selectionChanged returns whether the object is selected by only one of the selectors,
+// i.e. whether its claim labels change when the selector of a claim is changed.
+func selectionChanged(oldSelector, newSelector apisv1alpha2.PermissionClaimSelector, obj metav1.Object) (bool, error) {
+	oldMatches, err := permissionclaim.SelectorMatches(oldSelector, obj.GetNamespace(), obj.GetName(), obj.GetLabels())
+	if err != nil {
+		return false, err
+	}
+	newMatches, err := permissionclaim.SelectorMatches(newSelector, obj.GetNamespace(), obj.GetName(), obj.GetLabels())
+	if err != nil {
+		return false, err
+	}
+	return oldMatches != newMatches, nil
+}
+
 func detectSelectorChanges(
 	expectedClaims, acceptedClaims, appliedClaims sets.Set[string],
 	acceptedClaimsMap, appliedClaimsMap map[string]apisv1alpha2.ScopedPermissionClaim,
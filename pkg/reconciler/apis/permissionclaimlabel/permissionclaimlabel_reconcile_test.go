@@ -18,6 +18,7 @@ package permissionclaimlabel
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
@@ -159,3 +160,110 @@ func TestSelectorChangeDetection(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectionChanged(t *testing.T) {
+	matchAll := apisv1alpha2.PermissionClaimSelector{MatchAll: true}
+	matchFoo := apisv1alpha2.PermissionClaimSelector{
+		LabelSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{"foo": "bar"},
+		},
+	}
+	inDefault := apisv1alpha2.PermissionClaimSelector{Namespaces: []string{"default"}}
+
+	tests := map[string]struct {
+		oldSelector, newSelector apisv1alpha2.PermissionClaimSelector
+		obj                      metav1.ObjectMeta
+		want                     bool
+	}{
+		"narrowed to labels, object still selected": {
+			oldSelector: matchAll,
+			newSelector: matchFoo,
+			obj:         metav1.ObjectMeta{Namespace: "default", Name: "a", Labels: map[string]string{"foo": "bar"}},
+			want:        false,
+		},
+		"narrowed to labels, object no longer selected": {
+			oldSelector: matchAll,
+			newSelector: matchFoo,
+			obj:         metav1.ObjectMeta{Namespace: "default", Name: "a"},
+			want:        true,
+		},
+		"widened to all, object newly selected": {
+			oldSelector: matchFoo,
+			newSelector: matchAll,
+			obj:         metav1.ObjectMeta{Namespace: "default", Name: "a"},
+			want:        true,
+		},
+		"moved from labels to namespace, object in neither": {
+			oldSelector: matchFoo,
+			newSelector: inDefault,
+			obj:         metav1.ObjectMeta{Namespace: "other", Name: "a"},
+			want:        false,
+		},
+		"moved from labels to namespace, object in both": {
+			oldSelector: matchFoo,
+			newSelector: inDefault,
+			obj:         metav1.ObjectMeta{Namespace: "default", Name: "a", Labels: map[string]string{"foo": "bar"}},
+			want:        false,
+		},
+		"moved from labels to namespace, object only in new": {
+			oldSelector: matchFoo,
+			newSelector: inDefault,
+			obj:         metav1.ObjectMeta{Namespace: "default", Name: "a"},
+			want:        true,
+		},
+	}
+
+	for testName, tc := range tests {
+		t.Run(testName, func(t *testing.T) {
+			got, err := selectionChanged(tc.oldSelector, tc.newSelector, &tc.obj)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestNextClaimExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	claim := func(state apisv1alpha2.AcceptablePermissionClaimState, expiresAt *time.Time) apisv1alpha2.AcceptablePermissionClaim {
+		c := apisv1alpha2.AcceptablePermissionClaim{State: state}
+		if expiresAt != nil {
+			c.ExpiresAt = &metav1.Time{Time: *expiresAt}
+		}
+		return c
+	}
+	inAnHour := now.Add(time.Hour)
+	inAMinute := now.Add(time.Minute)
+	aMinuteAgo := now.Add(-time.Minute)
+
+	tests := map[string]struct {
+		claims []apisv1alpha2.AcceptablePermissionClaim
+		want   time.Duration
+	}{
+		"no claims": {},
+		"accepted without expiry": {
+			claims: []apisv1alpha2.AcceptablePermissionClaim{claim(apisv1alpha2.ClaimAccepted, nil)},
+		},
+		"rejected with expiry": {
+			claims: []apisv1alpha2.AcceptablePermissionClaim{claim(apisv1alpha2.ClaimRejected, &inAMinute)},
+		},
+		"already expired": {
+			claims: []apisv1alpha2.AcceptablePermissionClaim{claim(apisv1alpha2.ClaimAccepted, &aMinuteAgo)},
+		},
+		"earliest of several": {
+			claims: []apisv1alpha2.AcceptablePermissionClaim{
+				claim(apisv1alpha2.ClaimAccepted, &inAnHour),
+				claim(apisv1alpha2.ClaimAccepted, &aMinuteAgo),
+				claim(apisv1alpha2.ClaimAccepted, &inAMinute),
+				claim(apisv1alpha2.ClaimAccepted, nil),
+			},
+			want: time.Minute,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			binding := &apisv1alpha2.APIBinding{Spec: apisv1alpha2.APIBindingSpec{PermissionClaims: tc.claims}}
+			require.Equal(t, tc.want, nextClaimExpiry(binding, now))
+		})
+	}
+}
@@ -227,6 +227,11 @@ func InstallIndexers(
 			Local:  localKcpInformers.Apis().V1alpha1().APIConversions().Informer(),
 			Global: globalKcpInformers.Apis().V1alpha1().APIConversions().Informer(),
 		},
+		apisv1alpha2.SchemeGroupVersion.WithResource("apideployments"): {
+			Kind:   "APIDeployment",
+			Local:  localKcpInformers.Apis().V1alpha2().APIDeployments().Informer(),
+			Global: globalKcpInformers.Apis().V1alpha2().APIDeployments().Informer(),
+		},
 		admissionregistrationv1.SchemeGroupVersion.WithResource("mutatingwebhookconfigurations"): {
 			Kind:   "MutatingWebhookConfiguration",
 			Local:  localKubeInformers.Admissionregistration().V1().MutatingWebhookConfigurations().Informer(),
@@ -22,6 +22,9 @@ import (
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -30,10 +33,14 @@ import (
 	"k8s.io/klog/v2"
 
 	kcpcache "github.com/kcp-dev/apimachinery/v2/pkg/cache"
+	"github.com/kcp-dev/logicalcluster/v3"
 	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
 	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
 	corev1alpha1client "github.com/kcp-dev/sdk/client/clientset/versioned/typed/core/v1alpha1"
+	apisv1alpha2informers "github.com/kcp-dev/sdk/client/informers/externalversions/apis/v1alpha2"
+	cachev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/cache/v1alpha1"
 	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
+	tenancyv1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/tenancy/v1alpha1"
 	corev1alpha1listers "github.com/kcp-dev/sdk/client/listers/core/v1alpha1"
 
 	"github.com/kcp-dev/kcp/pkg/logging"
@@ -48,6 +55,10 @@ func NewController(
 	shardExternalURL func() string,
 	kcpClusterClient kcpclientset.ClusterInterface,
 	logicalClusterInformer corev1alpha1informers.LogicalClusterClusterInformer,
+	apiExportInformer apisv1alpha2informers.APIExportClusterInformer,
+	apiBindingInformer apisv1alpha2informers.APIBindingClusterInformer,
+	workspaceTypeInformer tenancyv1alpha1informers.WorkspaceTypeClusterInformer,
+	cachedResourceInformer cachev1alpha1informers.CachedResourceClusterInformer,
 ) (*Controller, error) {
 	c := &Controller{
 		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
@@ -60,7 +71,49 @@ func NewController(
 		kcpClusterClient:      kcpClusterClient,
 		logicalClusterIndexer: logicalClusterInformer.Informer().GetIndexer(),
 		logicalClusterLister:  logicalClusterInformer.Lister(),
-		commit:                committer.NewCommitter[*corev1alpha1.LogicalCluster, corev1alpha1client.LogicalClusterInterface, *corev1alpha1.LogicalClusterSpec, *corev1alpha1.LogicalClusterStatus](kcpClusterClient.CoreV1alpha1().LogicalClusters()),
+		pathResources: []pathResource{
+			{
+				resource: "apiexports",
+				list: func(clusterName logicalcluster.Name) ([]metav1.Object, error) {
+					return asObjects(apiExportInformer.Lister().Cluster(clusterName).List(labels.Everything()))
+				},
+				patch: func(ctx context.Context, clusterName logicalcluster.Name, name string, patch []byte) error {
+					_, err := kcpClusterClient.Cluster(clusterName.Path()).ApisV1alpha2().APIExports().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+					return err
+				},
+			},
+			{
+				resource: "apibindings",
+				list: func(clusterName logicalcluster.Name) ([]metav1.Object, error) {
+					return asObjects(apiBindingInformer.Lister().Cluster(clusterName).List(labels.Everything()))
+				},
+				patch: func(ctx context.Context, clusterName logicalcluster.Name, name string, patch []byte) error {
+					_, err := kcpClusterClient.Cluster(clusterName.Path()).ApisV1alpha2().APIBindings().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+					return err
+				},
+			},
+			{
+				resource: "workspacetypes",
+				list: func(clusterName logicalcluster.Name) ([]metav1.Object, error) {
+					return asObjects(workspaceTypeInformer.Lister().Cluster(clusterName).List(labels.Everything()))
+				},
+				patch: func(ctx context.Context, clusterName logicalcluster.Name, name string, patch []byte) error {
+					_, err := kcpClusterClient.Cluster(clusterName.Path()).TenancyV1alpha1().WorkspaceTypes().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+					return err
+				},
+			},
+			{
+				resource: "cachedresources",
+				list: func(clusterName logicalcluster.Name) ([]metav1.Object, error) {
+					return asObjects(cachedResourceInformer.Lister().Cluster(clusterName).List(labels.Everything()))
+				},
+				patch: func(ctx context.Context, clusterName logicalcluster.Name, name string, patch []byte) error {
+					_, err := kcpClusterClient.Cluster(clusterName.Path()).CacheV1alpha1().CachedResources().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+					return err
+				},
+			},
+		},
+		commit: committer.NewCommitter[*corev1alpha1.LogicalCluster, corev1alpha1client.LogicalClusterInterface, *corev1alpha1.LogicalClusterSpec, *corev1alpha1.LogicalClusterStatus](kcpClusterClient.CoreV1alpha1().LogicalClusters()),
 	}
 	_, _ = logicalClusterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    func(obj interface{}) { c.enqueue(obj) },
@@ -85,10 +138,24 @@ type Controller struct {
 	logicalClusterIndexer cache.Indexer
 	logicalClusterLister  corev1alpha1listers.LogicalClusterClusterLister
 
+	// pathResources carry the canonical path annotation of the logical cluster.
+	pathResources []pathResource
+
 	// commit creates a patch and submits it, if needed.
 	commit func(ctx context.Context, old, new *logicalClusterResource) error
 }
 
+func asObjects[T metav1.Object](objs []T, err error) ([]metav1.Object, error) {
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]metav1.Object, 0, len(objs))
+	for _, obj := range objs {
+		ret = append(ret, obj)
+	}
+	return ret, nil
+}
+
 func (c *Controller) enqueue(obj interface{}) {
 	key, err := kcpcache.DeletionHandlingMetaClusterNamespaceKeyFunc(obj)
 	if err != nil {
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logicalcluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+)
+
+// pathResource lists and patches the objects of a resource carrying the canonical path
+// annotation of their logical cluster.
+type pathResource struct {
+	resource string
+	list     func(clusterName logicalcluster.Name) ([]metav1.Object, error)
+	patch    func(ctx context.Context, clusterName logicalcluster.Name, name string, patch []byte) error
+}
+
+// pathReconciler updates the kcp.io/path annotation of the objects in the logical cluster
+// when the canonical path of the logical cluster changes, i.e. when its workspace is moved.
+type pathReconciler struct {
+	resources []pathResource
+}
+
+func (r *pathReconciler) reconcile(ctx context.Context, logicalCluster *corev1alpha1.LogicalCluster) (reconcileStatus, error) {
+	path, found := logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey]
+	if !found || !logicalCluster.DeletionTimestamp.IsZero() {
+		return reconcileStatusContinue, nil
+	}
+
+	logger := klog.FromContext(ctx)
+	clusterName := logicalcluster.From(logicalCluster)
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{core.LogicalClusterPathAnnotationKey: path},
+		},
+	})
+	if err != nil {
+		return reconcileStatusContinue, err
+	}
+
+	var errs []error
+	for _, resource := range r.resources {
+		objs, err := resource.list(clusterName)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, obj := range objs {
+			value, found := obj.GetAnnotations()[core.LogicalClusterPathAnnotationKey]
+			if !found || value == path {
+				continue
+			}
+			logger.V(2).Info("updating path annotation", "resource", resource.resource, "name", obj.GetName(), "from", value, "to", path)
+			if err := resource.patch(ctx, clusterName, obj.GetName(), patch); err != nil {
+				errs = append(errs, fmt.Errorf("failed to update path annotation of %s %s|%s: %w", resource.resource, clusterName, obj.GetName(), err))
+			}
+		}
+	}
+
+	return reconcileStatusContinue, utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logicalcluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+)
+
+func TestReconcilePath(t *testing.T) {
+	for _, testCase := range []struct {
+		name            string
+		path            string
+		objects         []metav1.Object
+		expectedPatched []string
+	}{
+		{
+			name: "patches objects with a stale path",
+			path: "root:new:team",
+			objects: []metav1.Object{
+				&metav1.ObjectMeta{Name: "stale", Annotations: map[string]string{"kcp.io/path": "root:old:team"}},
+				&metav1.ObjectMeta{Name: "current", Annotations: map[string]string{"kcp.io/path": "root:new:team"}},
+				&metav1.ObjectMeta{Name: "without"},
+			},
+			expectedPatched: []string{"stale"},
+		},
+		{
+			name: "ignores logical clusters without path",
+			objects: []metav1.Object{
+				&metav1.ObjectMeta{Name: "stale", Annotations: map[string]string{"kcp.io/path": "root:old:team"}},
+			},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			logicalCluster := &corev1alpha1.LogicalCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        corev1alpha1.LogicalClusterName,
+					Annotations: map[string]string{logicalcluster.AnnotationKey: "34"},
+				},
+			}
+			if testCase.path != "" {
+				logicalCluster.Annotations["kcp.io/path"] = testCase.path
+			}
+
+			var patched []string
+			r := &pathReconciler{resources: []pathResource{{
+				resource: "apiexports",
+				list: func(clusterName logicalcluster.Name) ([]metav1.Object, error) {
+					require.Equal(t, logicalcluster.Name("34"), clusterName)
+					return testCase.objects, nil
+				},
+				patch: func(ctx context.Context, clusterName logicalcluster.Name, name string, patch []byte) error {
+					require.Equal(t, logicalcluster.Name("34"), clusterName)
+					require.JSONEq(t, `{"metadata":{"annotations":{"kcp.io/path":"`+testCase.path+`"}}}`, string(patch))
+					patched = append(patched, name)
+					return nil
+				},
+			}}}
+
+			status, err := r.reconcile(context.Background(), logicalCluster)
+			require.NoError(t, err)
+			require.Equal(t, reconcileStatusContinue, status)
+			require.Equal(t, testCase.expectedPatched, patched)
+		})
+	}
+}
@@ -37,6 +37,17 @@ func (r *phaseReconciler) reconcile(ctx context.Context, workspace *corev1alpha1
 
 		workspace.Status.Phase = corev1alpha1.LogicalClusterPhaseReady
 		conditions.MarkTrue(workspace, tenancyv1alpha1.WorkspaceInitialized)
+
+	case corev1alpha1.LogicalClusterPhaseReady:
+		if workspace.Spec.Hibernation != "" && workspace.DeletionTimestamp.IsZero() {
+			workspace.Status.Phase = corev1alpha1.LogicalClusterPhaseHibernated
+		}
+
+	case corev1alpha1.LogicalClusterPhaseHibernated:
+		// wake up on deletion, so that the content can be deleted.
+		if workspace.Spec.Hibernation == "" || !workspace.DeletionTimestamp.IsZero() {
+			workspace.Status.Phase = corev1alpha1.LogicalClusterPhaseReady
+		}
 	}
 
 	return reconcileStatusContinue, nil
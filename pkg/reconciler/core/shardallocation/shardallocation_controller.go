@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shardallocation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	kcpcache "github.com/kcp-dev/apimachinery/v2/pkg/cache"
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+	corev1alpha1client "github.com/kcp-dev/sdk/client/clientset/versioned/typed/core/v1alpha1"
+	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/logging"
+	"github.com/kcp-dev/kcp/pkg/reconciler/committer"
+	"github.com/kcp-dev/kcp/pkg/reconciler/events"
+)
+
+const (
+	ControllerName = "kcp-shard-allocation"
+
+	// maxRemainingInMessage is the number of remaining logical clusters listed in the Drained condition.
+	maxRemainingInMessage = 5
+)
+
+// NewController returns a controller that reports the number of logical clusters
+// living on this shard in the status of the Shard object in the root workspace, and
+// whether a draining shard has been emptied.
+func NewController(
+	shardName string,
+	rootKcpClusterClient kcpclientset.ClusterInterface,
+	logicalClusterInformer corev1alpha1informers.LogicalClusterClusterInformer,
+	globalShardInformer corev1alpha1informers.ShardClusterInformer,
+) (*Controller, error) {
+	c := &Controller{
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: ControllerName,
+			},
+		),
+		shardName: shardName,
+		getShard: func(name string) (*corev1alpha1.Shard, error) {
+			return globalShardInformer.Lister().Cluster(core.RootCluster).Get(name)
+		},
+		listLogicalClusters: func() ([]*corev1alpha1.LogicalCluster, error) {
+			return logicalClusterInformer.Lister().List(labels.Everything())
+		},
+		commit: committer.NewCommitter[*corev1alpha1.Shard, corev1alpha1client.ShardInterface, *corev1alpha1.ShardSpec, *corev1alpha1.ShardStatus](rootKcpClusterClient.CoreV1alpha1().Shards()),
+	}
+
+	_, _ = logicalClusterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { c.enqueue("LogicalCluster added") },
+		DeleteFunc: func(obj interface{}) { c.enqueue("LogicalCluster deleted") },
+	})
+
+	_, _ = globalShardInformer.Informer().AddEventHandler(events.WithoutSyncs(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			key, err := kcpcache.DeletionHandlingMetaClusterNamespaceKeyFunc(obj)
+			if err != nil {
+				return false
+			}
+			clusterName, _, name, err := kcpcache.SplitMetaClusterNamespaceKey(key)
+			if err != nil {
+				return false
+			}
+			return clusterName == core.RootCluster && name == shardName
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(obj interface{}) { c.enqueue("Shard added") },
+			UpdateFunc: func(_, obj interface{}) { c.enqueue("Shard updated") },
+		},
+	}))
+
+	return c, nil
+}
+
+type shardResource = committer.Resource[*corev1alpha1.ShardSpec, *corev1alpha1.ShardStatus]
+
+// Controller counts the LogicalClusters of this shard and writes the result into
+// status.allocated of the Shard, where the workspace scheduler compares it against
+// the declared capacity.
+type Controller struct {
+	queue workqueue.TypedRateLimitingInterface[string]
+
+	shardName string
+
+	getShard            func(name string) (*corev1alpha1.Shard, error)
+	listLogicalClusters func() ([]*corev1alpha1.LogicalCluster, error)
+
+	// commit creates a patch and submits it, if needed.
+	commit func(ctx context.Context, old, new *shardResource) error
+}
+
+// enqueue adds the shard of this controller to the queue. There is only a single key,
+// such that bursts of logical cluster events are collapsed into one update.
+func (c *Controller) enqueue(reason string) {
+	logger := logging.WithQueueKey(logging.WithReconciler(klog.Background(), ControllerName), c.shardName)
+	logger.V(4).Info("queueing Shard", "reason", reason)
+	c.queue.Add(c.shardName)
+}
+
+func (c *Controller) Start(ctx context.Context, numThreads int) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	logger := logging.WithReconciler(klog.FromContext(ctx), ControllerName)
+	ctx = klog.NewContext(ctx, logger)
+	logger.Info("Starting controller")
+	defer logger.Info("Shutting down controller")
+
+	for range numThreads {
+		go wait.UntilWithContext(ctx, c.startWorker, time.Second)
+	}
+
+	<-ctx.Done()
+}
+
+func (c *Controller) startWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+func (c *Controller) processNextWorkItem(ctx context.Context) bool {
+	// Wait until there is a new item in the working queue
+	k, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	key := k
+
+	logger := logging.WithQueueKey(klog.FromContext(ctx), key)
+	ctx = klog.NewContext(ctx, logger)
+	logger.V(4).Info("processing key")
+
+	// No matter what, tell the queue we're done with this key, to unblock
+	// other workers.
+	defer c.queue.Done(key)
+
+	if err := c.process(ctx, key); err != nil {
+		utilruntime.HandleError(fmt.Errorf("%q controller failed to sync %q, err: %w", ControllerName, key, err))
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	c.queue.Forget(key)
+	return true
+}
+
+func (c *Controller) process(ctx context.Context, name string) error {
+	shard, err := c.getShard(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil // the shard is not registered yet, we get an event when it is
+		}
+		return err
+	}
+
+	logicalClusters, err := c.listLogicalClusters()
+	if err != nil {
+		return err
+	}
+	var remaining []string
+	for _, lc := range logicalClusters {
+		if strings.HasPrefix(logicalcluster.From(lc).String(), "system:") {
+			continue // system logical clusters are not scheduled
+		}
+		name := logicalcluster.From(lc).String()
+		if path, found := lc.Annotations[core.LogicalClusterPathAnnotationKey]; found {
+			name = path
+		}
+		remaining = append(remaining, name)
+	}
+	count := int64(len(remaining))
+
+	old := shard
+	shard = shard.DeepCopy()
+
+	logger := logging.WithObject(klog.FromContext(ctx), shard)
+	ctx = klog.NewContext(ctx, logger)
+
+	if shard.Status.Allocated == nil {
+		shard.Status.Allocated = corev1.ResourceList{}
+	}
+	shard.Status.Allocated[corev1alpha1.ShardResourceLogicalClusters] = *resource.NewQuantity(count, resource.DecimalSI)
+
+	switch {
+	case !shard.Spec.Drain:
+		conditions.Delete(shard, corev1alpha1.ShardDrained)
+	case count == 0:
+		conditions.MarkTrue(shard, corev1alpha1.ShardDrained)
+	default:
+		slices.Sort(remaining)
+		if len(remaining) > maxRemainingInMessage {
+			remaining = append(remaining[:maxRemainingInMessage:maxRemainingInMessage], fmt.Sprintf("and %d more", count-maxRemainingInMessage))
+		}
+		conditions.MarkFalse(shard, corev1alpha1.ShardDrained, corev1alpha1.ShardDraining, conditionsv1alpha1.ConditionSeverityInfo, "%d logical clusters remaining: %s", count, strings.Join(remaining, ", "))
+	}
+
+	oldResource := &shardResource{ObjectMeta: old.ObjectMeta, Spec: &old.Spec, Status: &old.Status}
+	newResource := &shardResource{ObjectMeta: shard.ObjectMeta, Spec: &shard.Spec, Status: &shard.Status}
+	if err := c.commit(ctx, oldResource, newResource); err != nil {
+		return err
+	}
+
+	logger.V(6).Info("processed Shard", "logicalClusters", count)
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shardallocation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+)
+
+func logicalClusters(names ...string) []*corev1alpha1.LogicalCluster {
+	lcs := make([]*corev1alpha1.LogicalCluster, 0, len(names))
+	for _, name := range names {
+		lcs = append(lcs, &corev1alpha1.LogicalCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        corev1alpha1.LogicalClusterName,
+				Annotations: map[string]string{logicalcluster.AnnotationKey: name, "kcp.io/path": "root:" + name},
+			},
+		})
+	}
+	return lcs
+}
+
+func TestProcess(t *testing.T) {
+	tests := map[string]struct {
+		drain           bool
+		logicalClusters []*corev1alpha1.LogicalCluster
+		expectedDrained *corev1.ConditionStatus
+		expectedMessage string
+	}{
+		"not draining": {
+			logicalClusters: logicalClusters("a", "b", "system:admin"),
+		},
+		"draining with remaining logical clusters": {
+			drain:           true,
+			logicalClusters: logicalClusters("g", "f", "e", "d", "c", "b", "a", "system:admin"),
+			expectedDrained: ptr.To(corev1.ConditionFalse),
+			expectedMessage: "7 logical clusters remaining: root:a, root:b, root:c, root:d, root:e, and 2 more",
+		},
+		"drained": {
+			drain:           true,
+			logicalClusters: logicalClusters("system:admin"),
+			expectedDrained: ptr.To(corev1.ConditionTrue),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			shard := &corev1alpha1.Shard{
+				ObjectMeta: metav1.ObjectMeta{Name: "alpha"},
+				Spec:       corev1alpha1.ShardSpec{Drain: tc.drain},
+			}
+			var committed *shardResource
+			c := &Controller{
+				shardName: "alpha",
+				getShard: func(name string) (*corev1alpha1.Shard, error) {
+					return shard, nil
+				},
+				listLogicalClusters: func() ([]*corev1alpha1.LogicalCluster, error) {
+					return tc.logicalClusters, nil
+				},
+				commit: func(ctx context.Context, old, new *shardResource) error {
+					committed = new
+					return nil
+				},
+			}
+			require.NoError(t, c.process(context.Background(), "alpha"))
+			require.NotNil(t, committed)
+
+			allocated := committed.Status.Allocated[corev1alpha1.ShardResourceLogicalClusters]
+			require.Equal(t, int64(len(tc.logicalClusters)-1), allocated.Value(), "system logical clusters are not counted")
+
+			updated := &corev1alpha1.Shard{Status: *committed.Status}
+			drained := conditions.Get(updated, corev1alpha1.ShardDrained)
+			if tc.expectedDrained == nil {
+				require.Nil(t, drained)
+				return
+			}
+			require.NotNil(t, drained)
+			require.Equal(t, *tc.expectedDrained, drained.Status)
+			require.Equal(t, tc.expectedMessage, drained.Message)
+		})
+	}
+}
@@ -276,10 +276,8 @@ func (c *DynamicTypesController) enqueueAPIBindingUpdate(apiBinding *apisv1alpha
 	}
 
 	for _, boundRes := range apiBinding.Status.BoundResources {
-		gr := schema.GroupResource{
-			Group:    boundRes.Group,
-			Resource: boundRes.Resource,
-		}
+		// Resources are locked under the name they are served as.
+		gr := boundRes.ServedGroupResource()
 		key := gr.String()
 		if _, hasBinding := boundResourcesAnn[key]; !hasBinding {
 			continue
@@ -447,17 +445,26 @@ func (c *DynamicTypesController) gatherGVKRsForAPIBinding(apiBinding *apisv1alph
 			return nil, err
 		}
 
+		// Map the resource under the name the APIBinding serves it as.
+		group, singular, plural := sch.Spec.Group, sch.Spec.Names.Singular, sch.Spec.Names.Plural
+		if rename := apiBinding.Spec.FindResourceRename(group, plural); rename != nil {
+			if rename.As.Resource != plural {
+				singular = rename.As.Resource
+			}
+			group, plural = rename.As.Group, rename.As.Resource
+		}
+
 		for _, schVersion := range sch.Spec.Versions {
 			if !schVersion.Served {
 				continue
 			}
 
 			gvkrs = append(gvkrs, newTypeMeta(
-				sch.Spec.Group,
+				group,
 				schVersion.Name,
 				sch.Spec.Names.Kind,
-				sch.Spec.Names.Singular,
-				sch.Spec.Names.Plural,
+				singular,
+				plural,
 				resourceScopeToRESTScope(sch.Spec.Scope),
 			))
 		}
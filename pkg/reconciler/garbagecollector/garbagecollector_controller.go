@@ -35,6 +35,7 @@ import (
 	kcpkubernetesclient "github.com/kcp-dev/client-go/kubernetes"
 	kcpmetadataclient "github.com/kcp-dev/client-go/metadata"
 	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
 	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
 	corev1alpha1listers "github.com/kcp-dev/sdk/client/listers/core/v1alpha1"
 
@@ -201,17 +202,7 @@ func (c *Controller) process(ctx context.Context, key string) error {
 	if err != nil {
 		if kerrors.IsNotFound(err) {
 			logger.V(2).Info("LogicalCluster not found - stopping garbage collector controller for it (if needed)")
-
-			c.lock.Lock()
-			cancel, ok := c.cancelFuncs[clusterName]
-			if ok {
-				cancel()
-				delete(c.cancelFuncs, clusterName)
-			}
-			c.lock.Unlock()
-
-			c.dynamicDiscoverySharedInformerFactory.Unsubscribe("gc-" + clusterName.String())
-
+			c.stopGarbageCollectorForLogicalCluster(clusterName)
 			return nil
 		}
 
@@ -219,6 +210,12 @@ func (c *Controller) process(ctx context.Context, key string) error {
 	}
 	logger = logging.WithObject(logger, ws)
 
+	if ws.Status.Phase == corev1alpha1.LogicalClusterPhaseHibernated {
+		logger.V(2).Info("LogicalCluster is hibernated - stopping garbage collector controller for it (if needed)")
+		c.stopGarbageCollectorForLogicalCluster(clusterName)
+		return nil
+	}
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
@@ -243,6 +240,19 @@ func (c *Controller) process(ctx context.Context, key string) error {
 	return nil
 }
 
+// stopGarbageCollectorForLogicalCluster stops the garbage collector controller of the given logical cluster, if it is running.
+func (c *Controller) stopGarbageCollectorForLogicalCluster(clusterName logicalcluster.Name) {
+	c.lock.Lock()
+	cancel, ok := c.cancelFuncs[clusterName]
+	if ok {
+		cancel()
+		delete(c.cancelFuncs, clusterName)
+	}
+	c.lock.Unlock()
+
+	c.dynamicDiscoverySharedInformerFactory.Unsubscribe("gc-" + clusterName.String())
+}
+
 func (c *Controller) startGarbageCollectorForLogicalCluster(ctx context.Context, clusterName logicalcluster.Name) error {
 	logger := klog.FromContext(ctx)
 
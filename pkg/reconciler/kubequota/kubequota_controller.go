@@ -23,8 +23,10 @@ import (
 	"time"
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
+	quota "k8s.io/apiserver/pkg/quota/v1"
 	"k8s.io/apiserver/pkg/quota/v1/generic"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
@@ -38,9 +40,13 @@ import (
 	kcpcorev1informers "github.com/kcp-dev/client-go/informers/core/v1"
 	kcpkubernetesclientset "github.com/kcp-dev/client-go/kubernetes"
 	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
 	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
 	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
 
+	"github.com/kcp-dev/kcp/pkg/indexers"
 	"github.com/kcp-dev/kcp/pkg/informer"
 	"github.com/kcp-dev/kcp/pkg/logging"
 )
@@ -77,12 +83,16 @@ type Controller struct {
 	scopingGenericSharedInformerFactory scopeableInformerFactory
 
 	// For better testability
-	getLogicalCluster func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error)
+	getLogicalCluster     func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error)
+	updateLogicalCluster  func(ctx context.Context, logicalCluster *corev1alpha1.LogicalCluster) (*corev1alpha1.LogicalCluster, error)
+	countNestedWorkspaces func(path logicalcluster.Path) (int, error)
 }
 
 // NewController creates a new Controller.
 func NewController(
 	logicalClusterInformer corev1alpha1informers.LogicalClusterClusterInformer,
+	globalLogicalClusterInformer corev1alpha1informers.LogicalClusterClusterInformer,
+	kcpClusterClient kcpclientset.ClusterInterface,
 	kubeClusterClient kcpkubernetesclientset.ClusterInterface,
 	kubeInformerFactory kcpkubernetesinformers.SharedInformerFactory,
 	dynamicDiscoverySharedInformerFactory *informer.DiscoveringDynamicSharedInformerFactory,
@@ -116,8 +126,19 @@ func NewController(
 		getLogicalCluster: func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error) {
 			return logicalClusterInformer.Lister().Cluster(clusterName).Get(corev1alpha1.LogicalClusterName)
 		},
+		updateLogicalCluster: func(ctx context.Context, logicalCluster *corev1alpha1.LogicalCluster) (*corev1alpha1.LogicalCluster, error) {
+			return kcpClusterClient.Cluster(logicalcluster.From(logicalCluster).Path()).CoreV1alpha1().LogicalClusters().Update(ctx, logicalCluster, metav1.UpdateOptions{})
+		},
+		countNestedWorkspaces: func(path logicalcluster.Path) (int, error) {
+			nested, err := globalLogicalClusterInformer.Informer().GetIndexer().ByIndex(indexers.ByLogicalClusterAncestorPath, path.String())
+			return len(nested), err
+		},
 	}
 
+	indexers.AddIfNotPresentOrDie(globalLogicalClusterInformer.Informer().GetIndexer(), cache.Indexers{
+		indexers.ByLogicalClusterAncestorPath: indexers.IndexByLogicalClusterAncestorPath,
+	})
+
 	_, _ = logicalClusterInformer.Informer().AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: c.enqueue,
@@ -128,9 +149,39 @@ func NewController(
 		},
 	)
 
+	// record the nested workspace limits on the LogicalCluster when its ResourceQuotas change
+	_, _ = c.resourceQuotaClusterInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: c.enqueueResourceQuota,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				c.enqueueResourceQuota(newObj)
+			},
+			DeleteFunc: c.enqueueResourceQuota,
+		},
+	)
+
 	return c, nil
 }
 
+// enqueueResourceQuota adds the key for the LogicalCluster of a ResourceQuota to the queue.
+func (c *Controller) enqueueResourceQuota(obj interface{}) {
+	key, err := kcpcache.DeletionHandlingMetaClusterNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	clusterName, _, _, err := kcpcache.SplitMetaClusterNamespaceKey(key)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+
+	key = kcpcache.ToClusterAwareKey(clusterName.String(), "", corev1alpha1.LogicalClusterName)
+	logger := logging.WithQueueKey(logging.WithReconciler(klog.Background(), ControllerName), key)
+	logger.V(4).Info("queueing Workspace because of ResourceQuota change")
+	c.queue.Add(key)
+}
+
 // enqueue adds the key for a Workspace to the queue.
 func (c *Controller) enqueue(obj interface{}) {
 	key, err := kcpcache.DeletionHandlingMetaClusterNamespaceKeyFunc(obj)
@@ -211,17 +262,7 @@ func (c *Controller) process(ctx context.Context, key string) error {
 	if err != nil {
 		if kerrors.IsNotFound(err) {
 			logger.V(2).Info("Workspace not found - stopping quota controller for it (if needed)")
-
-			c.lock.Lock()
-			cancel, ok := c.cancelFuncs[clusterName]
-			if ok {
-				cancel()
-				delete(c.cancelFuncs, clusterName)
-			}
-			c.lock.Unlock()
-
-			c.dynamicDiscoverySharedInformerFactory.Unsubscribe("quota-" + clusterName.String())
-
+			c.stopQuotaForLogicalCluster(clusterName)
 			return nil
 		}
 
@@ -229,6 +270,18 @@ func (c *Controller) process(ctx context.Context, key string) error {
 	}
 	logger = logging.WithObject(logger, ws)
 
+	if ws.DeletionTimestamp.IsZero() {
+		if err := c.updateWorkspaceQuota(ctx, ws); err != nil {
+			return err
+		}
+	}
+
+	if ws.Status.Phase == corev1alpha1.LogicalClusterPhaseHibernated {
+		logger.V(2).Info("LogicalCluster is hibernated - stopping quota controller for it (if needed)")
+		c.stopQuotaForLogicalCluster(clusterName)
+		return nil
+	}
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
@@ -252,6 +305,38 @@ func (c *Controller) process(ctx context.Context, key string) error {
 	return nil
 }
 
+// stopQuotaForLogicalCluster stops the quota controller of the given logical cluster, if it is running.
+func (c *Controller) stopQuotaForLogicalCluster(clusterName logicalcluster.Name) {
+	c.lock.Lock()
+	cancel, ok := c.cancelFuncs[clusterName]
+	if ok {
+		cancel()
+		delete(c.cancelFuncs, clusterName)
+	}
+	c.lock.Unlock()
+
+	c.dynamicDiscoverySharedInformerFactory.Unsubscribe("quota-" + clusterName.String())
+}
+
+// newWorkspaceEvaluator returns the Workspace evaluator of the given logical cluster. Workspaces nested
+// below its child workspaces are counted through the global LogicalCluster informer, i.e. changes deeper
+// in the hierarchy are only reflected in the quota usage with the next full recalculation.
+func (c *Controller) newWorkspaceEvaluator(ctx context.Context, clusterName logicalcluster.Name) quota.Evaluator {
+	listerFunc := generic.ListerFuncForResourceFunc(c.scopingGenericSharedInformerFactory.ClusterWithContext(ctx, clusterName).ForResource)
+	listResourceFunc := generic.ListResourceUsingListerFunc(listerFunc, tenancyv1alpha1.SchemeGroupVersion.WithResource("workspaces"))
+	return NewWorkspaceEvaluator(listResourceFunc, func(name string) (int, error) {
+		logicalCluster, err := c.getLogicalCluster(clusterName)
+		if err != nil {
+			return 0, err
+		}
+		path := clusterName.Path()
+		if value, found := logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey]; found {
+			path = logicalcluster.NewPath(value)
+		}
+		return c.countNestedWorkspaces(path.Join(name))
+	})
+}
+
 func (c *Controller) startQuotaForLogicalCluster(ctx context.Context, clusterName logicalcluster.Name) error {
 	logger := klog.FromContext(ctx)
 	resourceQuotaControllerClient := c.kubeClusterClient.Cluster(clusterName.Path())
@@ -260,7 +345,7 @@ func (c *Controller) startQuotaForLogicalCluster(ctx context.Context, clusterNam
 	// to get support for the special evaluators for pods/services/pvcs.
 	// listerFuncForResource := generic.ListerFuncForResourceFunc(scopedInformerFactory.ForResource)
 	// quotaConfiguration := install.NewQuotaConfigurationForControllers(listerFuncForResource)
-	quotaConfiguration := generic.NewConfiguration(nil, install.DefaultIgnoredResources())
+	quotaConfiguration := generic.NewConfiguration([]quota.Evaluator{c.newWorkspaceEvaluator(ctx, clusterName)}, install.DefaultIgnoredResources())
 
 	resourceQuotaControllerOptions := &resourcequota.ControllerOptions{
 		QuotaClient:           resourceQuotaControllerClient.CoreV1(),
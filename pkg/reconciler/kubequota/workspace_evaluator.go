@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubequota
+
+import (
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	quota "k8s.io/apiserver/pkg/quota/v1"
+	"k8s.io/apiserver/pkg/quota/v1/generic"
+
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+// NewWorkspaceEvaluator returns a quota evaluator for Workspaces. Next to the generic object count
+// count/workspaces.tenancy.kcp.io, it reports tenancy.kcp.io/nested-workspaces, which counts every
+// Workspace together with all workspaces nested below it.
+//
+// countNested returns the number of workspaces nested below the child workspace of the given name.
+// It can be nil for admission, where only new Workspaces are evaluated, which have nothing nested yet.
+// listFuncByNamespace can be nil for admission, too, but then UsageStats returns an error.
+func NewWorkspaceEvaluator(listFuncByNamespace generic.ListFuncByNamespace, countNested func(name string) (int, error)) quota.Evaluator {
+	return &workspaceEvaluator{
+		Evaluator:           generic.NewObjectCountEvaluator(tenancyv1alpha1.Resource("workspaces"), listFuncByNamespace, ""),
+		listFuncByNamespace: listFuncByNamespace,
+		countNested:         countNested,
+	}
+}
+
+var workspaceResourceNames = []corev1.ResourceName{
+	generic.ObjectCountQuotaResourceNameFor(tenancyv1alpha1.Resource("workspaces")),
+	tenancyv1alpha1.ResourceNestedWorkspaces,
+}
+
+type workspaceEvaluator struct {
+	quota.Evaluator
+
+	listFuncByNamespace generic.ListFuncByNamespace
+	countNested         func(name string) (int, error)
+}
+
+// Matches returns true if the evaluator matches the specified quota with the provided input item.
+func (e *workspaceEvaluator) Matches(resourceQuota *corev1.ResourceQuota, item runtime.Object) (bool, error) {
+	return generic.Matches(resourceQuota, item, e.MatchingResources, generic.MatchesNoScopeFunc)
+}
+
+// MatchingResources takes the input specified list of resources and returns the set of resources it matches.
+func (e *workspaceEvaluator) MatchingResources(input []corev1.ResourceName) []corev1.ResourceName {
+	return quota.Intersection(input, workspaceResourceNames)
+}
+
+// Usage returns the resource usage for the specified Workspace.
+func (e *workspaceEvaluator) Usage(item runtime.Object) (corev1.ResourceList, error) {
+	nested := int64(1)
+	if e.countNested != nil {
+		accessor, err := meta.Accessor(item)
+		if err != nil {
+			return nil, err
+		}
+		n, err := e.countNested(accessor.GetName())
+		if err != nil {
+			return nil, err
+		}
+		nested += int64(n)
+	}
+
+	return corev1.ResourceList{
+		generic.ObjectCountQuotaResourceNameFor(tenancyv1alpha1.Resource("workspaces")): *resource.NewQuantity(1, resource.DecimalSI),
+		tenancyv1alpha1.ResourceNestedWorkspaces:                                        *resource.NewQuantity(nested, resource.DecimalSI),
+	}, nil
+}
+
+// UsageStats calculates aggregate usage of all Workspaces.
+func (e *workspaceEvaluator) UsageStats(options quota.UsageStatsOptions) (quota.UsageStats, error) {
+	if e.listFuncByNamespace == nil {
+		return quota.UsageStats{}, errors.New("cannot calculate usage stats of workspaces without a list function")
+	}
+	return generic.CalculateUsageStats(options, e.listFuncByNamespace, generic.MatchesNoScopeFunc, e.Usage)
+}
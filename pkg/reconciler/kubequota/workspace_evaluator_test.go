@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubequota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	quota "k8s.io/apiserver/pkg/quota/v1"
+
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+func TestWorkspaceEvaluator(t *testing.T) {
+	ws := &tenancyv1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "team"}}
+
+	tests := map[string]struct {
+		countNested func(name string) (int, error)
+		want        corev1.ResourceList
+	}{
+		"admission": {
+			want: corev1.ResourceList{
+				"count/workspaces.tenancy.kcp.io":        resource.MustParse("1"),
+				tenancyv1alpha1.ResourceNestedWorkspaces: resource.MustParse("1"),
+			},
+		},
+		"with nested workspaces": {
+			countNested: func(name string) (int, error) {
+				require.Equal(t, "team", name)
+				return 4, nil
+			},
+			want: corev1.ResourceList{
+				"count/workspaces.tenancy.kcp.io":        resource.MustParse("1"),
+				tenancyv1alpha1.ResourceNestedWorkspaces: resource.MustParse("5"),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := NewWorkspaceEvaluator(nil, tc.countNested)
+			got, err := e.Usage(ws)
+			require.NoError(t, err)
+			require.Len(t, got, len(tc.want))
+			for k, v := range tc.want {
+				require.Zero(t, v.Cmp(got[k]), "unexpected usage of %s", k)
+			}
+
+			require.Equal(t, []corev1.ResourceName{tenancyv1alpha1.ResourceNestedWorkspaces}, e.MatchingResources([]corev1.ResourceName{tenancyv1alpha1.ResourceNestedWorkspaces, corev1.ResourcePods}))
+			require.Equal(t, tenancyv1alpha1.Resource("workspaces"), e.GroupResource())
+
+			_, err = e.UsageStats(quota.UsageStatsOptions{})
+			require.Error(t, err, "usage stats need a list function")
+		})
+	}
+}
+
+func TestWorkspaceEvaluatorUsageStats(t *testing.T) {
+	workspaces := []runtime.Object{
+		&tenancyv1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
+		&tenancyv1alpha1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: "b"}},
+	}
+	e := NewWorkspaceEvaluator(func(namespace string) ([]runtime.Object, error) {
+		return workspaces, nil
+	}, func(name string) (int, error) {
+		return map[string]int{"a": 2}[name], nil
+	})
+
+	stats, err := e.UsageStats(quota.UsageStatsOptions{
+		Resources: []corev1.ResourceName{tenancyv1alpha1.ResourceNestedWorkspaces},
+	})
+	require.NoError(t, err)
+	used := stats.Used[tenancyv1alpha1.ResourceNestedWorkspaces]
+	require.Equal(t, int64(4), used.Value())
+}
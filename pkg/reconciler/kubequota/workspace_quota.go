@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubequota
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+// WorkspaceQuota holds the nested workspace limits of one workspace, as recorded in the
+// tenancyv1alpha1.LogicalClusterWorkspaceQuotaAnnotationKey annotation of its LogicalCluster.
+type WorkspaceQuota struct {
+	// NestedWorkspaces is the maximum number of workspaces below the workspace.
+	NestedWorkspaces *int64 `json:"nestedWorkspaces,omitempty"`
+	// WorkspaceDepth is the maximum number of levels of workspaces below the workspace.
+	WorkspaceDepth *int64 `json:"workspaceDepth,omitempty"`
+}
+
+// WorkspaceQuotaFor returns the strictest nested workspace limits of the given ResourceQuotas,
+// or nil if none of them limits nested workspaces.
+func WorkspaceQuotaFor(resourceQuotas []*corev1.ResourceQuota) *WorkspaceQuota {
+	var q WorkspaceQuota
+	for _, rq := range resourceQuotas {
+		q.NestedWorkspaces = minLimit(q.NestedWorkspaces, rq.Spec.Hard, tenancyv1alpha1.ResourceNestedWorkspaces)
+		q.WorkspaceDepth = minLimit(q.WorkspaceDepth, rq.Spec.Hard, tenancyv1alpha1.ResourceWorkspaceDepth)
+	}
+	if q.NestedWorkspaces == nil && q.WorkspaceDepth == nil {
+		return nil
+	}
+	return &q
+}
+
+// WorkspaceQuotaFromLogicalCluster returns the nested workspace limits recorded on the given
+// LogicalCluster, or nil if there are none.
+func WorkspaceQuotaFromLogicalCluster(logicalCluster *corev1alpha1.LogicalCluster) (*WorkspaceQuota, error) {
+	value, found := logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterWorkspaceQuotaAnnotationKey]
+	if !found {
+		return nil, nil
+	}
+	var q WorkspaceQuota
+	if err := json.Unmarshal([]byte(value), &q); err != nil {
+		return nil, fmt.Errorf("failed to parse %s annotation: %w", tenancyv1alpha1.LogicalClusterWorkspaceQuotaAnnotationKey, err)
+	}
+	return &q, nil
+}
+
+// minLimit returns the smaller of current and the hard limit for name, if any.
+func minLimit(current *int64, hard corev1.ResourceList, name corev1.ResourceName) *int64 {
+	q, found := hard[name]
+	if !found {
+		return current
+	}
+	if limit := q.Value(); current == nil || limit < *current {
+		return &limit
+	}
+	return current
+}
+
+// workspaceQuotaAnnotationValue returns the value of the tenancyv1alpha1.LogicalClusterWorkspaceQuotaAnnotationKey
+// annotation for the given ResourceQuotas. It is empty if they do not limit nested workspaces.
+func workspaceQuotaAnnotationValue(resourceQuotas []*corev1.ResourceQuota) (string, error) {
+	q := WorkspaceQuotaFor(resourceQuotas)
+	if q == nil {
+		return "", nil
+	}
+	raw, err := json.Marshal(q)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+// updateWorkspaceQuota records the nested workspace limits of the ResourceQuotas of the logical
+// cluster on its LogicalCluster, where the admission of workspaces nested deeper finds them.
+func (c *Controller) updateWorkspaceQuota(ctx context.Context, logicalCluster *corev1alpha1.LogicalCluster) error {
+	clusterName := logicalcluster.From(logicalCluster)
+	resourceQuotas, err := c.resourceQuotaClusterInformer.Lister().Cluster(clusterName).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	value, err := workspaceQuotaAnnotationValue(resourceQuotas)
+	if err != nil {
+		return err
+	}
+
+	current, found := logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterWorkspaceQuotaAnnotationKey]
+	if found == (value != "") && current == value {
+		return nil
+	}
+
+	logicalCluster = logicalCluster.DeepCopy()
+	if value == "" {
+		delete(logicalCluster.Annotations, tenancyv1alpha1.LogicalClusterWorkspaceQuotaAnnotationKey)
+	} else {
+		if logicalCluster.Annotations == nil {
+			logicalCluster.Annotations = map[string]string{}
+		}
+		logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterWorkspaceQuotaAnnotationKey] = value
+	}
+
+	klog.FromContext(ctx).V(2).Info("updating workspace quota", "quota", value)
+	_, err = c.updateLogicalCluster(ctx, logicalCluster)
+	return err
+}
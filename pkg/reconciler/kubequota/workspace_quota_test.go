@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubequota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+func TestWorkspaceQuotaAnnotationValue(t *testing.T) {
+	tests := map[string]struct {
+		hard []corev1.ResourceList
+		want string
+	}{
+		"no quotas": {},
+		"no workspace limits": {
+			hard: []corev1.ResourceList{{corev1.ResourcePods: resource.MustParse("10")}},
+		},
+		"strictest limits": {
+			hard: []corev1.ResourceList{
+				{
+					tenancyv1alpha1.ResourceNestedWorkspaces: resource.MustParse("10"),
+					tenancyv1alpha1.ResourceWorkspaceDepth:   resource.MustParse("2"),
+				},
+				{
+					tenancyv1alpha1.ResourceNestedWorkspaces: resource.MustParse("5"),
+				},
+			},
+			want: `{"nestedWorkspaces":5,"workspaceDepth":2}`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var quotas []*corev1.ResourceQuota
+			for _, hard := range tc.hard {
+				quotas = append(quotas, &corev1.ResourceQuota{Spec: corev1.ResourceQuotaSpec{Hard: hard}})
+			}
+			got, err := workspaceQuotaAnnotationValue(quotas)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+
+			logicalCluster := &corev1alpha1.LogicalCluster{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{}}}
+			if got != "" {
+				logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterWorkspaceQuotaAnnotationKey] = got
+			}
+			q, err := WorkspaceQuotaFromLogicalCluster(logicalCluster)
+			require.NoError(t, err)
+			require.Equal(t, WorkspaceQuotaFor(quotas), q, "the annotation round-trips")
+		})
+	}
+}
+
+func TestWorkspaceQuotaFromLogicalCluster(t *testing.T) {
+	q, err := WorkspaceQuotaFromLogicalCluster(&corev1alpha1.LogicalCluster{ObjectMeta: metav1.ObjectMeta{
+		Annotations: map[string]string{tenancyv1alpha1.LogicalClusterWorkspaceQuotaAnnotationKey: `{"workspaceDepth":3}`},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, &WorkspaceQuota{WorkspaceDepth: ptr.To[int64](3)}, q)
+
+	_, err = WorkspaceQuotaFromLogicalCluster(&corev1alpha1.LogicalCluster{ObjectMeta: metav1.ObjectMeta{
+		Annotations: map[string]string{tenancyv1alpha1.LogicalClusterWorkspaceQuotaAnnotationKey: `[`},
+	}})
+	require.Error(t, err)
+}
@@ -0,0 +1,339 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultresources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	kcpcache "github.com/kcp-dev/apimachinery/v2/pkg/cache"
+	kcpcorev1informers "github.com/kcp-dev/client-go/informers/core/v1"
+	kcpkubernetesclientset "github.com/kcp-dev/client-go/kubernetes"
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+	corev1alpha1client "github.com/kcp-dev/sdk/client/clientset/versioned/typed/core/v1alpha1"
+	corev1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/core/v1alpha1"
+	tenancyv1alpha1informers "github.com/kcp-dev/sdk/client/informers/externalversions/tenancy/v1alpha1"
+
+	admission "github.com/kcp-dev/kcp/pkg/admission/workspacetypeexists"
+	"github.com/kcp-dev/kcp/pkg/indexers"
+	"github.com/kcp-dev/kcp/pkg/logging"
+	"github.com/kcp-dev/kcp/pkg/reconciler/committer"
+	"github.com/kcp-dev/kcp/pkg/reconciler/events"
+)
+
+const (
+	ControllerName = "kcp-default-resources"
+)
+
+// NewController returns a new controller which creates the defaultResourceQuotas and defaultLimitRanges
+// of WorkspaceTypes in new workspaces, and keeps them up to date if the lifecycle is Maintain.
+func NewController(
+	kcpClusterClient kcpclientset.ClusterInterface,
+	kubeClusterClient kcpkubernetesclientset.ClusterInterface,
+	logicalClusterInformer corev1alpha1informers.LogicalClusterClusterInformer,
+	workspaceTypeInformer, globalWorkspaceTypeInformer tenancyv1alpha1informers.WorkspaceTypeClusterInformer,
+	namespaceInformer kcpcorev1informers.NamespaceClusterInformer,
+	resourceQuotaInformer kcpcorev1informers.ResourceQuotaClusterInformer,
+	limitRangeInformer kcpcorev1informers.LimitRangeClusterInformer,
+) (*Controller, error) {
+	c := &Controller{
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: ControllerName,
+			},
+		),
+
+		getLogicalCluster: func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error) {
+			return logicalClusterInformer.Lister().Cluster(clusterName).Get(corev1alpha1.LogicalClusterName)
+		},
+		listLogicalClusters: func() ([]*corev1alpha1.LogicalCluster, error) {
+			return logicalClusterInformer.Lister().List(labels.Everything())
+		},
+		getWorkspaceType: func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
+			return indexers.ByPathAndNameWithFallback[*tenancyv1alpha1.WorkspaceType](tenancyv1alpha1.Resource("workspacetypes"), workspaceTypeInformer.Informer().GetIndexer(), globalWorkspaceTypeInformer.Informer().GetIndexer(), path, name)
+		},
+
+		getNamespace: func(clusterName logicalcluster.Name, name string) (*corev1.Namespace, error) {
+			return namespaceInformer.Lister().Cluster(clusterName).Get(name)
+		},
+		createNamespace: func(ctx context.Context, clusterName logicalcluster.Name, ns *corev1.Namespace) error {
+			_, err := kubeClusterClient.Cluster(clusterName.Path()).CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{})
+			return err
+		},
+
+		getResourceQuota: func(clusterName logicalcluster.Name, namespace, name string) (*corev1.ResourceQuota, error) {
+			return resourceQuotaInformer.Lister().Cluster(clusterName).ResourceQuotas(namespace).Get(name)
+		},
+		createResourceQuota: func(ctx context.Context, clusterName logicalcluster.Name, quota *corev1.ResourceQuota) error {
+			_, err := kubeClusterClient.Cluster(clusterName.Path()).CoreV1().ResourceQuotas(quota.Namespace).Create(ctx, quota, metav1.CreateOptions{})
+			return err
+		},
+		updateResourceQuota: func(ctx context.Context, clusterName logicalcluster.Name, quota *corev1.ResourceQuota) error {
+			_, err := kubeClusterClient.Cluster(clusterName.Path()).CoreV1().ResourceQuotas(quota.Namespace).Update(ctx, quota, metav1.UpdateOptions{})
+			return err
+		},
+
+		getLimitRange: func(clusterName logicalcluster.Name, namespace, name string) (*corev1.LimitRange, error) {
+			return limitRangeInformer.Lister().Cluster(clusterName).LimitRanges(namespace).Get(name)
+		},
+		createLimitRange: func(ctx context.Context, clusterName logicalcluster.Name, limitRange *corev1.LimitRange) error {
+			_, err := kubeClusterClient.Cluster(clusterName.Path()).CoreV1().LimitRanges(limitRange.Namespace).Create(ctx, limitRange, metav1.CreateOptions{})
+			return err
+		},
+		updateLimitRange: func(ctx context.Context, clusterName logicalcluster.Name, limitRange *corev1.LimitRange) error {
+			_, err := kubeClusterClient.Cluster(clusterName.Path()).CoreV1().LimitRanges(limitRange.Namespace).Update(ctx, limitRange, metav1.UpdateOptions{})
+			return err
+		},
+
+		commit: committer.NewCommitter[*corev1alpha1.LogicalCluster, corev1alpha1client.LogicalClusterInterface, *corev1alpha1.LogicalClusterSpec, *corev1alpha1.LogicalClusterStatus](kcpClusterClient.CoreV1alpha1().LogicalClusters()),
+	}
+
+	c.transitiveTypeResolver = admission.NewTransitiveTypeResolver(c.getWorkspaceType)
+
+	logger := logging.WithReconciler(klog.Background(), ControllerName)
+
+	_, _ = logicalClusterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { c.enqueueLogicalCluster(obj, logger, "") },
+		UpdateFunc: func(_, obj interface{}) { c.enqueueLogicalCluster(obj, logger, "") },
+	})
+
+	// needed to roll out changes of the templates and to pick up fixed WorkspaceTypes
+	_, _ = workspaceTypeInformer.Informer().AddEventHandler(events.WithoutSyncs(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { c.enqueueWorkspaceTypes(obj, logger) },
+		UpdateFunc: func(_, obj interface{}) { c.enqueueWorkspaceTypes(obj, logger) },
+	}))
+	_, _ = globalWorkspaceTypeInformer.Informer().AddEventHandler(events.WithoutSyncs(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { c.enqueueWorkspaceTypes(obj, logger) },
+		UpdateFunc: func(_, obj interface{}) { c.enqueueWorkspaceTypes(obj, logger) },
+	}))
+
+	// needed to restore modified or deleted objects with the Maintain lifecycle
+	_, _ = resourceQuotaInformer.Informer().AddEventHandler(events.WithoutSyncs(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(_, obj interface{}) { c.enqueueOwningLogicalCluster(obj, logger, "ResourceQuota") },
+		DeleteFunc: func(obj interface{}) { c.enqueueOwningLogicalCluster(obj, logger, "ResourceQuota") },
+	}))
+	_, _ = limitRangeInformer.Informer().AddEventHandler(events.WithoutSyncs(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(_, obj interface{}) { c.enqueueOwningLogicalCluster(obj, logger, "LimitRange") },
+		DeleteFunc: func(obj interface{}) { c.enqueueOwningLogicalCluster(obj, logger, "LimitRange") },
+	}))
+
+	return c, nil
+}
+
+type logicalClusterResource = committer.Resource[*corev1alpha1.LogicalClusterSpec, *corev1alpha1.LogicalClusterStatus]
+
+// Controller creates the defaultResourceQuotas and defaultLimitRanges of WorkspaceTypes in
+// new workspaces, and keeps them up to date if the lifecycle is Maintain.
+type Controller struct {
+	queue workqueue.TypedRateLimitingInterface[string]
+
+	getLogicalCluster   func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error)
+	listLogicalClusters func() ([]*corev1alpha1.LogicalCluster, error)
+	getWorkspaceType    func(clusterName logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error)
+
+	getNamespace    func(clusterName logicalcluster.Name, name string) (*corev1.Namespace, error)
+	createNamespace func(ctx context.Context, clusterName logicalcluster.Name, ns *corev1.Namespace) error
+
+	getResourceQuota    func(clusterName logicalcluster.Name, namespace, name string) (*corev1.ResourceQuota, error)
+	createResourceQuota func(ctx context.Context, clusterName logicalcluster.Name, quota *corev1.ResourceQuota) error
+	updateResourceQuota func(ctx context.Context, clusterName logicalcluster.Name, quota *corev1.ResourceQuota) error
+
+	getLimitRange    func(clusterName logicalcluster.Name, namespace, name string) (*corev1.LimitRange, error)
+	createLimitRange func(ctx context.Context, clusterName logicalcluster.Name, limitRange *corev1.LimitRange) error
+	updateLimitRange func(ctx context.Context, clusterName logicalcluster.Name, limitRange *corev1.LimitRange) error
+
+	transitiveTypeResolver transitiveTypeResolver
+
+	// commit creates a patch and submits it, if needed.
+	commit func(ctx context.Context, old, new *logicalClusterResource) error
+}
+
+type transitiveTypeResolver interface {
+	Resolve(t *tenancyv1alpha1.WorkspaceType) ([]*tenancyv1alpha1.WorkspaceType, error)
+}
+
+func (c *Controller) enqueueLogicalCluster(obj interface{}, logger logr.Logger, logSuffix string) {
+	key, err := kcpcache.DeletionHandlingMetaClusterNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+
+	logging.WithQueueKey(logger, key).V(4).Info(fmt.Sprintf("queueing LogicalCluster%s", logSuffix))
+	c.queue.Add(key)
+}
+
+// enqueueWorkspaceTypes enqueues all logical clusters of this shard when a WorkspaceType
+// with default resources changes.
+func (c *Controller) enqueueWorkspaceTypes(obj interface{}, logger logr.Logger) {
+	wt, ok := obj.(*tenancyv1alpha1.WorkspaceType)
+	if !ok {
+		utilruntime.HandleError(fmt.Errorf("obj is supposed to be a WorkspaceType, but is %T", obj))
+		return
+	}
+
+	if len(wt.Spec.DefaultResourceQuotas) == 0 && len(wt.Spec.DefaultLimitRanges) == 0 {
+		return
+	}
+
+	list, err := c.listLogicalClusters()
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("error listing logical clusters: %w", err))
+	}
+
+	for _, logicalCluster := range list {
+		logger := logging.WithObject(logger, logicalCluster)
+		c.enqueueLogicalCluster(logicalCluster, logger, " because of WorkspaceType")
+	}
+}
+
+// enqueueOwningLogicalCluster enqueues the logical cluster of a ResourceQuota or LimitRange.
+func (c *Controller) enqueueOwningLogicalCluster(obj interface{}, logger logr.Logger, kind string) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	metaObj, ok := obj.(metav1.Object)
+	if !ok {
+		utilruntime.HandleError(fmt.Errorf("obj is supposed to be a metav1.Object, but is %T", obj))
+		return
+	}
+
+	logicalCluster, err := c.getLogicalCluster(logicalcluster.From(metaObj))
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			utilruntime.HandleError(err)
+		}
+		return
+	}
+	if logicalCluster.Status.Phase != corev1alpha1.LogicalClusterPhaseReady {
+		return
+	}
+
+	c.enqueueLogicalCluster(logicalCluster, logger, " because of "+kind)
+}
+
+func (c *Controller) startWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+func (c *Controller) Start(ctx context.Context, numThreads int) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+	logger := logging.WithReconciler(klog.FromContext(ctx), ControllerName)
+	ctx = klog.NewContext(ctx, logger)
+
+	logger.Info("Starting controller")
+	defer logger.Info("Shutting down controller")
+
+	for range numThreads {
+		go wait.UntilWithContext(ctx, c.startWorker, time.Second)
+	}
+	<-ctx.Done()
+}
+
+func (c *Controller) processNextWorkItem(ctx context.Context) bool {
+	// Wait until there is a new item in the working queue
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+
+	logger := logging.WithQueueKey(klog.FromContext(ctx), key)
+	ctx = klog.NewContext(ctx, logger)
+	logger.V(4).Info("processing key")
+
+	// No matter what, tell the queue we're done with this key, to unblock
+	// other workers.
+	defer c.queue.Done(key)
+
+	if err := c.process(ctx, key); err != nil {
+		utilruntime.HandleError(fmt.Errorf("%s: failed to sync %q, err: %w", ControllerName, key, err))
+		c.queue.AddRateLimited(key)
+		return true
+	}
+
+	c.queue.Forget(key)
+	return true
+}
+
+func (c *Controller) process(ctx context.Context, key string) error {
+	logger := klog.FromContext(ctx)
+
+	clusterName, _, _, err := kcpcache.SplitMetaClusterNamespaceKey(key)
+	if err != nil {
+		logger.Error(err, "unable to decode key")
+		return nil
+	}
+
+	logicalCluster, err := c.getLogicalCluster(clusterName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			logger.Error(err, "failed to get LogicalCluster from lister", "cluster", clusterName)
+		}
+
+		return nil // nothing we can do here
+	}
+
+	old := logicalCluster
+	logicalCluster = logicalCluster.DeepCopy()
+
+	logger = logging.WithObject(logger, logicalCluster)
+	ctx = klog.NewContext(ctx, logger)
+
+	var errs []error
+	if err := c.reconcile(ctx, logicalCluster); err != nil {
+		errs = append(errs, err)
+	}
+
+	// If the object being reconciled changed as a result, update it.
+	oldResource := &logicalClusterResource{ObjectMeta: old.ObjectMeta, Spec: &old.Spec, Status: &old.Status}
+	newResource := &logicalClusterResource{ObjectMeta: logicalCluster.ObjectMeta, Spec: &logicalCluster.Spec, Status: &logicalCluster.Status}
+	if err := c.commit(ctx, oldResource, newResource); err != nil {
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// InstallIndexers adds the additional indexers that this controller requires to the informers.
+func InstallIndexers(workspaceTypeInformer, globalWorkspaceTypeInformer tenancyv1alpha1informers.WorkspaceTypeClusterInformer) {
+	indexers.AddIfNotPresentOrDie(workspaceTypeInformer.Informer().GetIndexer(), cache.Indexers{
+		indexers.ByLogicalClusterPathAndName: indexers.IndexByLogicalClusterPathAndName,
+	})
+	indexers.AddIfNotPresentOrDie(globalWorkspaceTypeInformer.Informer().GetIndexer(), cache.Indexers{
+		indexers.ByLogicalClusterPathAndName: indexers.IndexByLogicalClusterPathAndName,
+	})
+}
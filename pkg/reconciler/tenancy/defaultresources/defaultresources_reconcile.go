@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultresources
+
+import (
+	"context"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/tenancy/initialization"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+
+	"github.com/kcp-dev/kcp/pkg/logging"
+)
+
+func (c *Controller) reconcile(ctx context.Context, logicalCluster *corev1alpha1.LogicalCluster) error {
+	initializing := logicalCluster.Status.Phase == corev1alpha1.LogicalClusterPhaseInitializing &&
+		initialization.InitializerPresent(tenancyv1alpha1.WorkspaceDefaultResourcesInitializer, logicalCluster.Status.Initializers)
+	if !initializing && logicalCluster.Status.Phase != corev1alpha1.LogicalClusterPhaseReady {
+		return nil
+	}
+
+	annotationValue, found := logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterTypeAnnotationKey]
+	if !found {
+		return nil
+	}
+	wtCluster, wtName := logicalcluster.NewPath(annotationValue).Split()
+	if wtCluster.Empty() {
+		return nil
+	}
+	logger := klog.FromContext(ctx).WithValues(
+		"workspacetype.path", wtCluster.String(),
+		"workspacetype.name", wtName,
+	)
+
+	// Start with the WorkspaceType specified by the Workspace
+	leafWT, err := c.getWorkspaceType(wtCluster, wtName)
+	if err != nil {
+		logger.Error(err, "error getting WorkspaceType")
+		return nil
+	}
+
+	// Get all the transitive WorkspaceTypes
+	wts, err := c.transitiveTypeResolver.Resolve(leafWT)
+	if err != nil {
+		logger.Error(err, "error resolving transitive types")
+		return nil
+	}
+
+	var errs []error
+	clusterName := logicalcluster.From(logicalCluster)
+	ensuredNamespaces := sets.New[string]()
+	for _, wt := range wts {
+		maintain := ptr.Deref(wt.Spec.DefaultResourcesLifecycle, tenancyv1alpha1.APIBindingLifecycleModeInitializeOnly) == tenancyv1alpha1.APIBindingLifecycleModeMaintain
+		if !initializing && !maintain {
+			continue
+		}
+
+		logger := logging.WithObject(logger, wt)
+		ctx := klog.NewContext(ctx, logger)
+
+		for _, template := range wt.Spec.DefaultResourceQuotas {
+			namespace := namespaceOrDefault(template.Namespace)
+			if err := c.ensureNamespace(ctx, clusterName, namespace, ensuredNamespaces); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if err := c.ensureResourceQuota(ctx, clusterName, namespace, template, maintain); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		for _, template := range wt.Spec.DefaultLimitRanges {
+			namespace := namespaceOrDefault(template.Namespace)
+			if err := c.ensureNamespace(ctx, clusterName, namespace, ensuredNamespaces); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if err := c.ensureLimitRange(ctx, clusterName, namespace, template, maintain); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return utilerrors.NewAggregate(errs)
+	}
+
+	if initializing {
+		logger.V(2).Info("default resources created, removing initializer")
+		logicalCluster.Status.Initializers = initialization.EnsureInitializerAbsent(tenancyv1alpha1.WorkspaceDefaultResourcesInitializer, logicalCluster.Status.Initializers)
+	}
+
+	return nil
+}
+
+// namespaceOrDefault returns the namespace of a template, defaulting to "default" for
+// WorkspaceTypes that were stored before the field was defaulted.
+func namespaceOrDefault(namespace string) string {
+	if namespace == "" {
+		return metav1.NamespaceDefault
+	}
+	return namespace
+}
+
+func (c *Controller) ensureNamespace(ctx context.Context, clusterName logicalcluster.Name, name string, ensured sets.Set[string]) error {
+	if ensured.Has(name) {
+		return nil
+	}
+	if _, err := c.getNamespace(clusterName, name); err == nil {
+		ensured.Insert(name)
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	klog.FromContext(ctx).V(2).Info("creating Namespace", "namespace", name)
+	if err := c.createNamespace(ctx, clusterName, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	ensured.Insert(name)
+	return nil
+}
+
+// ensureResourceQuota creates the ResourceQuota of the template if it is missing. If maintain is true,
+// an existing ResourceQuota is updated to match the template.
+func (c *Controller) ensureResourceQuota(ctx context.Context, clusterName logicalcluster.Name, namespace string, template tenancyv1alpha1.ResourceQuotaTemplate, maintain bool) error {
+	logger := klog.FromContext(ctx).WithValues("resourcequota.namespace", namespace, "resourcequota.name", template.Name)
+
+	existing, err := c.getResourceQuota(clusterName, namespace, template.Name)
+	if apierrors.IsNotFound(err) {
+		logger.V(2).Info("creating ResourceQuota")
+		quota := &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        template.Name,
+				Namespace:   namespace,
+				Annotations: maps.Clone(template.Annotations),
+			},
+			Spec: *template.Spec.DeepCopy(),
+		}
+		if err := c.createResourceQuota(ctx, clusterName, quota); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if !maintain || (equality.Semantic.DeepEqual(existing.Spec, template.Spec) && hasAnnotations(existing.Annotations, template.Annotations)) {
+		return nil
+	}
+
+	logger.V(2).Info("updating ResourceQuota")
+	quota := existing.DeepCopy()
+	quota.Spec = *template.Spec.DeepCopy()
+	if len(template.Annotations) > 0 && quota.Annotations == nil {
+		quota.Annotations = map[string]string{}
+	}
+	maps.Copy(quota.Annotations, template.Annotations)
+	return c.updateResourceQuota(ctx, clusterName, quota)
+}
+
+// ensureLimitRange creates the LimitRange of the template if it is missing. If maintain is true,
+// an existing LimitRange is updated to match the template.
+func (c *Controller) ensureLimitRange(ctx context.Context, clusterName logicalcluster.Name, namespace string, template tenancyv1alpha1.LimitRangeTemplate, maintain bool) error {
+	logger := klog.FromContext(ctx).WithValues("limitrange.namespace", namespace, "limitrange.name", template.Name)
+
+	existing, err := c.getLimitRange(clusterName, namespace, template.Name)
+	if apierrors.IsNotFound(err) {
+		logger.V(2).Info("creating LimitRange")
+		limitRange := &corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      template.Name,
+				Namespace: namespace,
+			},
+			Spec: *template.Spec.DeepCopy(),
+		}
+		if err := c.createLimitRange(ctx, clusterName, limitRange); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if !maintain || equality.Semantic.DeepEqual(existing.Spec, template.Spec) {
+		return nil
+	}
+
+	logger.V(2).Info("updating LimitRange")
+	limitRange := existing.DeepCopy()
+	limitRange.Spec = *template.Spec.DeepCopy()
+	return c.updateLimitRange(ctx, clusterName, limitRange)
+}
+
+// hasAnnotations returns whether annotations contains all of the wanted annotations.
+func hasAnnotations(annotations, wanted map[string]string) bool {
+	for k, v := range wanted {
+		if got, ok := annotations[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultresources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+func TestReconcile(t *testing.T) {
+	quotaSpec := corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{corev1.ResourceConfigMaps: resource.MustParse("10")}}
+	oldQuotaSpec := corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{corev1.ResourceConfigMaps: resource.MustParse("100")}}
+	limitRangeSpec := corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{
+		Type:    corev1.LimitTypeContainer,
+		Default: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+	}}}
+
+	tests := map[string]struct {
+		phase       corev1alpha1.LogicalClusterPhaseType
+		initializer bool
+		lifecycle   *tenancyv1alpha1.APIBindingLifecycleMode
+		namespaces  []string
+		quotas      []*corev1.ResourceQuota
+
+		wantNamespaces  []string
+		wantQuotas      []string
+		wantQuotaUpdate bool
+		wantLimitRanges []string
+		wantInitialized bool
+	}{
+		"initializing creates objects and namespaces": {
+			phase:           corev1alpha1.LogicalClusterPhaseInitializing,
+			initializer:     true,
+			namespaces:      []string{"default"},
+			wantNamespaces:  []string{"admin"},
+			wantQuotas:      []string{"default/compute", "admin/cluster"},
+			wantLimitRanges: []string{"default/defaults"},
+			wantInitialized: true,
+		},
+		"initializing keeps existing objects": {
+			phase:           corev1alpha1.LogicalClusterPhaseInitializing,
+			initializer:     true,
+			namespaces:      []string{"default", "admin"},
+			quotas:          []*corev1.ResourceQuota{quota("default", "compute", oldQuotaSpec), quota("admin", "cluster", quotaSpec)},
+			wantLimitRanges: []string{"default/defaults"},
+			wantInitialized: true,
+		},
+		"initializing without our initializer is left alone": {
+			phase: corev1alpha1.LogicalClusterPhaseInitializing,
+		},
+		"ready with InitializeOnly is left alone": {
+			phase: corev1alpha1.LogicalClusterPhaseReady,
+		},
+		"ready with Maintain restores and updates objects": {
+			phase:           corev1alpha1.LogicalClusterPhaseReady,
+			lifecycle:       ptr.To(tenancyv1alpha1.APIBindingLifecycleModeMaintain),
+			namespaces:      []string{"default", "admin"},
+			quotas:          []*corev1.ResourceQuota{quota("default", "compute", oldQuotaSpec)},
+			wantQuotas:      []string{"admin/cluster"},
+			wantQuotaUpdate: true,
+			wantLimitRanges: []string{"default/defaults"},
+		},
+		"hibernated with Maintain is left alone": {
+			phase:     corev1alpha1.LogicalClusterPhaseHibernated,
+			lifecycle: ptr.To(tenancyv1alpha1.APIBindingLifecycleModeMaintain),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			wt := &tenancyv1alpha1.WorkspaceType{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "team",
+					Annotations: map[string]string{logicalcluster.AnnotationKey: "root:org"},
+				},
+				Spec: tenancyv1alpha1.WorkspaceTypeSpec{
+					DefaultResourceQuotas: []tenancyv1alpha1.ResourceQuotaTemplate{
+						{Name: "compute", Spec: quotaSpec},
+						{Name: "cluster", Namespace: "admin", Annotations: map[string]string{"experimental.quota.kcp.io/cluster-scoped": "true"}, Spec: quotaSpec},
+					},
+					DefaultLimitRanges: []tenancyv1alpha1.LimitRangeTemplate{
+						{Name: "defaults", Namespace: "default", Spec: limitRangeSpec},
+					},
+					DefaultResourcesLifecycle: tc.lifecycle,
+				},
+			}
+
+			lc := &corev1alpha1.LogicalCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: corev1alpha1.LogicalClusterName,
+					Annotations: map[string]string{
+						logicalcluster.AnnotationKey:                    "cluster-1",
+						tenancyv1alpha1.LogicalClusterTypeAnnotationKey: "root:org:team",
+					},
+				},
+				Status: corev1alpha1.LogicalClusterStatus{Phase: tc.phase},
+			}
+			if tc.initializer {
+				lc.Status.Initializers = []corev1alpha1.LogicalClusterInitializer{"root:org:team", tenancyv1alpha1.WorkspaceDefaultResourcesInitializer}
+			}
+
+			var createdNamespaces, createdQuotas, createdLimitRanges []string
+			var updatedQuota bool
+			c := &Controller{
+				getWorkspaceType: func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
+					require.Equal(t, "root:org", path.String())
+					require.Equal(t, "team", name)
+					return wt, nil
+				},
+				transitiveTypeResolver: &fakeResolver{},
+				getNamespace: func(clusterName logicalcluster.Name, name string) (*corev1.Namespace, error) {
+					for _, ns := range tc.namespaces {
+						if ns == name {
+							return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}, nil
+						}
+					}
+					return nil, apierrors.NewNotFound(corev1.Resource("namespaces"), name)
+				},
+				createNamespace: func(ctx context.Context, clusterName logicalcluster.Name, ns *corev1.Namespace) error {
+					require.Equal(t, "cluster-1", clusterName.String())
+					createdNamespaces = append(createdNamespaces, ns.Name)
+					return nil
+				},
+				getResourceQuota: func(clusterName logicalcluster.Name, namespace, name string) (*corev1.ResourceQuota, error) {
+					for _, q := range tc.quotas {
+						if q.Namespace == namespace && q.Name == name {
+							return q, nil
+						}
+					}
+					return nil, apierrors.NewNotFound(corev1.Resource("resourcequotas"), name)
+				},
+				createResourceQuota: func(ctx context.Context, clusterName logicalcluster.Name, quota *corev1.ResourceQuota) error {
+					if quota.Namespace == "admin" {
+						require.Equal(t, "true", quota.Annotations["experimental.quota.kcp.io/cluster-scoped"])
+					}
+					createdQuotas = append(createdQuotas, quota.Namespace+"/"+quota.Name)
+					return nil
+				},
+				updateResourceQuota: func(ctx context.Context, clusterName logicalcluster.Name, quota *corev1.ResourceQuota) error {
+					require.Equal(t, quotaSpec, quota.Spec)
+					updatedQuota = true
+					return nil
+				},
+				getLimitRange: func(clusterName logicalcluster.Name, namespace, name string) (*corev1.LimitRange, error) {
+					return nil, apierrors.NewNotFound(corev1.Resource("limitranges"), name)
+				},
+				createLimitRange: func(ctx context.Context, clusterName logicalcluster.Name, limitRange *corev1.LimitRange) error {
+					createdLimitRanges = append(createdLimitRanges, limitRange.Namespace+"/"+limitRange.Name)
+					return nil
+				},
+				updateLimitRange: func(ctx context.Context, clusterName logicalcluster.Name, limitRange *corev1.LimitRange) error {
+					t.Fatal("unexpected LimitRange update")
+					return nil
+				},
+			}
+
+			err := c.reconcile(context.Background(), lc)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantNamespaces, createdNamespaces)
+			require.Equal(t, tc.wantQuotas, createdQuotas)
+			require.Equal(t, tc.wantQuotaUpdate, updatedQuota)
+			require.Equal(t, tc.wantLimitRanges, createdLimitRanges)
+			if tc.initializer {
+				require.Equal(t, tc.wantInitialized, !containsInitializer(lc.Status.Initializers))
+				require.Contains(t, lc.Status.Initializers, corev1alpha1.LogicalClusterInitializer("root:org:team"))
+			}
+		})
+	}
+}
+
+func quota(namespace, name string, spec corev1.ResourceQuotaSpec) *corev1.ResourceQuota {
+	return &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       spec,
+	}
+}
+
+func containsInitializer(initializers []corev1alpha1.LogicalClusterInitializer) bool {
+	for _, initializer := range initializers {
+		if initializer == tenancyv1alpha1.WorkspaceDefaultResourcesInitializer {
+			return true
+		}
+	}
+	return false
+}
+
+type fakeResolver struct{}
+
+func (r *fakeResolver) Resolve(t *tenancyv1alpha1.WorkspaceType) ([]*tenancyv1alpha1.WorkspaceType, error) {
+	return []*tenancyv1alpha1.WorkspaceType{t}, nil
+}
@@ -55,6 +55,11 @@ func NewController(
 		ControllerName,
 		tenancy.GroupName,
 		func(cluster *corev1alpha1.LogicalCluster) bool {
+			// If the logical cluster limits nested workspaces, the workspaces below have to see them.
+			if _, found := cluster.Annotations[tenancyv1alpha1.LogicalClusterWorkspaceQuotaAnnotationKey]; found {
+				return true
+			}
+
 			// If there are any WorkspaceTypes for this logical cluster, then the LogicalCluster object should be replicated.
 			keys, err := workspaceTypeIndexer.IndexKeys(kcpcache.ClusterIndexName, kcpcache.ClusterIndexKey(logicalcluster.From(cluster)))
 			if err != nil {
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -32,7 +33,11 @@ import (
 	"k8s.io/klog/v2"
 
 	kcpcache "github.com/kcp-dev/apimachinery/v2/pkg/cache"
+	kcpdynamic "github.com/kcp-dev/client-go/dynamic"
 	"github.com/kcp-dev/client-go/kubernetes"
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
 	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
 	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
 	tenancyv1alpha1client "github.com/kcp-dev/sdk/client/clientset/versioned/typed/tenancy/v1alpha1"
@@ -52,17 +57,19 @@ const (
 )
 
 type clientPool struct {
-	mu          sync.RWMutex
-	kcpClients  map[string]kcpclientset.ClusterInterface
-	kubeClients map[string]kubernetes.ClusterInterface
-	adminConfig *rest.Config
+	mu             sync.RWMutex
+	kcpClients     map[string]kcpclientset.ClusterInterface
+	kubeClients    map[string]kubernetes.ClusterInterface
+	dynamicClients map[string]kcpdynamic.ClusterInterface
+	adminConfig    *rest.Config
 }
 
 func newClientPool(adminConfig *rest.Config) *clientPool {
 	return &clientPool{
-		kcpClients:  make(map[string]kcpclientset.ClusterInterface),
-		kubeClients: make(map[string]kubernetes.ClusterInterface),
-		adminConfig: adminConfig,
+		kcpClients:     make(map[string]kcpclientset.ClusterInterface),
+		kubeClients:    make(map[string]kubernetes.ClusterInterface),
+		dynamicClients: make(map[string]kcpdynamic.ClusterInterface),
+		adminConfig:    adminConfig,
 	}
 }
 
@@ -122,6 +129,34 @@ func (p *clientPool) getKubeClient(shardName, baseURL string) (kubernetes.Cluste
 	return client, nil
 }
 
+// getDynamicClient returns a dynamic client (i.e. a client that implements kcpdynamic.ClusterInterface) for the given shard.
+// the returned client establishes a direct connection with the shard with credentials stored in adminConfig.
+// clients are cached per shard to prevent connection leaks.
+func (p *clientPool) getDynamicClient(shardName, baseURL string) (kcpdynamic.ClusterInterface, error) {
+	p.mu.RLock()
+	if client, exists := p.dynamicClients[shardName]; exists {
+		p.mu.RUnlock()
+		return client, nil
+	}
+	p.mu.RUnlock()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if client, exists := p.dynamicClients[shardName]; exists {
+		return client, nil
+	}
+
+	shardConfig := rest.CopyConfig(p.adminConfig)
+	shardConfig.Host = baseURL
+	client, err := kcpdynamic.NewForConfig(shardConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create shard %q dynamic client: %w", shardName, err)
+	}
+	p.dynamicClients[shardName] = client
+	return client, nil
+}
+
 func NewController(
 	shardName string,
 	kcpClusterClient kcpclientset.ClusterInterface,
@@ -176,6 +211,10 @@ func NewController(
 		DeleteFunc: func(obj interface{}) { c.enqueueShard(obj) },
 	}))
 
+	_, _ = logicalClusterInformer.Informer().AddEventHandler(events.WithoutSyncs(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, obj interface{}) { c.enqueueLogicalCluster(oldObj, obj) },
+	}))
+
 	return c, nil
 }
 
@@ -253,6 +292,55 @@ func (c *Controller) enqueueShard(obj interface{}) {
 			logging.WithQueueKey(logger, key).V(3).Info("queueing unschedulable Workspace because of shard update", "shard", shard)
 			c.queue.Add(key)
 		}
+
+		if shard.Spec.Drain {
+			workspaces, err := c.workspaceIndexer.ByIndex(byShard, ByBase36Sha224NameValue(shard.Name))
+			if err != nil {
+				utilruntime.HandleError(err)
+				return
+			}
+			for _, workspace := range workspaces {
+				key, err := kcpcache.MetaClusterNamespaceKeyFunc(workspace)
+				if err != nil {
+					utilruntime.HandleError(err)
+					return
+				}
+				logging.WithQueueKey(logger, key).V(3).Info("queueing Workspace on draining shard", "shard", shard)
+				c.queue.Add(key)
+			}
+		}
+	}
+}
+
+// enqueueLogicalCluster enqueues the child workspaces of a LogicalCluster whose canonical path
+// has changed, such that the new path is passed on to their logical clusters.
+func (c *Controller) enqueueLogicalCluster(oldObj, obj interface{}) {
+	old, ok := oldObj.(*corev1alpha1.LogicalCluster)
+	if !ok {
+		return
+	}
+	logicalCluster, ok := obj.(*corev1alpha1.LogicalCluster)
+	if !ok {
+		return
+	}
+	if old.Annotations[core.LogicalClusterPathAnnotationKey] == logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey] {
+		return
+	}
+
+	logger := logging.WithReconciler(klog.Background(), ControllerName)
+	workspaces, err := c.workspaceLister.Cluster(logicalcluster.From(logicalCluster)).List(labels.Everything())
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	for _, workspace := range workspaces {
+		key, err := kcpcache.MetaClusterNamespaceKeyFunc(workspace)
+		if err != nil {
+			utilruntime.HandleError(err)
+			return
+		}
+		logging.WithQueueKey(logger, key).V(3).Info("queueing Workspace because the canonical path of its parent changed")
+		c.queue.Add(key)
 	}
 }
 
@@ -359,6 +447,7 @@ func InstallIndexers(
 ) {
 	indexers.AddIfNotPresentOrDie(workspaceInformer.Informer().GetIndexer(), cache.Indexers{
 		unschedulable: indexUnschedulable,
+		byShard:       indexByShard,
 	})
 	indexers.AddIfNotPresentOrDie(globalShardInformer.Informer().GetIndexer(), cache.Indexers{
 		byBase36Sha224Name: indexByBase36Sha224Name,
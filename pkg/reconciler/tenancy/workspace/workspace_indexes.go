@@ -30,6 +30,7 @@ import (
 const (
 	byBase36Sha224Name = "byBase36Sha224Name"
 	unschedulable      = "unschedulable"
+	byShard            = "byShard"
 )
 
 func indexUnschedulable(obj interface{}) ([]string, error) {
@@ -37,6 +38,18 @@ func indexUnschedulable(obj interface{}) ([]string, error) {
 	if conditions.IsFalse(workspace, tenancyv1alpha1.WorkspaceScheduled) && conditions.GetReason(workspace, tenancyv1alpha1.WorkspaceScheduled) == tenancyv1alpha1.WorkspaceReasonUnschedulable {
 		return []string{"true"}, nil
 	}
+	if conditions.IsFalse(workspace, tenancyv1alpha1.WorkspaceMigrated) && conditions.GetReason(workspace, tenancyv1alpha1.WorkspaceMigrated) == tenancyv1alpha1.WorkspaceMigratedUnschedulable {
+		return []string{"true"}, nil
+	}
+	return []string{}, nil
+}
+
+// indexByShard indexes workspaces by the base36(sha224) hash of the shard their logical cluster lives on.
+func indexByShard(obj interface{}) ([]string, error) {
+	workspace := obj.(*tenancyv1alpha1.Workspace)
+	if hash, found := workspace.Annotations[WorkspaceShardHashAnnotationKey]; found {
+		return []string{hash}, nil
+	}
 	return []string{}, nil
 }
 
@@ -0,0 +1,298 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+var (
+	// excludedFromMigration are resources that are not copied when a logical cluster
+	// is migrated to another shard.
+	excludedFromMigration = sets.New[schema.GroupResource](
+		corev1alpha1.Resource("logicalclusters"), // copied by the migration reconciler
+		tenancyv1alpha1.Resource("workspaces"),   // workspaces with child workspaces are not migrated
+		schema.GroupResource{Resource: "events"},
+		schema.GroupResource{Group: "events.k8s.io", Resource: "events"},
+	)
+
+	// migrationCopyOrder are resources that are copied before everything else,
+	// because other objects depend on them.
+	migrationCopyOrder = []schema.GroupResource{
+		{Resource: "namespaces"},
+		{Resource: "secrets"},
+		{Resource: "configmaps"},
+		{Resource: "serviceaccounts"},
+		{Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"},
+		{Group: "apis.kcp.io", Resource: "apiexports"},
+		{Group: "apis.kcp.io", Resource: "apibindings"},
+	}
+
+	// systemReconciledGroups are API groups whose status is owned by kcp controllers.
+	// The status of these objects is rebuilt on the target shard and not copied.
+	systemReconciledGroups = sets.New[string](
+		"apis.kcp.io",
+		"cache.kcp.io",
+		"core.kcp.io",
+		"tenancy.kcp.io",
+		"topology.kcp.io",
+	)
+)
+
+// copyResult is the outcome of one copy round of a logical cluster.
+type copyResult struct {
+	// copied is the number of objects created on the target shard in this round.
+	copied int
+	// pending describes what could not be copied yet, e.g. because the resource
+	// is not served on the target shard yet, or the owner of an object is missing.
+	pending []string
+}
+
+// logicalClusterCopier copies the objects of a logical cluster from one shard to another.
+// The copy is done in rounds. Every round creates all objects that are missing on the
+// target shard and that can be created. Rounds are repeated until nothing is pending.
+//
+// Objects are copied through the API, not on the storage level, because the shards do
+// not share storage. The copies get a new UID, creation timestamp and resourceVersion,
+// and owner references are rewritten to the new UIDs.
+type logicalClusterCopier struct {
+	sourceDiscovery, targetDiscovery discovery.DiscoveryInterface
+	source, target                   dynamic.Interface
+}
+
+type migratedResource struct {
+	gvr        schema.GroupVersionResource
+	namespaced bool
+	hasStatus  bool
+}
+
+type migratedObjects struct {
+	migratedResource
+	source []unstructured.Unstructured
+	target sets.Set[string] // namespace/name of existing objects
+}
+
+func (c *logicalClusterCopier) copy(ctx context.Context) (*copyResult, error) {
+	logger := klog.FromContext(ctx)
+
+	sourceResources, err := migratedResources(c.sourceDiscovery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover resources on source shard: %w", err)
+	}
+	targetResources, err := migratedResources(c.targetDiscovery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover resources on target shard: %w", err)
+	}
+
+	result := &copyResult{}
+
+	// list everything first, such that owner references to objects of any resource can be mapped.
+	uids := map[types.UID]types.UID{} // source UID -> target UID
+	var all []*migratedObjects
+	for _, r := range sortedMigratedResources(sourceResources) {
+		if _, found := targetResources[r.gvr]; !found {
+			result.pending = append(result.pending, fmt.Sprintf("%s is not served", r.gvr.GroupResource()))
+			continue
+		}
+
+		sourceList, err := c.source.Resource(r.gvr).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s on source shard: %w", r.gvr.GroupResource(), err)
+		}
+		targetList, err := c.target.Resource(r.gvr).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s on target shard: %w", r.gvr.GroupResource(), err)
+		}
+
+		objs := &migratedObjects{migratedResource: r, source: sourceList.Items, target: sets.New[string]()}
+		targetUIDs := make(map[string]types.UID, len(targetList.Items))
+		for _, obj := range targetList.Items {
+			key := objectKey(&obj)
+			objs.target.Insert(key)
+			targetUIDs[key] = obj.GetUID()
+		}
+		for _, obj := range sourceList.Items {
+			if uid, found := targetUIDs[objectKey(&obj)]; found {
+				uids[obj.GetUID()] = uid
+			}
+		}
+		all = append(all, objs)
+	}
+	notServed := len(result.pending)
+
+	// objects can be owned by objects of resources that are copied later. Repeat the
+	// pass over objects that wait for their owners as long as there is progress.
+	for progress := true; progress; {
+		progress = false
+		result.pending = result.pending[:notServed]
+		for _, objs := range all {
+			for i := range objs.source {
+				obj := &objs.source[i]
+				if objs.target.Has(objectKey(obj)) || obj.GetDeletionTimestamp() != nil {
+					continue
+				}
+
+				copied, ok := migratedCopy(obj, uids)
+				if !ok {
+					result.pending = append(result.pending, fmt.Sprintf("%s %s waits for its owners", objs.gvr.GroupResource(), objectKey(obj)))
+					continue
+				}
+
+				var client dynamic.ResourceInterface = c.target.Resource(objs.gvr)
+				if objs.namespaced {
+					client = c.target.Resource(objs.gvr).Namespace(obj.GetNamespace())
+				}
+				objs.target.Insert(objectKey(obj))
+				progress = true
+				created, err := client.Create(ctx, copied, metav1.CreateOptions{})
+				if apierrors.IsAlreadyExists(err) {
+					continue // created by the target shard itself, e.g. the default namespace
+				} else if err != nil {
+					return nil, fmt.Errorf("failed to create %s %s on target shard: %w", objs.gvr.GroupResource(), objectKey(obj), err)
+				}
+				uids[obj.GetUID()] = created.GetUID()
+				result.copied++
+
+				status, hasStatus := obj.Object["status"]
+				if !objs.hasStatus || !hasStatus || systemReconciledGroups.Has(objs.gvr.Group) {
+					continue
+				}
+				created.Object["status"] = status
+				if _, err := client.UpdateStatus(ctx, created, metav1.UpdateOptions{}); err != nil {
+					return nil, fmt.Errorf("failed to update status of %s %s on target shard: %w", objs.gvr.GroupResource(), objectKey(obj), err)
+				}
+			}
+		}
+	}
+
+	logger.V(4).Info("copied logical cluster objects", "copied", result.copied, "pending", len(result.pending))
+	return result, nil
+}
+
+// migratedResources returns the preferred versions of all resources that are copied
+// during a migration.
+func migratedResources(d discovery.DiscoveryInterface) (map[schema.GroupVersionResource]migratedResource, error) {
+	lists, err := d.ServerPreferredResources()
+	if err != nil {
+		return nil, err
+	}
+
+	resources := map[schema.GroupVersionResource]migratedResource{}
+	for _, list := range lists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			return nil, err
+		}
+		subresources := sets.New[string]()
+		for _, r := range list.APIResources {
+			if strings.Contains(r.Name, "/") {
+				subresources.Insert(r.Name)
+			}
+		}
+		for _, r := range list.APIResources {
+			if strings.Contains(r.Name, "/") {
+				continue
+			}
+			if verbs := sets.New[string](r.Verbs...); !verbs.HasAll("list", "create") {
+				continue
+			}
+			gvr := gv.WithResource(r.Name)
+			if excludedFromMigration.Has(gvr.GroupResource()) {
+				continue
+			}
+			resources[gvr] = migratedResource{
+				gvr:        gvr,
+				namespaced: r.Namespaced,
+				hasStatus:  subresources.Has(r.Name + "/status"),
+			}
+		}
+	}
+	return resources, nil
+}
+
+// sortedMigratedResources returns the resources in the order they are copied.
+func sortedMigratedResources(resources map[schema.GroupVersionResource]migratedResource) []migratedResource {
+	rank := func(gr schema.GroupResource) int {
+		if i := slices.Index(migrationCopyOrder, gr); i >= 0 {
+			return i
+		}
+		return len(migrationCopyOrder)
+	}
+	sorted := make([]migratedResource, 0, len(resources))
+	for _, r := range resources {
+		sorted = append(sorted, r)
+	}
+	slices.SortFunc(sorted, func(a, b migratedResource) int {
+		if d := rank(a.gvr.GroupResource()) - rank(b.gvr.GroupResource()); d != 0 {
+			return d
+		}
+		return strings.Compare(a.gvr.String(), b.gvr.String())
+	})
+	return sorted
+}
+
+// migratedCopy returns the object as it is created on the target shard. It returns
+// false if the object has owners that do not exist on the target shard yet.
+func migratedCopy(obj *unstructured.Unstructured, uids map[types.UID]types.UID) (*unstructured.Unstructured, bool) {
+	copied := obj.DeepCopy()
+	copied.SetUID("")
+	copied.SetResourceVersion("")
+	copied.SetGeneration(0)
+	copied.SetCreationTimestamp(metav1.Time{})
+	copied.SetManagedFields(nil)
+	copied.SetSelfLink("")
+	if annotations := copied.GetAnnotations(); annotations != nil {
+		delete(annotations, logicalcluster.AnnotationKey)
+		copied.SetAnnotations(annotations)
+	}
+
+	owners := copied.GetOwnerReferences()
+	for i := range owners {
+		uid, found := uids[owners[i].UID]
+		if !found {
+			return nil, false
+		}
+		owners[i].UID = uid
+	}
+	copied.SetOwnerReferences(owners)
+
+	return copied, true
+}
+
+func objectKey(obj *unstructured.Unstructured) string {
+	if ns := obj.GetNamespace(); ns != "" {
+		return ns + "/" + obj.GetName()
+	}
+	return obj.GetName()
+}
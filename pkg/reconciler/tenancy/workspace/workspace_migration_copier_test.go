@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clientgotesting "k8s.io/client-go/testing"
+)
+
+// preferredResourcesDiscovery serves the resources of the fake as preferred resources,
+// which the fake itself does not implement.
+type preferredResourcesDiscovery struct {
+	*fakediscovery.FakeDiscovery
+}
+
+func (d *preferredResourcesDiscovery) ServerPreferredResources() ([]*metav1.APIResourceList, error) {
+	return d.Resources, nil
+}
+
+func newPreferredResourcesDiscovery(lists ...*metav1.APIResourceList) *preferredResourcesDiscovery {
+	return &preferredResourcesDiscovery{FakeDiscovery: &fakediscovery.FakeDiscovery{Fake: &clientgotesting.Fake{Resources: lists}}}
+}
+
+var (
+	namespacesGVR = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	configMapsGVR = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	eventsGVR     = schema.GroupVersionResource{Version: "v1", Resource: "events"}
+	widgetsGVR    = schema.GroupVersionResource{Group: "example.io", Version: "v1", Resource: "widgets"}
+)
+
+func newMigrationDynamicClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		namespacesGVR: "NamespaceList",
+		configMapsGVR: "ConfigMapList",
+		eventsGVR:     "EventList",
+		widgetsGVR:    "WidgetList",
+	}, objects...)
+	// the fake does not assign UIDs, but the copier maps owner references by UID.
+	client.PrependReactor("create", "*", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		obj := action.(clientgotesting.CreateAction).GetObject().(*unstructured.Unstructured)
+		obj.SetUID(types.UID("target-" + obj.GetName()))
+		return false, nil, nil
+	})
+	return client
+}
+
+func migrationObject(apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetUID(types.UID("source-" + name))
+	obj.SetResourceVersion("42")
+	obj.SetAnnotations(map[string]string{"kcp.io/cluster": "source", "keep": "me"})
+	return obj
+}
+
+func TestLogicalClusterCopier(t *testing.T) {
+	coreResources := &metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "namespaces", Verbs: []string{"list", "create"}},
+			{Name: "configmaps", Namespaced: true, Verbs: []string{"list", "create"}},
+			{Name: "events", Namespaced: true, Verbs: []string{"list", "create"}},
+		},
+	}
+	widgetResources := &metav1.APIResourceList{
+		GroupVersion: "example.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "widgets", Verbs: []string{"list", "create", "update"}},
+			{Name: "widgets/status", Verbs: []string{"update"}},
+		},
+	}
+
+	ns := migrationObject("v1", "Namespace", "", "ns")
+	widget := migrationObject("example.io/v1", "Widget", "", "w")
+	widget.Object["status"] = map[string]interface{}{"ready": true}
+	cm := migrationObject("v1", "ConfigMap", "ns", "cm")
+	cm.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "example.io/v1", Kind: "Widget", Name: "w", UID: widget.GetUID()}})
+	event := migrationObject("v1", "Event", "ns", "e")
+
+	source := newMigrationDynamicClient(ns, widget, cm, event)
+	target := newMigrationDynamicClient()
+	targetDiscovery := newPreferredResourcesDiscovery(coreResources)
+	copier := &logicalClusterCopier{
+		sourceDiscovery: newPreferredResourcesDiscovery(coreResources, widgetResources),
+		targetDiscovery: targetDiscovery,
+		source:          source,
+		target:          target,
+	}
+	ctx := context.Background()
+
+	t.Log("Widgets are not served on the target yet")
+	result, err := copier.copy(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.copied)
+	require.ElementsMatch(t, []string{"widgets.example.io is not served", "configmaps ns/cm waits for its owners"}, result.pending)
+
+	copiedNS, err := target.Resource(namespacesGVR).Get(ctx, "ns", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"keep": "me"}, copiedNS.GetAnnotations())
+	require.Empty(t, copiedNS.GetResourceVersion())
+
+	t.Log("Widgets are served on the target now")
+	targetDiscovery.Resources = append(targetDiscovery.Resources, widgetResources)
+	result, err = copier.copy(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.copied)
+	require.Empty(t, result.pending)
+
+	copiedWidget, err := target.Resource(widgetsGVR).Get(ctx, "w", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"ready": true}, copiedWidget.Object["status"])
+
+	copiedCM, err := target.Resource(configMapsGVR).Namespace("ns").Get(ctx, "cm", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, types.UID("target-w"), copiedCM.GetOwnerReferences()[0].UID)
+
+	_, err = target.Resource(eventsGVR).Namespace("ns").Get(ctx, "e", metav1.GetOptions{})
+	require.Error(t, err, "events are not migrated")
+
+	t.Log("Nothing is left to copy")
+	result, err = copier.copy(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, result.copied)
+	require.Empty(t, result.pending)
+}
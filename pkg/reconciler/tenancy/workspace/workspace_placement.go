@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+const (
+	placementReasonAffinity       = "Affinity"
+	placementReasonAntiAffinity   = "AntiAffinity"
+	placementReasonTopologySpread = "TopologySpread"
+)
+
+// placement evaluates the affinity, anti-affinity and topology spread constraints of a
+// workspace location against the shards its sibling workspaces are scheduled to.
+type placement struct {
+	affinity, antiAffinity []placementTerm
+	spread                 []placementSpread
+
+	// siblingShards are the shards of the scheduled sibling workspaces, by index into siblings.
+	siblings      []*tenancyv1alpha1.Workspace
+	siblingShards []*corev1alpha1.Shard
+}
+
+type placementTerm struct {
+	selector    labels.Selector
+	typ         *tenancyv1alpha1.WorkspaceTypeReference
+	topologyKey string
+}
+
+type placementSpread struct {
+	selector    labels.Selector
+	topologyKey string
+	maxSkew     int
+}
+
+// newPlacement returns the placement constraints of the workspace, or nil if there are
+// none. If the constraints are invalid, a message explaining why is returned.
+func newPlacement(
+	workspace *tenancyv1alpha1.Workspace,
+	listWorkspaces func(clusterName logicalcluster.Name) ([]*tenancyv1alpha1.Workspace, error),
+	listShards func(selector labels.Selector) ([]*corev1alpha1.Shard, error),
+) (p *placement, message string, err error) {
+	location := workspace.Spec.Location
+	if location == nil || (len(location.Affinity) == 0 && len(location.AntiAffinity) == 0 && len(location.TopologySpreadConstraints) == 0) {
+		return nil, "", nil
+	}
+
+	p = &placement{}
+	for i, term := range location.Affinity {
+		t, err := newPlacementTerm(term)
+		if err != nil {
+			return nil, fmt.Sprintf("spec.location.affinity[%d].workspaceSelector is invalid: %v", i, err), nil
+		}
+		p.affinity = append(p.affinity, t)
+	}
+	for i, term := range location.AntiAffinity {
+		t, err := newPlacementTerm(term)
+		if err != nil {
+			return nil, fmt.Sprintf("spec.location.antiAffinity[%d].workspaceSelector is invalid: %v", i, err), nil
+		}
+		p.antiAffinity = append(p.antiAffinity, t)
+	}
+	for i, constraint := range location.TopologySpreadConstraints {
+		selector, err := selectorOrEverything(constraint.WorkspaceSelector)
+		if err != nil {
+			return nil, fmt.Sprintf("spec.location.topologySpreadConstraints[%d].workspaceSelector is invalid: %v", i, err), nil
+		}
+		if constraint.TopologyKey == "" {
+			return nil, fmt.Sprintf("spec.location.topologySpreadConstraints[%d].topologyKey is required", i), nil
+		}
+		maxSkew := int(constraint.MaxSkew)
+		if maxSkew < 1 {
+			maxSkew = 1
+		}
+		p.spread = append(p.spread, placementSpread{selector: selector, topologyKey: constraint.TopologyKey, maxSkew: maxSkew})
+	}
+
+	workspaces, err := listWorkspaces(logicalcluster.From(workspace))
+	if err != nil {
+		return nil, "", err
+	}
+	shards, err := listShards(labels.Everything())
+	if err != nil {
+		return nil, "", err
+	}
+	shardsByHash := make(map[string]*corev1alpha1.Shard, len(shards))
+	for _, shard := range shards {
+		shardsByHash[ByBase36Sha224NameValue(shard.Name)] = shard
+	}
+	for _, sibling := range workspaces {
+		if sibling.Name == workspace.Name || !sibling.DeletionTimestamp.IsZero() {
+			continue
+		}
+		shard, found := shardsByHash[sibling.Annotations[WorkspaceShardHashAnnotationKey]]
+		if !found {
+			continue // not scheduled yet, or the shard is gone
+		}
+		p.siblings = append(p.siblings, sibling)
+		p.siblingShards = append(p.siblingShards, shard)
+	}
+
+	return p, "", nil
+}
+
+func newPlacementTerm(term tenancyv1alpha1.WorkspaceAffinityTerm) (placementTerm, error) {
+	selector, err := selectorOrEverything(term.WorkspaceSelector)
+	if err != nil {
+		return placementTerm{}, err
+	}
+	return placementTerm{selector: selector, typ: term.Type, topologyKey: term.TopologyKey}, nil
+}
+
+func selectorOrEverything(selector *metav1.LabelSelector) (labels.Selector, error) {
+	if selector == nil {
+		return labels.Everything(), nil
+	}
+	return metav1.LabelSelectorAsSelector(selector)
+}
+
+func (t placementTerm) matches(ws *tenancyv1alpha1.Workspace) bool {
+	if !t.selector.Matches(labels.Set(ws.Labels)) {
+		return false
+	}
+	if t.typ == nil {
+		return true
+	}
+	if ws.Spec.Type == nil || ws.Spec.Type.Name != t.typ.Name {
+		return false
+	}
+	return t.typ.Path == "" || ws.Spec.Type.Path == t.typ.Path
+}
+
+// topologyDomain returns the topology domain of the shard for the given key. The empty
+// key makes every shard its own domain.
+func topologyDomain(shard *corev1alpha1.Shard, key string) (string, bool) {
+	if key == "" {
+		return shard.Name, true
+	}
+	value, found := shard.Labels[key]
+	return value, found
+}
+
+// filter returns the shards that satisfy the placement constraints, and the reasons
+// why the others do not.
+func (p *placement) filter(shards []*corev1alpha1.Shard) (valid []*corev1alpha1.Shard, invalid map[string]struct{ reason, message string }) {
+	invalid = map[string]struct{ reason, message string }{}
+
+	// affinity and anti-affinity are independent of the other candidates.
+	for _, shard := range shards {
+		if reason, message, ok := p.checkAffinity(shard); !ok {
+			invalid[shard.Name] = struct{ reason, message string }{reason: reason, message: message}
+			continue
+		}
+		valid = append(valid, shard)
+	}
+
+	// topology spread compares the domains of the remaining candidates.
+	for _, spread := range p.spread {
+		counts := map[string]int{}
+		for _, shard := range valid {
+			if domain, found := topologyDomain(shard, spread.topologyKey); found {
+				counts[domain] = 0
+			}
+		}
+		for i, sibling := range p.siblings {
+			domain, found := topologyDomain(p.siblingShards[i], spread.topologyKey)
+			if _, candidate := counts[domain]; !found || !candidate || !spread.selector.Matches(labels.Set(sibling.Labels)) {
+				continue
+			}
+			counts[domain]++
+		}
+		minimum := -1
+		for _, count := range counts {
+			if minimum < 0 || count < minimum {
+				minimum = count
+			}
+		}
+
+		remaining := valid[:0:0]
+		for _, shard := range valid {
+			domain, found := topologyDomain(shard, spread.topologyKey)
+			switch {
+			case !found:
+				invalid[shard.Name] = struct{ reason, message string }{reason: placementReasonTopologySpread, message: fmt.Sprintf("shard has no label %q", spread.topologyKey)}
+			case counts[domain]+1-minimum > spread.maxSkew:
+				invalid[shard.Name] = struct{ reason, message string }{reason: placementReasonTopologySpread, message: fmt.Sprintf("%s=%s would exceed the maximal skew of %d", spread.topologyKey, domain, spread.maxSkew)}
+			default:
+				remaining = append(remaining, shard)
+			}
+		}
+		valid = remaining
+	}
+
+	return valid, invalid
+}
+
+func (p *placement) checkAffinity(shard *corev1alpha1.Shard) (reason, message string, ok bool) {
+	for i, term := range p.affinity {
+		domains := p.matchingDomains(term)
+		if len(domains) == 0 {
+			continue // nothing to be close to yet
+		}
+		domain, found := topologyDomain(shard, term.topologyKey)
+		if !found {
+			return placementReasonAffinity, fmt.Sprintf("shard has no label %q required by affinity term %d", term.topologyKey, i), false
+		}
+		if _, found := domains[domain]; !found {
+			return placementReasonAffinity, fmt.Sprintf("no matching workspaces for affinity term %d in topology domain %q", i, domain), false
+		}
+	}
+	for i, term := range p.antiAffinity {
+		domain, found := topologyDomain(shard, term.topologyKey)
+		if !found {
+			continue
+		}
+		if _, found := p.matchingDomains(term)[domain]; found {
+			return placementReasonAntiAffinity, fmt.Sprintf("matching workspaces for anti-affinity term %d in topology domain %q", i, domain), false
+		}
+	}
+	return "", "", true
+}
+
+// matchingDomains returns the topology domains hosting sibling workspaces matched by the term.
+func (p *placement) matchingDomains(term placementTerm) map[string]struct{} {
+	domains := map[string]struct{}{}
+	for i, sibling := range p.siblings {
+		if !term.matches(sibling) {
+			continue
+		}
+		if domain, found := topologyDomain(p.siblingShards[i], term.topologyKey); found {
+			domains[domain] = struct{}{}
+		}
+	}
+	return domains
+}
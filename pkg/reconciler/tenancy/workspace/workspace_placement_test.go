@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+func shardInRegion(name, region string) *corev1alpha1.Shard {
+	s := shard(name)
+	s.Labels["region"] = region
+	return s
+}
+
+func siblingOn(name, shardName string, workspaceLabels map[string]string, typ tenancyv1alpha1.WorkspaceTypeName) *tenancyv1alpha1.Workspace {
+	ws := workspace(name)
+	ws.Labels = workspaceLabels
+	ws.Annotations[WorkspaceShardHashAnnotationKey] = ByBase36Sha224NameValue(shardName)
+	ws.Spec.Type = &tenancyv1alpha1.WorkspaceTypeReference{Name: typ, Path: "root"}
+	return ws
+}
+
+func TestChooseShardPlacement(t *testing.T) {
+	regions := []*corev1alpha1.Shard{
+		shardInRegion("eu-1", "eu"),
+		shardInRegion("eu-2", "eu"),
+		shardInRegion("us-1", "us"),
+		shardInRegion("ap-1", "ap"),
+	}
+
+	tests := map[string]struct {
+		shards          []*corev1alpha1.Shard
+		siblings        []*tenancyv1alpha1.Workspace
+		location        tenancyv1alpha1.WorkspaceLocation
+		expectedShards  sets.Set[string]
+		expectedMessage string
+	}{
+		"affinity without matching siblings does not restrict": {
+			shards: regions,
+			location: tenancyv1alpha1.WorkspaceLocation{
+				Affinity: []tenancyv1alpha1.WorkspaceAffinityTerm{{Type: &tenancyv1alpha1.WorkspaceTypeReference{Name: "team"}}},
+			},
+			expectedShards: sets.New("eu-1", "eu-2", "us-1", "ap-1"),
+		},
+		"affinity by type keeps workspaces on one shard": {
+			shards: regions,
+			siblings: []*tenancyv1alpha1.Workspace{
+				siblingOn("a", "us-1", nil, "team"),
+				siblingOn("b", "eu-1", nil, "universal"),
+			},
+			location: tenancyv1alpha1.WorkspaceLocation{
+				Affinity: []tenancyv1alpha1.WorkspaceAffinityTerm{{Type: &tenancyv1alpha1.WorkspaceTypeReference{Name: "team"}}},
+			},
+			expectedShards: sets.New("us-1"),
+		},
+		"affinity by type and path": {
+			shards: regions,
+			siblings: []*tenancyv1alpha1.Workspace{
+				siblingOn("a", "us-1", nil, "team"),
+			},
+			location: tenancyv1alpha1.WorkspaceLocation{
+				Affinity: []tenancyv1alpha1.WorkspaceAffinityTerm{{Type: &tenancyv1alpha1.WorkspaceTypeReference{Name: "team", Path: "root:org"}}},
+			},
+			expectedShards: sets.New("eu-1", "eu-2", "us-1", "ap-1"),
+		},
+		"affinity by region": {
+			shards: regions,
+			siblings: []*tenancyv1alpha1.Workspace{
+				siblingOn("a", "eu-2", map[string]string{"app": "db"}, "universal"),
+			},
+			location: tenancyv1alpha1.WorkspaceLocation{
+				Affinity: []tenancyv1alpha1.WorkspaceAffinityTerm{{
+					WorkspaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+					TopologyKey:       "region",
+				}},
+			},
+			expectedShards: sets.New("eu-1", "eu-2"),
+		},
+		"anti-affinity by region": {
+			shards: regions,
+			siblings: []*tenancyv1alpha1.Workspace{
+				siblingOn("a", "eu-2", nil, "universal"),
+				siblingOn("b", "us-1", nil, "universal"),
+			},
+			location: tenancyv1alpha1.WorkspaceLocation{
+				AntiAffinity: []tenancyv1alpha1.WorkspaceAffinityTerm{{TopologyKey: "region"}},
+			},
+			expectedShards: sets.New("ap-1"),
+		},
+		"anti-affinity by shard ignores non-matching siblings": {
+			shards: regions,
+			siblings: []*tenancyv1alpha1.Workspace{
+				siblingOn("a", "eu-1", map[string]string{"tier": "prod"}, "universal"),
+				siblingOn("b", "eu-2", map[string]string{"tier": "dev"}, "universal"),
+			},
+			location: tenancyv1alpha1.WorkspaceLocation{
+				AntiAffinity: []tenancyv1alpha1.WorkspaceAffinityTerm{{
+					WorkspaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "prod"}},
+				}},
+			},
+			expectedShards: sets.New("eu-2", "us-1", "ap-1"),
+		},
+		"spread across regions": {
+			shards: regions,
+			siblings: []*tenancyv1alpha1.Workspace{
+				siblingOn("a", "eu-1", nil, "universal"),
+				siblingOn("b", "us-1", nil, "universal"),
+			},
+			location: tenancyv1alpha1.WorkspaceLocation{
+				TopologySpreadConstraints: []tenancyv1alpha1.WorkspaceTopologySpreadConstraint{{TopologyKey: "region", MaxSkew: 1}},
+			},
+			expectedShards: sets.New("ap-1"),
+		},
+		"spread with larger skew": {
+			shards: regions,
+			siblings: []*tenancyv1alpha1.Workspace{
+				siblingOn("a", "eu-1", nil, "universal"),
+				siblingOn("b", "eu-2", nil, "universal"),
+				siblingOn("c", "us-1", nil, "universal"),
+			},
+			location: tenancyv1alpha1.WorkspaceLocation{
+				TopologySpreadConstraints: []tenancyv1alpha1.WorkspaceTopologySpreadConstraint{{TopologyKey: "region", MaxSkew: 2}},
+			},
+			expectedShards: sets.New("us-1", "ap-1"),
+		},
+		"spread skips shards without the topology key": {
+			shards: append([]*corev1alpha1.Shard{shard("unlabelled")}, regions[:3]...),
+			location: tenancyv1alpha1.WorkspaceLocation{
+				TopologySpreadConstraints: []tenancyv1alpha1.WorkspaceTopologySpreadConstraint{{TopologyKey: "region", MaxSkew: 1}},
+			},
+			expectedShards: sets.New("eu-1", "eu-2", "us-1"),
+		},
+		"conflicting constraints": {
+			shards: regions[:2],
+			siblings: []*tenancyv1alpha1.Workspace{
+				siblingOn("a", "eu-1", nil, "universal"),
+			},
+			location: tenancyv1alpha1.WorkspaceLocation{
+				AntiAffinity: []tenancyv1alpha1.WorkspaceAffinityTerm{{TopologyKey: "region"}},
+			},
+			expectedMessage: `No available shards to schedule the workspace: eu-1: AntiAffinity (matching workspaces for anti-affinity term 0 in topology domain "eu"); eu-2: AntiAffinity (matching workspaces for anti-affinity term 0 in topology domain "eu")`,
+		},
+		"invalid selector": {
+			shards: regions,
+			location: tenancyv1alpha1.WorkspaceLocation{
+				Affinity: []tenancyv1alpha1.WorkspaceAffinityTerm{{
+					WorkspaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Bogus"}}},
+				}},
+			},
+			expectedMessage: `spec.location.affinity[0].workspaceSelector is invalid: "Bogus" is not a valid label selector operator`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ws := workspace("new")
+			ws.Spec.Location = &tc.location
+			listShards := func(selector labels.Selector) ([]*corev1alpha1.Shard, error) {
+				var shards []*corev1alpha1.Shard
+				for _, shard := range tc.shards {
+					if selector.Matches(labels.Set(shard.Labels)) {
+						shards = append(shards, shard)
+					}
+				}
+				return shards, nil
+			}
+			listWorkspaces := func(clusterName logicalcluster.Name) ([]*tenancyv1alpha1.Workspace, error) {
+				require.Equal(t, logicalcluster.Name("root"), clusterName)
+				return append([]*tenancyv1alpha1.Workspace{ws}, tc.siblings...), nil
+			}
+
+			// the choice among equally good shards is random, so collect all of them.
+			chosen := sets.New[string]()
+			for range 100 {
+				shard, message, err := chooseShard(klog.Background(), ws, listShards, listWorkspaces)
+				require.NoError(t, err)
+				if tc.expectedMessage != "" {
+					require.Nil(t, shard)
+					require.Equal(t, tc.expectedMessage, message)
+					return
+				}
+				require.NotNil(t, shard, message)
+				chosen.Insert(shard.Name)
+			}
+			require.Equal(t, sets.List(tc.expectedShards), sets.List(chosen))
+		})
+	}
+}
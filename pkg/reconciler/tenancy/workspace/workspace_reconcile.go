@@ -22,6 +22,7 @@ import (
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	utilserrors "k8s.io/apimachinery/pkg/util/errors"
 
 	kcpcache "github.com/kcp-dev/apimachinery/v2/pkg/cache"
@@ -73,12 +74,73 @@ func (c *Controller) reconcile(ctx context.Context, ws *tenancyv1alpha1.Workspac
 		return c.clientPool.getKubeClient(shard.Name, shard.Spec.BaseURL)
 	}
 
+	copyLogicalCluster := func(ctx context.Context, source, target *corev1alpha1.Shard, cluster logicalcluster.Path) (*copyResult, error) {
+		sourceKubeClient, err := c.clientPool.getKubeClient(source.Name, source.Spec.BaseURL)
+		if err != nil {
+			return nil, err
+		}
+		sourceDynamicClient, err := c.clientPool.getDynamicClient(source.Name, source.Spec.BaseURL)
+		if err != nil {
+			return nil, err
+		}
+		targetKubeClient, err := c.clientPool.getKubeClient(target.Name, target.Spec.BaseURL)
+		if err != nil {
+			return nil, err
+		}
+		targetDynamicClient, err := c.clientPool.getDynamicClient(target.Name, target.Spec.BaseURL)
+		if err != nil {
+			return nil, err
+		}
+		copier := &logicalClusterCopier{
+			sourceDiscovery: sourceKubeClient.Cluster(cluster).Discovery(),
+			targetDiscovery: targetKubeClient.Cluster(cluster).Discovery(),
+			source:          sourceDynamicClient.Cluster(cluster),
+			target:          targetDynamicClient.Cluster(cluster),
+		}
+		return copier.copy(ctx)
+	}
+
+	listWorkspaces := func(clusterName logicalcluster.Name) ([]*tenancyv1alpha1.Workspace, error) {
+		return c.workspaceLister.Cluster(clusterName).List(labels.Everything())
+	}
+
 	getType := func(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
 		return indexers.ByPathAndName[*tenancyv1alpha1.WorkspaceType](tenancyv1alpha1.Resource("workspacetypes"), c.globalWorkspaceTypeIndexer, path, name)
 	}
 
 	reconcilers := []reconciler{
 		&metaDataReconciler{},
+		// the move reconciler runs before the deletion reconciler, such that an unfinished move
+		// is rolled back before the logical cluster would be deleted.
+		&moveReconciler{
+			getWorkspace: func(ctx context.Context, cluster logicalcluster.Path, name string) (*tenancyv1alpha1.Workspace, error) {
+				return c.kcpExternalClient.Cluster(cluster).TenancyV1alpha1().Workspaces().Get(ctx, name, metav1.GetOptions{})
+			},
+			updateWorkspace: func(ctx context.Context, cluster logicalcluster.Path, workspace *tenancyv1alpha1.Workspace) error {
+				_, err := c.kcpExternalClient.Cluster(cluster).TenancyV1alpha1().Workspaces().Update(ctx, workspace, metav1.UpdateOptions{})
+				return err
+			},
+			deleteWorkspace: func(ctx context.Context, workspace *tenancyv1alpha1.Workspace) error {
+				return c.kcpClusterClient.Cluster(logicalcluster.From(workspace).Path()).TenancyV1alpha1().Workspaces().Delete(ctx, workspace.Name, metav1.DeleteOptions{
+					Preconditions: &metav1.Preconditions{UID: &workspace.UID},
+				})
+			},
+			getLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error) {
+				return c.kcpExternalClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
+			},
+			updateLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path, logicalCluster *corev1alpha1.LogicalCluster) error {
+				_, err := c.kcpExternalClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Update(ctx, logicalCluster, metav1.UpdateOptions{})
+				return err
+			},
+			listShards: c.globalShardLister.List,
+			getParentLogicalCluster: func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error) {
+				return c.logicalClusterLister.Cluster(clusterName).Get(corev1alpha1.LogicalClusterName)
+			},
+			requeueAfter: func(workspace *tenancyv1alpha1.Workspace, after time.Duration) {
+				c.queue.AddAfter(kcpcache.ToClusterAwareKey(logicalcluster.From(workspace).String(), "", workspace.Name), after)
+			},
+			now: time.Now,
+		},
 		&deletionReconciler{
 			getLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error) {
 				return c.kcpExternalClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
@@ -96,6 +158,7 @@ func (c *Controller) reconcile(ctx context.Context, ws *tenancyv1alpha1.Workspac
 			},
 			getShardByHash:   getShardByName,
 			listShards:       c.globalShardLister.List,
+			listWorkspaces:   listWorkspaces,
 			getWorkspaceType: getType,
 			getLogicalCluster: func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error) {
 				return c.logicalClusterLister.Cluster(clusterName).Get(corev1alpha1.LogicalClusterName)
@@ -104,6 +167,31 @@ func (c *Controller) reconcile(ctx context.Context, ws *tenancyv1alpha1.Workspac
 			kcpLogicalClusterAdminClientFor:  kcpDirectClientFor,
 			kubeLogicalClusterAdminClientFor: kubeDirectClientFor,
 		},
+		&migrationReconciler{
+			getShard: func(name string) (*corev1alpha1.Shard, error) {
+				return c.globalShardLister.Cluster(core.RootCluster).Get(name)
+			},
+			getShardByHash:                  getShardByName,
+			listShards:                      c.globalShardLister.List,
+			listWorkspaces:                  listWorkspaces,
+			kcpLogicalClusterAdminClientFor: kcpDirectClientFor,
+			copyLogicalCluster:              copyLogicalCluster,
+			requeueAfter: func(workspace *tenancyv1alpha1.Workspace, after time.Duration) {
+				c.queue.AddAfter(kcpcache.ToClusterAwareKey(logicalcluster.From(workspace).String(), "", workspace.Name), after)
+			},
+		},
+		&hibernationReconciler{
+			getLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error) {
+				return c.kcpExternalClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
+			},
+			updateLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path, logicalCluster *corev1alpha1.LogicalCluster) error {
+				_, err := c.kcpExternalClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Update(ctx, logicalCluster, metav1.UpdateOptions{})
+				return err
+			},
+			requeueAfter: func(workspace *tenancyv1alpha1.Workspace, after time.Duration) {
+				c.queue.AddAfter(kcpcache.ToClusterAwareKey(logicalcluster.From(workspace).String(), "", workspace.Name), after)
+			},
+		},
 		&phaseReconciler{
 			getLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error) {
 				return c.kcpExternalClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
@@ -107,6 +107,16 @@ func (r *deletionReconciler) reconcile(ctx context.Context, workspace *tenancyv1
 		return reconcileStatusContinue, nil
 	}
 
+	if owner := logicalCluster.Spec.Owner; owner != nil && owner.UID != "" && owner.UID != workspace.UID {
+		// the logical cluster has been handed over to another workspace, e.g. by a move.
+		if finSet.Has(corev1alpha1.LogicalClusterFinalizerName) {
+			logger.Info(fmt.Sprintf("Removing finalizer %s of workspace not owning the LogicalCluster", corev1alpha1.LogicalClusterFinalizerName))
+			workspace.Finalizers = sets.List(finSet.Delete(corev1alpha1.LogicalClusterFinalizerName))
+			return reconcileStatusStopAndRequeue, nil // spec change
+		}
+		return reconcileStatusContinue, nil
+	}
+
 	if logicalCluster.DeletionTimestamp.IsZero() {
 		logger.Info("Deleting LogicalCluster")
 		if err := r.deleteLogicalCluster(ctx, clusterName.Path()); err != nil {
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+// hibernationResumePollInterval is how often a resuming workspace checks whether its
+// logical cluster is ready again.
+const hibernationResumePollInterval = time.Second * 2
+
+// hibernationReconciler propagates spec.hibernation of the workspace to its LogicalCluster,
+// and reflects hibernation in the workspace phase. The LogicalCluster controller on the shard
+// moves the LogicalCluster between the Ready and Hibernated phases, which stops its controllers
+// and restricts access.
+type hibernationReconciler struct {
+	getLogicalCluster    func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error)
+	updateLogicalCluster func(ctx context.Context, cluster logicalcluster.Path, logicalCluster *corev1alpha1.LogicalCluster) error
+
+	requeueAfter func(workspace *tenancyv1alpha1.Workspace, after time.Duration)
+}
+
+func (r *hibernationReconciler) reconcile(ctx context.Context, workspace *tenancyv1alpha1.Workspace) (reconcileStatus, error) {
+	logger := klog.FromContext(ctx).WithValues("reconciler", "hibernation")
+
+	if workspace.Spec.Mount != nil {
+		return reconcileStatusContinue, nil
+	}
+	if !workspace.DeletionTimestamp.IsZero() {
+		// the logical cluster wakes up on deletion to get its content deleted.
+		if workspace.Status.Phase == corev1alpha1.LogicalClusterPhaseHibernated {
+			workspace.Status.Phase = corev1alpha1.LogicalClusterPhaseReady
+		}
+		return reconcileStatusContinue, nil
+	}
+
+	switch {
+	case workspace.Spec.Hibernation != "" && (workspace.Status.Phase == corev1alpha1.LogicalClusterPhaseReady || workspace.Status.Phase == corev1alpha1.LogicalClusterPhaseHibernated):
+		cluster := logicalcluster.NewPath(workspace.Spec.Cluster)
+		logicalCluster, err := r.getLogicalCluster(ctx, cluster)
+		if err != nil {
+			return reconcileStatusStopAndRequeue, err
+		}
+		if logicalCluster.Spec.Hibernation != workspace.Spec.Hibernation {
+			logger.Info("hibernating LogicalCluster", "cluster", cluster, "mode", workspace.Spec.Hibernation)
+			logicalCluster.Spec.Hibernation = workspace.Spec.Hibernation
+			if err := r.updateLogicalCluster(ctx, cluster, logicalCluster); err != nil {
+				return reconcileStatusStopAndRequeue, err
+			}
+		}
+		workspace.Status.Phase = corev1alpha1.LogicalClusterPhaseHibernated
+
+	case workspace.Spec.Hibernation == "" && workspace.Status.Phase == corev1alpha1.LogicalClusterPhaseHibernated:
+		cluster := logicalcluster.NewPath(workspace.Spec.Cluster)
+		logicalCluster, err := r.getLogicalCluster(ctx, cluster)
+		if err != nil {
+			return reconcileStatusStopAndRequeue, err
+		}
+		if logicalCluster.Spec.Hibernation != "" {
+			logger.Info("resuming LogicalCluster", "cluster", cluster)
+			logicalCluster.Spec.Hibernation = ""
+			if err := r.updateLogicalCluster(ctx, cluster, logicalCluster); err != nil {
+				return reconcileStatusStopAndRequeue, err
+			}
+		}
+		if logicalCluster.Status.Phase == corev1alpha1.LogicalClusterPhaseHibernated {
+			// the LogicalCluster controller lives on another shard, we won't be notified.
+			logger.V(3).Info("LogicalCluster is still hibernated, requeuing", "cluster", cluster)
+			r.requeueAfter(workspace, hibernationResumePollInterval)
+			return reconcileStatusContinue, nil
+		}
+		workspace.Status.Phase = corev1alpha1.LogicalClusterPhaseReady
+	}
+
+	return reconcileStatusContinue, nil
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+)
+
+func TestReconcileHibernation(t *testing.T) {
+	now := metav1.Now()
+
+	for _, tc := range []struct {
+		name           string
+		hibernation    corev1alpha1.LogicalClusterHibernationMode
+		phase          corev1alpha1.LogicalClusterPhaseType
+		deleting       bool
+		logicalCluster *corev1alpha1.LogicalCluster
+
+		wantPhase       corev1alpha1.LogicalClusterPhaseType
+		wantHibernation corev1alpha1.LogicalClusterHibernationMode
+		wantUpdate      bool
+		wantRequeue     bool
+	}{
+		{
+			name:      "ready workspace without hibernation is left alone",
+			phase:     corev1alpha1.LogicalClusterPhaseReady,
+			wantPhase: corev1alpha1.LogicalClusterPhaseReady,
+		},
+		{
+			name:            "initializing workspace is not hibernated yet",
+			hibernation:     corev1alpha1.LogicalClusterHibernationModeDenied,
+			phase:           corev1alpha1.LogicalClusterPhaseInitializing,
+			logicalCluster:  &corev1alpha1.LogicalCluster{},
+			wantPhase:       corev1alpha1.LogicalClusterPhaseInitializing,
+			wantHibernation: "",
+		},
+		{
+			name:            "ready workspace is hibernated",
+			hibernation:     corev1alpha1.LogicalClusterHibernationModeReadOnly,
+			phase:           corev1alpha1.LogicalClusterPhaseReady,
+			logicalCluster:  &corev1alpha1.LogicalCluster{Status: corev1alpha1.LogicalClusterStatus{Phase: corev1alpha1.LogicalClusterPhaseReady}},
+			wantPhase:       corev1alpha1.LogicalClusterPhaseHibernated,
+			wantHibernation: corev1alpha1.LogicalClusterHibernationModeReadOnly,
+			wantUpdate:      true,
+		},
+		{
+			name:        "hibernation mode is changed",
+			hibernation: corev1alpha1.LogicalClusterHibernationModeDenied,
+			phase:       corev1alpha1.LogicalClusterPhaseHibernated,
+			logicalCluster: &corev1alpha1.LogicalCluster{
+				Spec:   corev1alpha1.LogicalClusterSpec{Hibernation: corev1alpha1.LogicalClusterHibernationModeReadOnly},
+				Status: corev1alpha1.LogicalClusterStatus{Phase: corev1alpha1.LogicalClusterPhaseHibernated},
+			},
+			wantPhase:       corev1alpha1.LogicalClusterPhaseHibernated,
+			wantHibernation: corev1alpha1.LogicalClusterHibernationModeDenied,
+			wantUpdate:      true,
+		},
+		{
+			name:  "resumed workspace waits for the logical cluster",
+			phase: corev1alpha1.LogicalClusterPhaseHibernated,
+			logicalCluster: &corev1alpha1.LogicalCluster{
+				Spec:   corev1alpha1.LogicalClusterSpec{Hibernation: corev1alpha1.LogicalClusterHibernationModeDenied},
+				Status: corev1alpha1.LogicalClusterStatus{Phase: corev1alpha1.LogicalClusterPhaseHibernated},
+			},
+			wantPhase:   corev1alpha1.LogicalClusterPhaseHibernated,
+			wantUpdate:  true,
+			wantRequeue: true,
+		},
+		{
+			name:           "resumed workspace is ready with the logical cluster",
+			phase:          corev1alpha1.LogicalClusterPhaseHibernated,
+			logicalCluster: &corev1alpha1.LogicalCluster{Status: corev1alpha1.LogicalClusterStatus{Phase: corev1alpha1.LogicalClusterPhaseReady}},
+			wantPhase:      corev1alpha1.LogicalClusterPhaseReady,
+		},
+		{
+			name:        "deleted hibernated workspace wakes up",
+			hibernation: corev1alpha1.LogicalClusterHibernationModeDenied,
+			phase:       corev1alpha1.LogicalClusterPhaseHibernated,
+			deleting:    true,
+			wantPhase:   corev1alpha1.LogicalClusterPhaseReady,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			workspace := &tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       tenancyv1alpha1.WorkspaceSpec{Cluster: "cluster-1", Hibernation: tc.hibernation},
+				Status:     tenancyv1alpha1.WorkspaceStatus{Phase: tc.phase},
+			}
+			if tc.deleting {
+				workspace.DeletionTimestamp = &now
+			}
+
+			var updated, requeued bool
+			r := &hibernationReconciler{
+				getLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error) {
+					require.Equal(t, "cluster-1", cluster.String())
+					require.NotNil(t, tc.logicalCluster, "unexpected get of the LogicalCluster")
+					return tc.logicalCluster, nil
+				},
+				updateLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path, logicalCluster *corev1alpha1.LogicalCluster) error {
+					updated = true
+					return nil
+				},
+				requeueAfter: func(workspace *tenancyv1alpha1.Workspace, after time.Duration) {
+					requeued = true
+				},
+			}
+
+			status, err := r.reconcile(context.Background(), workspace)
+			require.NoError(t, err)
+			require.Equal(t, reconcileStatusContinue, status)
+			require.Equal(t, tc.wantPhase, workspace.Status.Phase)
+			require.Equal(t, tc.wantUpdate, updated)
+			require.Equal(t, tc.wantRequeue, requeued)
+			if tc.logicalCluster != nil {
+				require.Equal(t, tc.wantHibernation, tc.logicalCluster.Spec.Hibernation)
+			}
+		})
+	}
+}
@@ -0,0 +1,391 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+
+	"github.com/kcp-dev/kcp/pkg/logging"
+)
+
+const (
+	// workspaceMigrationSourceAnnotationKey keeps track of the shard a workspace is migrated away
+	// from. The value is a base36(sha224) hash of the Shard name, like WorkspaceShardHashAnnotationKey.
+	workspaceMigrationSourceAnnotationKey = "internal.tenancy.kcp.io/migration-source-shard"
+
+	// workspaceMigrationTargetAnnotationKey keeps track of the name of the shard a workspace is migrated to.
+	workspaceMigrationTargetAnnotationKey = "internal.tenancy.kcp.io/migration-target-shard"
+
+	// migrationPollInterval is the interval in which a migration is checked for progress.
+	migrationPollInterval = 5 * time.Second
+
+	// maxPendingInMessage is the number of pending items listed in the Migrated condition.
+	maxPendingInMessage = 3
+)
+
+// migrationReconciler moves the logical cluster of a workspace to the shard in spec.location.shard,
+// or away from a draining shard to the schedulable shard with the most free capacity.
+//
+// A migration goes through these steps:
+//  1. the workspace and the logical cluster on the source shard go into the read-only Migrating phase.
+//  2. the LogicalCluster is created on the target shard, annotated such that it is not served yet.
+//  3. all objects are copied to the target shard, in rounds until nothing is pending.
+//  4. the LogicalCluster on the source shard is annotated as migrated, such that it is ignored by the
+//     front-proxy index from now on.
+//  5. the annotation is removed from the target LogicalCluster, which flips the front-proxy index entry,
+//     and the workspace is pointed to the target shard.
+//  6. the LogicalCluster on the source shard is deleted, and the workspace goes back to Ready.
+type migrationReconciler struct {
+	getShard       func(name string) (*corev1alpha1.Shard, error)
+	getShardByHash func(hash string) (*corev1alpha1.Shard, error)
+	listShards     func(selector labels.Selector) ([]*corev1alpha1.Shard, error)
+	listWorkspaces func(clusterName logicalcluster.Name) ([]*tenancyv1alpha1.Workspace, error)
+
+	kcpLogicalClusterAdminClientFor func(shard *corev1alpha1.Shard) (kcpclientset.ClusterInterface, error)
+	copyLogicalCluster              func(ctx context.Context, source, target *corev1alpha1.Shard, cluster logicalcluster.Path) (*copyResult, error)
+
+	requeueAfter func(workspace *tenancyv1alpha1.Workspace, after time.Duration)
+}
+
+func (r *migrationReconciler) reconcile(ctx context.Context, workspace *tenancyv1alpha1.Workspace) (reconcileStatus, error) {
+	logger := klog.FromContext(ctx).WithValues("reconciler", "migration")
+	ctx = klog.NewContext(ctx, logger)
+
+	if workspace.Spec.Mount != nil || workspace.Spec.URL == "" || workspace.Spec.Cluster == "" {
+		return reconcileStatusContinue, nil
+	}
+
+	currentHash := workspace.Annotations[WorkspaceShardHashAnnotationKey]
+	sourceHash, migrating := workspace.Annotations[workspaceMigrationSourceAnnotationKey]
+
+	switch {
+	case !workspace.DeletionTimestamp.IsZero():
+		if migrating && sourceHash == currentHash {
+			return r.abort(ctx, workspace)
+		}
+		return reconcileStatusContinue, nil
+	case migrating && sourceHash != currentHash:
+		return r.cleanUpSource(ctx, workspace, sourceHash)
+	case migrating:
+		return r.copyToTarget(ctx, workspace, sourceHash)
+	}
+
+	targetName := migrationTarget(workspace)
+	if targetName == "" || ByBase36Sha224NameValue(targetName) == currentHash {
+		current, err := r.getShardByHash(currentHash)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return reconcileStatusStopAndRequeue, err
+		}
+		if current == nil || !current.Spec.Drain || targetName != "" {
+			if conditions.IsFalse(workspace, tenancyv1alpha1.WorkspaceMigrated) {
+				conditions.Delete(workspace, tenancyv1alpha1.WorkspaceMigrated) // the failed request was withdrawn
+			}
+			return reconcileStatusContinue, nil
+		}
+		if workspace.Status.Phase != corev1alpha1.LogicalClusterPhaseReady {
+			return reconcileStatusContinue, nil // only ready workspaces are migrated
+		}
+
+		// the current shard is drained, move to the best schedulable shard.
+		target, message, err := chooseShard(logger, workspace, r.listShards, r.listWorkspaces)
+		if err != nil {
+			return reconcileStatusStopAndRequeue, err
+		}
+		if target == nil {
+			conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceMigrated, tenancyv1alpha1.WorkspaceMigratedUnschedulable, conditionsv1alpha1.ConditionSeverityError, "Shard %q is drained: %s", current.Name, message)
+			return reconcileStatusContinue, nil // retry is automatic when shards change
+		}
+		targetName = target.Name
+	}
+	if workspace.Status.Phase != corev1alpha1.LogicalClusterPhaseReady {
+		return reconcileStatusContinue, nil // only ready workspaces are migrated
+	}
+
+	target, err := r.getShard(targetName)
+	if apierrors.IsNotFound(err) {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceMigrated, tenancyv1alpha1.WorkspaceMigratedInvalidTarget, conditionsv1alpha1.ConditionSeverityError, "Shard %q does not exist", targetName)
+		return reconcileStatusContinue, nil // retry is automatic when the shard shows up
+	} else if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	if valid, reason, message := isValidShard(target); !valid {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceMigrated, tenancyv1alpha1.WorkspaceMigratedInvalidTarget, conditionsv1alpha1.ConditionSeverityError, "Shard %q cannot host logical clusters: %s (%s)", target.Name, reason, message)
+		return reconcileStatusContinue, nil
+	}
+	source, err := r.getShardByHash(currentHash)
+	if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+
+	sourceClient, err := r.kcpLogicalClusterAdminClientFor(source)
+	if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	children, err := sourceClient.Cluster(logicalcluster.NewPath(workspace.Spec.Cluster)).TenancyV1alpha1().Workspaces().List(ctx, metav1.ListOptions{Limit: 1})
+	if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	if len(children.Items) > 0 {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceMigrated, tenancyv1alpha1.WorkspaceMigratedNotSupported, conditionsv1alpha1.ConditionSeverityError, "Workspaces with child workspaces cannot be migrated")
+		return reconcileStatusContinue, nil
+	}
+
+	logger.Info("starting migration", "source", source.Name, "target", target.Name)
+	workspace.Annotations[workspaceMigrationSourceAnnotationKey] = currentHash
+	workspace.Annotations[workspaceMigrationTargetAnnotationKey] = target.Name
+	workspace.Status.Phase = corev1alpha1.LogicalClusterPhaseMigrating
+	conditions.MarkUnknown(workspace, tenancyv1alpha1.WorkspaceMigrated, tenancyv1alpha1.WorkspaceMigratedCopying, "Migrating from shard %q to shard %q", source.Name, target.Name)
+
+	// persist the migration before anything is changed on the shards
+	return reconcileStatusStopAndRequeue, nil
+}
+
+// copyToTarget brings the target shard up to date, and flips over to it when nothing is pending anymore.
+func (r *migrationReconciler) copyToTarget(ctx context.Context, workspace *tenancyv1alpha1.Workspace, sourceHash string) (reconcileStatus, error) {
+	logger := klog.FromContext(ctx)
+	cluster := logicalcluster.NewPath(workspace.Spec.Cluster)
+
+	source, err := r.getShardByHash(sourceHash)
+	if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	target, err := r.getShard(migrationTarget(workspace))
+	if apierrors.IsNotFound(err) {
+		conditions.MarkUnknown(workspace, tenancyv1alpha1.WorkspaceMigrated, tenancyv1alpha1.WorkspaceMigratedInvalidTarget, "Shard %q does not exist anymore", migrationTarget(workspace))
+		return reconcileStatusContinue, nil // retry is automatic when the shard shows up
+	} else if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+
+	sourceClient, err := r.kcpLogicalClusterAdminClientFor(source)
+	if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	targetClient, err := r.kcpLogicalClusterAdminClientFor(target)
+	if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+
+	sourceLogicalCluster, err := sourceClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
+	if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	if sourceLogicalCluster.Status.Phase != corev1alpha1.LogicalClusterPhaseMigrating {
+		sourceLogicalCluster.Status.Phase = corev1alpha1.LogicalClusterPhaseMigrating
+		logging.WithObject(logger, sourceLogicalCluster).Info("making LogicalCluster read-only for migration")
+		if sourceLogicalCluster, err = sourceClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().UpdateStatus(ctx, sourceLogicalCluster, metav1.UpdateOptions{}); err != nil {
+			return reconcileStatusStopAndRequeue, err
+		}
+	}
+
+	targetLogicalCluster, err := targetClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		if targetLogicalCluster, err = r.createTargetLogicalCluster(ctx, targetClient, cluster, source, sourceLogicalCluster); err != nil {
+			return reconcileStatusStopAndRequeue, err
+		}
+	} else if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	} else if !equality.Semantic.DeepEqual(targetLogicalCluster.Spec.Owner, sourceLogicalCluster.Spec.Owner) {
+		conditions.MarkUnknown(workspace, tenancyv1alpha1.WorkspaceMigrated, tenancyv1alpha1.WorkspaceMigratedInvalidTarget, "Shard %q already has a different logical cluster %q", target.Name, cluster)
+		return reconcileStatusContinue, nil
+	}
+
+	result, err := r.copyLogicalCluster(ctx, source, target, cluster)
+	if err != nil {
+		conditions.MarkUnknown(workspace, tenancyv1alpha1.WorkspaceMigrated, tenancyv1alpha1.WorkspaceMigratedCopying, "Copying to shard %q failed: %v", target.Name, err)
+		return reconcileStatusContinue, err
+	}
+	if len(result.pending) > 0 {
+		pending := result.pending
+		if len(pending) > maxPendingInMessage {
+			pending = append(pending[:maxPendingInMessage:maxPendingInMessage], fmt.Sprintf("and %d more", len(result.pending)-maxPendingInMessage))
+		}
+		conditions.MarkUnknown(workspace, tenancyv1alpha1.WorkspaceMigrated, tenancyv1alpha1.WorkspaceMigratedCopying, "Copied %d objects to shard %q, waiting for: %s", result.copied, target.Name, strings.Join(pending, ", "))
+		r.requeueAfter(workspace, migrationPollInterval)
+		return reconcileStatusContinue, nil
+	}
+
+	// everything is copied, serve from the target shard from now on.
+	if targetLogicalCluster.Status.Phase != corev1alpha1.LogicalClusterPhaseReady {
+		targetLogicalCluster.Status.Phase = corev1alpha1.LogicalClusterPhaseReady
+		if targetLogicalCluster, err = targetClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().UpdateStatus(ctx, targetLogicalCluster, metav1.UpdateOptions{}); err != nil {
+			return reconcileStatusStopAndRequeue, err
+		}
+	}
+	// the source is marked first, such that its updates and its deletion during the clean-up do
+	// not point the front-proxy index back to the source shard.
+	if sourceLogicalCluster.Annotations[tenancyv1alpha1.LogicalClusterMigratedToAnnotationKey] != target.Name {
+		if sourceLogicalCluster.Annotations == nil {
+			sourceLogicalCluster.Annotations = map[string]string{}
+		}
+		sourceLogicalCluster.Annotations[tenancyv1alpha1.LogicalClusterMigratedToAnnotationKey] = target.Name
+		if _, err := sourceClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Update(ctx, sourceLogicalCluster, metav1.UpdateOptions{}); err != nil {
+			return reconcileStatusStopAndRequeue, err
+		}
+	}
+	if _, found := targetLogicalCluster.Annotations[tenancyv1alpha1.LogicalClusterMigrationSourceAnnotationKey]; found {
+		delete(targetLogicalCluster.Annotations, tenancyv1alpha1.LogicalClusterMigrationSourceAnnotationKey)
+		logging.WithObject(logger, targetLogicalCluster).Info("serving migrated LogicalCluster", "shard", target.Name)
+		if _, err := targetClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Update(ctx, targetLogicalCluster, metav1.UpdateOptions{}); err != nil {
+			return reconcileStatusStopAndRequeue, err
+		}
+	}
+
+	workspace.Annotations[WorkspaceShardHashAnnotationKey] = ByBase36Sha224NameValue(target.Name)
+	if region, found := target.Labels["region"]; found {
+		workspace.Labels["region"] = region
+	} else {
+		delete(workspace.Labels, "region")
+	}
+	conditions.MarkUnknown(workspace, tenancyv1alpha1.WorkspaceMigrated, tenancyv1alpha1.WorkspaceMigratedCleaningUp, "Serving from shard %q, removing the logical cluster from shard %q", target.Name, source.Name)
+
+	return reconcileStatusStopAndRequeue, nil
+}
+
+func (r *migrationReconciler) createTargetLogicalCluster(ctx context.Context, targetClient kcpclientset.ClusterInterface, cluster logicalcluster.Path, source *corev1alpha1.Shard, sourceLogicalCluster *corev1alpha1.LogicalCluster) (*corev1alpha1.LogicalCluster, error) {
+	logicalCluster := &corev1alpha1.LogicalCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        corev1alpha1.LogicalClusterName,
+			Labels:      sourceLogicalCluster.Labels,
+			Annotations: map[string]string{},
+		},
+		Spec: *sourceLogicalCluster.Spec.DeepCopy(),
+	}
+	for k, v := range sourceLogicalCluster.Annotations {
+		if k != logicalcluster.AnnotationKey && k != tenancyv1alpha1.LogicalClusterMigratedToAnnotationKey {
+			logicalCluster.Annotations[k] = v
+		}
+	}
+	logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterMigrationSourceAnnotationKey] = source.Name
+
+	logging.WithObject(klog.FromContext(ctx), logicalCluster).Info("creating LogicalCluster on migration target")
+	created, err := targetClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Create(ctx, logicalCluster, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// initialization has happened on the source shard already.
+	created.Status.Phase = corev1alpha1.LogicalClusterPhaseMigrating
+	created.Status.Initializers = nil
+	created.Status.Terminators = sourceLogicalCluster.Status.Terminators
+	created.Status.Conditions = sourceLogicalCluster.Status.Conditions
+	return targetClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().UpdateStatus(ctx, created, metav1.UpdateOptions{})
+}
+
+// cleanUpSource deletes the LogicalCluster on the source shard after the workspace has been flipped over.
+func (r *migrationReconciler) cleanUpSource(ctx context.Context, workspace *tenancyv1alpha1.Workspace, sourceHash string) (reconcileStatus, error) {
+	logger := klog.FromContext(ctx)
+	cluster := logicalcluster.NewPath(workspace.Spec.Cluster)
+
+	source, err := r.getShardByHash(sourceHash)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return reconcileStatusStopAndRequeue, err
+	}
+	if source != nil {
+		sourceClient, err := r.kcpLogicalClusterAdminClientFor(source)
+		if err != nil {
+			return reconcileStatusStopAndRequeue, err
+		}
+		logicalCluster, err := sourceClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return reconcileStatusStopAndRequeue, err
+		}
+		if err == nil {
+			if logicalCluster.DeletionTimestamp.IsZero() {
+				// terminators are for the deletion of the workspace, not for the removal of a copy.
+				if len(logicalCluster.Status.Terminators) > 0 {
+					logicalCluster.Status.Terminators = nil
+					if _, err := sourceClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().UpdateStatus(ctx, logicalCluster, metav1.UpdateOptions{}); err != nil {
+						return reconcileStatusStopAndRequeue, err
+					}
+				}
+				logging.WithObject(logger, logicalCluster).Info("deleting migrated LogicalCluster", "shard", source.Name)
+				if err := sourceClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Delete(ctx, corev1alpha1.LogicalClusterName, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+					return reconcileStatusStopAndRequeue, err
+				}
+			}
+			r.requeueAfter(workspace, migrationPollInterval)
+			return reconcileStatusContinue, nil
+		}
+	}
+
+	logger.Info("migration finished")
+	delete(workspace.Annotations, workspaceMigrationSourceAnnotationKey)
+	delete(workspace.Annotations, workspaceMigrationTargetAnnotationKey)
+	workspace.Status.Phase = corev1alpha1.LogicalClusterPhaseReady
+	conditions.MarkTrue(workspace, tenancyv1alpha1.WorkspaceMigrated)
+
+	return reconcileStatusStopAndRequeue, nil
+}
+
+// abort removes the incomplete copy on the target shard of a workspace that is deleted while it is migrated.
+func (r *migrationReconciler) abort(ctx context.Context, workspace *tenancyv1alpha1.Workspace) (reconcileStatus, error) {
+	target, err := r.getShard(migrationTarget(workspace))
+	if apierrors.IsNotFound(err) {
+		return reconcileStatusContinue, nil
+	} else if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	targetClient, err := r.kcpLogicalClusterAdminClientFor(target)
+	if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+
+	cluster := logicalcluster.NewPath(workspace.Spec.Cluster)
+	logicalCluster, err := targetClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return reconcileStatusContinue, nil
+	} else if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	if _, found := logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterMigrationSourceAnnotationKey]; !found || !logicalCluster.DeletionTimestamp.IsZero() {
+		return reconcileStatusContinue, nil
+	}
+
+	logging.WithObject(klog.FromContext(ctx), logicalCluster).Info("deleting incomplete copy of LogicalCluster", "shard", target.Name)
+	if err := targetClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Delete(ctx, corev1alpha1.LogicalClusterName, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return reconcileStatusStopAndRequeue, err
+	}
+	return reconcileStatusContinue, nil
+}
+
+// migrationTarget returns the name of the shard the workspace is migrated to, or requested to live on.
+func migrationTarget(workspace *tenancyv1alpha1.Workspace) string {
+	if target, found := workspace.Annotations[workspaceMigrationTargetAnnotationKey]; found {
+		return target
+	}
+	if workspace.Spec.Location == nil {
+		return ""
+	}
+	return workspace.Spec.Location.Shard
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+	kcpfakeclient "github.com/kcp-dev/sdk/client/clientset/versioned/cluster/fake"
+)
+
+type migrationTestEnv struct {
+	shards   []*corev1alpha1.Shard
+	clients  map[string]*kcpfakeclient.ClusterClientset
+	pending  []string
+	requeues int
+}
+
+func newMigrationTestEnv(sourceObjects ...runtime.Object) *migrationTestEnv {
+	target := shard("target")
+	target.Labels["region"] = "eu"
+	return &migrationTestEnv{
+		shards: []*corev1alpha1.Shard{shard("source"), target},
+		clients: map[string]*kcpfakeclient.ClusterClientset{
+			"source": kcpfakeclient.NewSimpleClientset(sourceObjects...),
+			"target": kcpfakeclient.NewSimpleClientset(),
+		},
+	}
+}
+
+func (e *migrationTestEnv) reconciler() *migrationReconciler {
+	return &migrationReconciler{
+		getShard: func(name string) (*corev1alpha1.Shard, error) {
+			for _, shard := range e.shards {
+				if shard.Name == name {
+					return shard, nil
+				}
+			}
+			return nil, kerrors.NewNotFound(corev1alpha1.Resource("shards"), name)
+		},
+		getShardByHash: func(hash string) (*corev1alpha1.Shard, error) {
+			for _, shard := range e.shards {
+				if ByBase36Sha224NameValue(shard.Name) == hash {
+					return shard, nil
+				}
+			}
+			return nil, kerrors.NewNotFound(corev1alpha1.Resource("shards"), hash)
+		},
+		listShards: func(selector labels.Selector) ([]*corev1alpha1.Shard, error) {
+			return e.shards, nil
+		},
+		kcpLogicalClusterAdminClientFor: func(shard *corev1alpha1.Shard) (kcpclientset.ClusterInterface, error) {
+			return e.clients[shard.Name], nil
+		},
+		copyLogicalCluster: func(ctx context.Context, source, target *corev1alpha1.Shard, cluster logicalcluster.Path) (*copyResult, error) {
+			return &copyResult{copied: 3, pending: e.pending}, nil
+		},
+		requeueAfter: func(workspace *tenancyv1alpha1.Workspace, after time.Duration) {
+			e.requeues++
+		},
+	}
+}
+
+func (e *migrationTestEnv) logicalCluster(t *testing.T, shard string) *corev1alpha1.LogicalCluster {
+	t.Helper()
+	lc, err := e.clients[shard].Cluster(logicalcluster.NewPath("c1")).CoreV1alpha1().LogicalClusters().Get(context.Background(), corev1alpha1.LogicalClusterName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	require.NoError(t, err)
+	return lc
+}
+
+func readyWorkspaceOnShard(shard string) *tenancyv1alpha1.Workspace {
+	ws := workspace("foo")
+	ws.Labels = map[string]string{}
+	ws.Annotations[WorkspaceShardHashAnnotationKey] = ByBase36Sha224NameValue(shard)
+	ws.Spec.Cluster = "c1"
+	ws.Spec.URL = "https://" + shard + "/clusters/root:foo"
+	ws.Status.Phase = corev1alpha1.LogicalClusterPhaseReady
+	return ws
+}
+
+func migratedLogicalCluster() *corev1alpha1.LogicalCluster {
+	return &corev1alpha1.LogicalCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        corev1alpha1.LogicalClusterName,
+			Annotations: map[string]string{logicalcluster.AnnotationKey: "c1", "kcp.io/path": "root:foo"},
+		},
+		Spec: corev1alpha1.LogicalClusterSpec{
+			Owner: &corev1alpha1.LogicalClusterOwner{Resource: "workspaces", Name: "foo", Cluster: "root"},
+		},
+		Status: corev1alpha1.LogicalClusterStatus{
+			Phase:       corev1alpha1.LogicalClusterPhaseReady,
+			Terminators: []corev1alpha1.LogicalClusterTerminator{"root:universal"},
+		},
+	}
+}
+
+func TestReconcileMigration(t *testing.T) {
+	ctx := context.Background()
+	env := newMigrationTestEnv(migratedLogicalCluster())
+	r := env.reconciler()
+
+	ws := readyWorkspaceOnShard("source")
+	status, err := r.reconcile(ctx, ws)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusContinue, status, "nothing to do without a target shard")
+	require.Nil(t, conditions.Get(ws, tenancyv1alpha1.WorkspaceMigrated))
+
+	t.Log("Request the migration")
+	ws.Spec.Location.Shard = "target"
+	status, err = r.reconcile(ctx, ws)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusStopAndRequeue, status)
+	require.Equal(t, corev1alpha1.LogicalClusterPhaseMigrating, ws.Status.Phase)
+	require.Equal(t, ByBase36Sha224NameValue("source"), ws.Annotations[workspaceMigrationSourceAnnotationKey])
+	require.Equal(t, tenancyv1alpha1.WorkspaceMigratedCopying, conditions.GetReason(ws, tenancyv1alpha1.WorkspaceMigrated))
+
+	t.Log("Copy with pending objects")
+	env.pending = []string{"a", "b", "c", "d"}
+	status, err = r.reconcile(ctx, ws)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusContinue, status)
+	require.Equal(t, 1, env.requeues)
+	require.Equal(t, `Copied 3 objects to shard "target", waiting for: a, b, c, and 1 more`, conditions.GetMessage(ws, tenancyv1alpha1.WorkspaceMigrated))
+	require.Equal(t, corev1alpha1.LogicalClusterPhaseMigrating, env.logicalCluster(t, "source").Status.Phase)
+	targetLC := env.logicalCluster(t, "target")
+	require.NotNil(t, targetLC)
+	require.Equal(t, "source", targetLC.Annotations[tenancyv1alpha1.LogicalClusterMigrationSourceAnnotationKey])
+	require.Equal(t, "root:foo", targetLC.Annotations["kcp.io/path"])
+	require.Equal(t, corev1alpha1.LogicalClusterPhaseMigrating, targetLC.Status.Phase)
+	require.Equal(t, []corev1alpha1.LogicalClusterTerminator{"root:universal"}, targetLC.Status.Terminators)
+
+	t.Log("Copy completes and the workspace flips over to the target")
+	env.pending = nil
+	status, err = r.reconcile(ctx, ws)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusStopAndRequeue, status)
+	require.Equal(t, ByBase36Sha224NameValue("target"), ws.Annotations[WorkspaceShardHashAnnotationKey])
+	require.Equal(t, "eu", ws.Labels["region"])
+	require.Equal(t, tenancyv1alpha1.WorkspaceMigratedCleaningUp, conditions.GetReason(ws, tenancyv1alpha1.WorkspaceMigrated))
+	targetLC = env.logicalCluster(t, "target")
+	require.Equal(t, corev1alpha1.LogicalClusterPhaseReady, targetLC.Status.Phase)
+	require.NotContains(t, targetLC.Annotations, tenancyv1alpha1.LogicalClusterMigrationSourceAnnotationKey)
+	require.Equal(t, "target", env.logicalCluster(t, "source").Annotations[tenancyv1alpha1.LogicalClusterMigratedToAnnotationKey])
+
+	t.Log("The source is removed")
+	status, err = r.reconcile(ctx, ws)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusContinue, status)
+	require.Equal(t, 2, env.requeues)
+	require.Nil(t, env.logicalCluster(t, "source"))
+
+	t.Log("The migration finishes")
+	status, err = r.reconcile(ctx, ws)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusStopAndRequeue, status)
+	require.Equal(t, corev1alpha1.LogicalClusterPhaseReady, ws.Status.Phase)
+	require.NotContains(t, ws.Annotations, workspaceMigrationSourceAnnotationKey)
+	require.True(t, conditions.IsTrue(ws, tenancyv1alpha1.WorkspaceMigrated))
+
+	status, err = r.reconcile(ctx, ws)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusContinue, status, "the target shard is the current shard")
+}
+
+func TestReconcileMigrationRefused(t *testing.T) {
+	tests := map[string]struct {
+		target         string
+		sourceObjects  []runtime.Object
+		expectedReason string
+	}{
+		"unknown shard": {
+			target:         "unknown",
+			expectedReason: tenancyv1alpha1.WorkspaceMigratedInvalidTarget,
+		},
+		"child workspaces": {
+			target: "target",
+			sourceObjects: []runtime.Object{&tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{Name: "child", Annotations: map[string]string{logicalcluster.AnnotationKey: "c1"}},
+			}},
+			expectedReason: tenancyv1alpha1.WorkspaceMigratedNotSupported,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newMigrationTestEnv(append(tc.sourceObjects, migratedLogicalCluster())...)
+			ws := readyWorkspaceOnShard("source")
+			ws.Spec.Location.Shard = tc.target
+
+			status, err := env.reconciler().reconcile(context.Background(), ws)
+			require.NoError(t, err)
+			require.Equal(t, reconcileStatusContinue, status)
+			require.Equal(t, corev1alpha1.LogicalClusterPhaseReady, ws.Status.Phase)
+			require.NotContains(t, ws.Annotations, workspaceMigrationSourceAnnotationKey)
+			require.Equal(t, corev1.ConditionFalse, conditions.Get(ws, tenancyv1alpha1.WorkspaceMigrated).Status)
+			require.Equal(t, tc.expectedReason, conditions.GetReason(ws, tenancyv1alpha1.WorkspaceMigrated))
+
+			t.Log("Withdrawing the request removes the condition")
+			ws.Spec.Location.Shard = ""
+			_, err = env.reconciler().reconcile(context.Background(), ws)
+			require.NoError(t, err)
+			require.Nil(t, conditions.Get(ws, tenancyv1alpha1.WorkspaceMigrated))
+		})
+	}
+}
+
+func TestReconcileMigrationDrain(t *testing.T) {
+	t.Run("workspaces move away from a draining shard", func(t *testing.T) {
+		env := newMigrationTestEnv(migratedLogicalCluster())
+		env.shards[0].Spec.Drain = true
+		ws := readyWorkspaceOnShard("source")
+
+		status, err := env.reconciler().reconcile(context.Background(), ws)
+		require.NoError(t, err)
+		require.Equal(t, reconcileStatusStopAndRequeue, status)
+		require.Equal(t, corev1alpha1.LogicalClusterPhaseMigrating, ws.Status.Phase)
+		require.Equal(t, "target", ws.Annotations[workspaceMigrationTargetAnnotationKey])
+		require.Equal(t, "target", migrationTarget(ws))
+	})
+
+	t.Run("workspaces pinned to a draining shard stay", func(t *testing.T) {
+		env := newMigrationTestEnv(migratedLogicalCluster())
+		env.shards[0].Spec.Drain = true
+		ws := readyWorkspaceOnShard("source")
+		ws.Spec.Location.Shard = "source"
+
+		status, err := env.reconciler().reconcile(context.Background(), ws)
+		require.NoError(t, err)
+		require.Equal(t, reconcileStatusContinue, status)
+		require.Equal(t, corev1alpha1.LogicalClusterPhaseReady, ws.Status.Phase)
+	})
+
+	t.Run("no shard to move to", func(t *testing.T) {
+		env := newMigrationTestEnv(migratedLogicalCluster())
+		env.shards[0].Spec.Drain = true
+		env.shards[1].Spec.Unschedulable = true
+		ws := readyWorkspaceOnShard("source")
+
+		status, err := env.reconciler().reconcile(context.Background(), ws)
+		require.NoError(t, err)
+		require.Equal(t, reconcileStatusContinue, status)
+		require.Equal(t, corev1alpha1.LogicalClusterPhaseReady, ws.Status.Phase)
+		require.Equal(t, tenancyv1alpha1.WorkspaceMigratedUnschedulable, conditions.GetReason(ws, tenancyv1alpha1.WorkspaceMigrated))
+		require.Equal(t, `Shard "source" is drained: No available shards to schedule the workspace`, conditions.GetMessage(ws, tenancyv1alpha1.WorkspaceMigrated))
+	})
+}
@@ -0,0 +1,433 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+
+	indexrewriters "github.com/kcp-dev/kcp/pkg/index/rewriters"
+)
+
+const (
+	// movedWorkspaceGracePeriod is how long the old workspace of a move is kept after the
+	// move, and requests to its path are served from the new path.
+	movedWorkspaceGracePeriod = 24 * time.Hour
+
+	// movePollInterval is the interval in which a move waiting for the old workspace is retried.
+	movePollInterval = 10 * time.Second
+
+	// workspaceMoveSourcePathAnnotationKey keeps track of the canonical path of the logical cluster
+	// before it is taken over by a new workspace, to restore it if the move is rolled back.
+	workspaceMoveSourcePathAnnotationKey = "internal.tenancy.kcp.io/move-source-path"
+
+	// homeWorkspaceType is the type of home workspaces, which have no Workspace object.
+	homeWorkspaceType = "root:home"
+)
+
+// moveReconciler moves a workspace to another place in the workspace hierarchy, keeping its
+// logical cluster. The move is driven by the new workspace, created with spec.moveFrom:
+//  1. the new workspace is marked with the HandingOver reason of the Moved condition. From now
+//     on, deleting the new workspace hands the logical cluster back to the old workspace.
+//  2. the LogicalCluster is handed over to the new workspace, i.e. its owner and its canonical
+//     path annotation are updated in one step.
+//  3. the new workspace is pointed to the logical cluster, and is indexed under the new path.
+//  4. the old workspace is annotated with the new path, which makes the front-proxy serve the
+//     old path from the new path.
+//  5. after a grace period, the old workspace is deleted, leaving the logical cluster alone.
+//
+// Every step is idempotent, and a move interrupted between two steps continues with the step
+// it has not finished.
+//
+// Home workspaces have no Workspace object. When one is moved, there is nothing to annotate
+// and delete. The logical cluster keeps its name, so ~ and the user:<name> path keep
+// resolving to it.
+//
+// Child workspaces pick up the new canonical path when the scheduling reconciler recomputes
+// their URLs.
+type moveReconciler struct {
+	getWorkspace         func(ctx context.Context, cluster logicalcluster.Path, name string) (*tenancyv1alpha1.Workspace, error)
+	updateWorkspace      func(ctx context.Context, cluster logicalcluster.Path, workspace *tenancyv1alpha1.Workspace) error
+	deleteWorkspace      func(ctx context.Context, workspace *tenancyv1alpha1.Workspace) error
+	getLogicalCluster    func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error)
+	updateLogicalCluster func(ctx context.Context, cluster logicalcluster.Path, logicalCluster *corev1alpha1.LogicalCluster) error
+	listShards           func(selector labels.Selector) ([]*corev1alpha1.Shard, error)
+
+	// getParentLogicalCluster returns the local LogicalCluster a workspace lives in.
+	getParentLogicalCluster func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error)
+
+	requeueAfter func(workspace *tenancyv1alpha1.Workspace, after time.Duration)
+	now          func() time.Time
+}
+
+func (r *moveReconciler) reconcile(ctx context.Context, workspace *tenancyv1alpha1.Workspace) (reconcileStatus, error) {
+	logger := klog.FromContext(ctx).WithValues("reconciler", "move")
+	ctx = klog.NewContext(ctx, logger)
+
+	if !workspace.DeletionTimestamp.IsZero() {
+		return r.rollBack(ctx, workspace)
+	}
+	if movedTo, found := workspace.Annotations[tenancyv1alpha1.WorkspaceMovedToAnnotationKey]; found {
+		return r.reconcileMovedAway(ctx, workspace, movedTo)
+	}
+	if workspace.Spec.MoveFrom == "" {
+		return reconcileStatusContinue, nil
+	}
+
+	targetPath, err := r.canonicalPath(workspace)
+	if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	sourcePath := logicalcluster.NewPath(workspace.Spec.MoveFrom)
+	sourceParent, sourceName := sourcePath.Split()
+	homeSource := isHomeWorkspacePath(sourcePath)
+
+	if workspace.Spec.Cluster != "" {
+		// the logical cluster is ours, hand over the old path to us.
+		if conditions.IsTrue(workspace, tenancyv1alpha1.WorkspaceMoved) {
+			return reconcileStatusContinue, nil
+		}
+		if !homeSource {
+			source, err := r.getWorkspace(ctx, sourceParent, sourceName)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return reconcileStatusStopAndRequeue, err
+			}
+			if err == nil && source.Annotations[tenancyv1alpha1.WorkspaceMovedToAnnotationKey] != targetPath.String() {
+				source = source.DeepCopy()
+				if source.Annotations == nil {
+					source.Annotations = map[string]string{}
+				}
+				source.Annotations[tenancyv1alpha1.WorkspaceMovedToAnnotationKey] = targetPath.String()
+				if err := r.updateWorkspace(ctx, sourceParent, source); err != nil {
+					return reconcileStatusStopAndRequeue, err
+				}
+			}
+		}
+		logger.Info("moved workspace", "from", sourcePath, "to", targetPath)
+		delete(workspace.Annotations, workspaceMoveSourcePathAnnotationKey)
+		conditions.MarkTrue(workspace, tenancyv1alpha1.WorkspaceMoved)
+		return reconcileStatusContinue, nil
+	}
+
+	var (
+		source      *tenancyv1alpha1.Workspace // nil for home workspaces
+		clusterName logicalcluster.Name
+	)
+	if homeSource {
+		clusterName = indexrewriters.HomeClusterName(sourceName)
+	} else {
+		if sourceParent.Empty() {
+			conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceMoved, tenancyv1alpha1.WorkspaceMovedInvalidSource, conditionsv1alpha1.ConditionSeverityError, "Workspace %q has no parent and cannot be moved", sourcePath)
+			return reconcileStatusContinue, nil
+		}
+		source, err = r.getWorkspace(ctx, sourceParent, sourceName)
+		if apierrors.IsNotFound(err) {
+			conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceMoved, tenancyv1alpha1.WorkspaceMovedInvalidSource, conditionsv1alpha1.ConditionSeverityError, "Workspace %q does not exist", sourcePath)
+			return reconcileStatusContinue, nil
+		} else if err != nil {
+			return reconcileStatusStopAndRequeue, err
+		}
+		if message := invalidMoveSource(workspace, source, targetPath); message != "" {
+			conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceMoved, tenancyv1alpha1.WorkspaceMovedInvalidSource, conditionsv1alpha1.ConditionSeverityError, "Workspace %q cannot be moved: %s", sourcePath, message)
+			return reconcileStatusContinue, nil
+		}
+		if source.Status.Phase != corev1alpha1.LogicalClusterPhaseReady {
+			conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceMoved, tenancyv1alpha1.WorkspaceMovedInvalidSource, conditionsv1alpha1.ConditionSeverityInfo, "Waiting for workspace %q to be ready", sourcePath)
+			r.requeueAfter(workspace, movePollInterval)
+			return reconcileStatusContinue, nil
+		}
+		clusterName = logicalcluster.Name(source.Spec.Cluster)
+	}
+
+	clusterPath := clusterName.Path()
+	logicalCluster, err := r.getLogicalCluster(ctx, clusterPath)
+	if homeSource && apierrors.IsNotFound(err) {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceMoved, tenancyv1alpha1.WorkspaceMovedInvalidSource, conditionsv1alpha1.ConditionSeverityError, "Workspace %q does not exist", sourcePath)
+		return reconcileStatusContinue, nil
+	} else if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+
+	shardHash, url := "", ""
+	if homeSource {
+		if message := invalidHomeMoveSource(workspace, logicalCluster); message != "" {
+			conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceMoved, tenancyv1alpha1.WorkspaceMovedInvalidSource, conditionsv1alpha1.ConditionSeverityError, "Workspace %q cannot be moved: %s", sourcePath, message)
+			return reconcileStatusContinue, nil
+		}
+		if logicalCluster.Status.Phase != corev1alpha1.LogicalClusterPhaseReady {
+			conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceMoved, tenancyv1alpha1.WorkspaceMovedInvalidSource, conditionsv1alpha1.ConditionSeverityInfo, "Waiting for workspace %q to be ready", sourcePath)
+			r.requeueAfter(workspace, movePollInterval)
+			return reconcileStatusContinue, nil
+		}
+		shard, err := r.shardServing(logicalCluster.Status.URL)
+		if err != nil {
+			return reconcileStatusStopAndRequeue, err
+		}
+		if shard == nil {
+			conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceMoved, tenancyv1alpha1.WorkspaceMovedInvalidSource, conditionsv1alpha1.ConditionSeverityInfo, "Waiting for the shard of workspace %q", sourcePath)
+			r.requeueAfter(workspace, movePollInterval)
+			return reconcileStatusContinue, nil
+		}
+		shardHash, url = ByBase36Sha224NameValue(shard.Name), logicalCluster.Status.URL
+	} else {
+		shardHash, url = source.Annotations[WorkspaceShardHashAnnotationKey], source.Spec.URL
+	}
+
+	owner := logicalCluster.Spec.Owner
+	ownedBySource := (homeSource && owner == nil) || (!homeSource && owner != nil && owner.UID == source.UID)
+	ownedByUs := owner != nil && owner.UID == workspace.UID
+	if !ownedBySource && !ownedByUs {
+		conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceMoved, tenancyv1alpha1.WorkspaceMovedInvalidSource, conditionsv1alpha1.ConditionSeverityError, "LogicalCluster %s is not owned by workspace %q", clusterName, sourcePath)
+		return reconcileStatusContinue, nil
+	}
+	if ownedBySource {
+		current := logicalcluster.NewPath(logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey])
+		if current == targetPath || strings.HasPrefix(targetPath.String(), current.String()+":") {
+			conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceMoved, tenancyv1alpha1.WorkspaceMovedInvalidSource, conditionsv1alpha1.ConditionSeverityError, "Workspace %q cannot be moved into itself", sourcePath)
+			return reconcileStatusContinue, nil
+		}
+
+		// persist that the hand-over starts before touching the logical cluster, such that
+		// it can be rolled back when the new workspace is deleted before the move is finished.
+		if conditions.GetReason(workspace, tenancyv1alpha1.WorkspaceMoved) != tenancyv1alpha1.WorkspaceMovedHandingOver {
+			if workspace.Annotations == nil {
+				workspace.Annotations = map[string]string{}
+			}
+			workspace.Annotations[workspaceMoveSourcePathAnnotationKey] = current.String()
+			if !slices.Contains(workspace.Finalizers, corev1alpha1.LogicalClusterFinalizerName) {
+				workspace.Finalizers = append(workspace.Finalizers, corev1alpha1.LogicalClusterFinalizerName)
+			}
+			conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceMoved, tenancyv1alpha1.WorkspaceMovedHandingOver, conditionsv1alpha1.ConditionSeverityInfo, "Taking over LogicalCluster %s from workspace %q", clusterName, sourcePath)
+			return reconcileStatusStopAndRequeue, nil
+		}
+
+		// hand over the logical cluster. From now on the old workspace does not own it anymore.
+		logicalCluster = logicalCluster.DeepCopy()
+		logicalCluster.Spec.Owner = &corev1alpha1.LogicalClusterOwner{
+			APIVersion: tenancyv1alpha1.SchemeGroupVersion.String(),
+			Resource:   "workspaces",
+			Name:       workspace.Name,
+			Cluster:    logicalcluster.From(workspace).String(),
+			UID:        workspace.UID,
+		}
+		if logicalCluster.Annotations == nil {
+			logicalCluster.Annotations = map[string]string{}
+		}
+		logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey] = targetPath.String()
+		if homeSource && workspace.Spec.Type != nil {
+			logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterTypeAnnotationKey] = logicalcluster.NewPath(workspace.Spec.Type.Path).Join(string(workspace.Spec.Type.Name)).String()
+		}
+		if err := r.updateLogicalCluster(ctx, clusterPath, logicalCluster); err != nil {
+			return reconcileStatusStopAndRequeue, err
+		}
+	}
+
+	// point the workspace to the logical cluster. The URL is recomputed by the scheduling reconciler.
+	if workspace.Annotations == nil {
+		workspace.Annotations = map[string]string{}
+	}
+	workspace.Annotations[WorkspaceShardHashAnnotationKey] = shardHash
+	workspace.Annotations[workspaceClusterAnnotationKey] = clusterName.String()
+	if !slices.Contains(workspace.Finalizers, corev1alpha1.LogicalClusterFinalizerName) {
+		workspace.Finalizers = append(workspace.Finalizers, corev1alpha1.LogicalClusterFinalizerName)
+	}
+	workspace.Spec.Cluster = clusterName.String()
+	workspace.Spec.URL = url
+	conditions.MarkFalse(workspace, tenancyv1alpha1.WorkspaceMoved, tenancyv1alpha1.WorkspaceMovedHandingOver, conditionsv1alpha1.ConditionSeverityInfo, "Waiting for workspace %q to hand over its path", sourcePath)
+	return reconcileStatusStopAndRequeue, nil
+}
+
+// rollBack hands the logical cluster back to the old workspace if the new workspace is deleted
+// before the move is finished. The deletion reconciler then leaves the logical cluster alone,
+// because it is not owned by the deleted workspace anymore.
+func (r *moveReconciler) rollBack(ctx context.Context, workspace *tenancyv1alpha1.Workspace) (reconcileStatus, error) {
+	if workspace.Spec.MoveFrom == "" || conditions.GetReason(workspace, tenancyv1alpha1.WorkspaceMoved) != tenancyv1alpha1.WorkspaceMovedHandingOver {
+		return reconcileStatusContinue, nil
+	}
+
+	sourcePath := logicalcluster.NewPath(workspace.Spec.MoveFrom)
+	sourceParent, sourceName := sourcePath.Split()
+
+	var (
+		clusterName logicalcluster.Name
+		owner       *corev1alpha1.LogicalClusterOwner // nil for home workspaces
+	)
+	if isHomeWorkspacePath(sourcePath) {
+		clusterName = indexrewriters.HomeClusterName(sourceName)
+	} else {
+		source, err := r.getWorkspace(ctx, sourceParent, sourceName)
+		if apierrors.IsNotFound(err) {
+			return reconcileStatusContinue, nil // nobody to hand back to
+		} else if err != nil {
+			return reconcileStatusStopAndRequeue, err
+		}
+		clusterName = logicalcluster.Name(source.Spec.Cluster)
+		owner = &corev1alpha1.LogicalClusterOwner{
+			APIVersion: tenancyv1alpha1.SchemeGroupVersion.String(),
+			Resource:   "workspaces",
+			Name:       source.Name,
+			Cluster:    logicalcluster.From(source).String(),
+			UID:        source.UID,
+		}
+	}
+
+	logicalCluster, err := r.getLogicalCluster(ctx, clusterName.Path())
+	if apierrors.IsNotFound(err) {
+		return reconcileStatusContinue, nil
+	} else if err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	if logicalCluster.Spec.Owner == nil || logicalCluster.Spec.Owner.UID != workspace.UID {
+		return reconcileStatusContinue, nil // not handed over yet
+	}
+
+	logicalCluster = logicalCluster.DeepCopy()
+	logicalCluster.Spec.Owner = owner
+	if logicalCluster.Annotations == nil {
+		logicalCluster.Annotations = map[string]string{}
+	}
+	if path, found := workspace.Annotations[workspaceMoveSourcePathAnnotationKey]; found {
+		logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey] = path
+	}
+	if owner == nil {
+		logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterTypeAnnotationKey] = homeWorkspaceType
+	}
+	klog.FromContext(ctx).Info("handing LogicalCluster back to the old workspace of an unfinished move", "cluster", clusterName, "to", sourcePath)
+	if err := r.updateLogicalCluster(ctx, clusterName.Path(), logicalCluster); err != nil {
+		return reconcileStatusStopAndRequeue, err
+	}
+	return reconcileStatusContinue, nil
+}
+
+// shardServing returns the shard serving the given logical cluster URL, or nil if it is not known.
+func (r *moveReconciler) shardServing(url string) (*corev1alpha1.Shard, error) {
+	if url == "" {
+		return nil, nil
+	}
+	shards, err := r.listShards(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, shard := range shards {
+		if strings.HasPrefix(url, strings.TrimSuffix(shard.Spec.ExternalURL, "/")+"/clusters/") {
+			return shard, nil
+		}
+	}
+	return nil, nil
+}
+
+// reconcileMovedAway releases the logical cluster of a workspace that has been moved, and deletes
+// the workspace after the grace period.
+func (r *moveReconciler) reconcileMovedAway(ctx context.Context, workspace *tenancyv1alpha1.Workspace, movedTo string) (reconcileStatus, error) {
+	logger := klog.FromContext(ctx)
+
+	if slices.Contains(workspace.Finalizers, corev1alpha1.LogicalClusterFinalizerName) {
+		workspace.Finalizers = slices.DeleteFunc(workspace.Finalizers, func(f string) bool {
+			return f == corev1alpha1.LogicalClusterFinalizerName
+		})
+		return reconcileStatusStopAndRequeue, nil
+	}
+
+	cond := conditions.Get(workspace, tenancyv1alpha1.WorkspaceMoved)
+	if cond == nil || cond.Status != corev1.ConditionTrue || cond.Reason != tenancyv1alpha1.WorkspaceMovedRedirecting {
+		conditions.Set(workspace, &conditionsv1alpha1.Condition{
+			Type:    tenancyv1alpha1.WorkspaceMoved,
+			Status:  corev1.ConditionTrue,
+			Reason:  tenancyv1alpha1.WorkspaceMovedRedirecting,
+			Message: "Moved to " + movedTo,
+		})
+		r.requeueAfter(workspace, movedWorkspaceGracePeriod)
+		return reconcileStatusContinue, nil
+	}
+
+	if remaining := cond.LastTransitionTime.Add(movedWorkspaceGracePeriod).Sub(r.now()); remaining > 0 {
+		r.requeueAfter(workspace, remaining)
+		return reconcileStatusContinue, nil
+	}
+
+	logger.Info("deleting moved workspace after grace period", "movedTo", movedTo)
+	if err := r.deleteWorkspace(ctx, workspace); err != nil && !apierrors.IsNotFound(err) {
+		return reconcileStatusStopAndRequeue, err
+	}
+	return reconcileStatusContinue, nil
+}
+
+// canonicalPath returns the canonical path of the workspace, derived from the LogicalCluster it lives in.
+func (r *moveReconciler) canonicalPath(workspace *tenancyv1alpha1.Workspace) (logicalcluster.Path, error) {
+	parent, err := r.getParentLogicalCluster(logicalcluster.From(workspace))
+	if err != nil {
+		return logicalcluster.Path{}, err
+	}
+	if path := parent.Annotations[core.LogicalClusterPathAnnotationKey]; path != "" {
+		return logicalcluster.NewPath(path).Join(workspace.Name), nil
+	}
+	return logicalcluster.From(workspace).Path().Join(workspace.Name), nil
+}
+
+// isHomeWorkspacePath returns whether the path is the path of a home workspace, i.e. user:<name>.
+func isHomeWorkspacePath(path logicalcluster.Path) bool {
+	parent, _ := path.Split()
+	return parent.String() == "user"
+}
+
+// invalidHomeMoveSource returns why the home workspace with the given LogicalCluster cannot be
+// moved to the target workspace, or the empty string if it can.
+func invalidHomeMoveSource(target *tenancyv1alpha1.Workspace, logicalCluster *corev1alpha1.LogicalCluster) string {
+	switch {
+	case !logicalCluster.DeletionTimestamp.IsZero():
+		return "it is being deleted"
+	case logicalCluster.Spec.Owner == nil && logicalCluster.Annotations[tenancyv1alpha1.LogicalClusterTypeAnnotationKey] != homeWorkspaceType:
+		return "it is not a home workspace"
+	case logicalCluster.Spec.Owner != nil && logicalCluster.Spec.Owner.UID != target.UID:
+		return "it has been moved to " + logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey]
+	}
+	return ""
+}
+
+// invalidMoveSource returns why the source workspace cannot be moved to the target workspace,
+// or the empty string if it can.
+func invalidMoveSource(target, source *tenancyv1alpha1.Workspace, targetPath logicalcluster.Path) string {
+	switch {
+	case !source.DeletionTimestamp.IsZero():
+		return "it is being deleted"
+	case source.Spec.Mount != nil:
+		return "it is mounted"
+	case source.Spec.MoveFrom != "" && !conditions.IsTrue(source, tenancyv1alpha1.WorkspaceMoved):
+		return "it is being moved itself"
+	case source.Annotations[tenancyv1alpha1.WorkspaceMovedToAnnotationKey] != "" && source.Annotations[tenancyv1alpha1.WorkspaceMovedToAnnotationKey] != targetPath.String():
+		return "it has been moved to " + source.Annotations[tenancyv1alpha1.WorkspaceMovedToAnnotationKey]
+	case source.Spec.Cluster == "":
+		return "it has no logical cluster"
+	case target.Spec.Type == nil || source.Spec.Type == nil || target.Spec.Type.Name != source.Spec.Type.Name || target.Spec.Type.Path != source.Spec.Type.Path:
+		return "the workspace types differ"
+	}
+	return ""
+}
@@ -0,0 +1,414 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+
+	indexrewriters "github.com/kcp-dev/kcp/pkg/index/rewriters"
+)
+
+type fakeMoveWorld struct {
+	workspaces      map[string]*tenancyv1alpha1.Workspace // by parent path and name
+	logicalClusters map[string]*corev1alpha1.LogicalCluster
+	paths           map[logicalcluster.Name]string
+	deleted         []string
+	requeuedAfter   time.Duration
+	now             time.Time
+
+	updateLogicalClusterErr error
+}
+
+func (w *fakeMoveWorld) reconciler() *moveReconciler {
+	return &moveReconciler{
+		getWorkspace: func(ctx context.Context, cluster logicalcluster.Path, name string) (*tenancyv1alpha1.Workspace, error) {
+			ws, found := w.workspaces[cluster.Join(name).String()]
+			if !found {
+				return nil, apierrors.NewNotFound(tenancyv1alpha1.Resource("workspaces"), name)
+			}
+			return ws, nil
+		},
+		updateWorkspace: func(ctx context.Context, cluster logicalcluster.Path, workspace *tenancyv1alpha1.Workspace) error {
+			w.workspaces[cluster.Join(workspace.Name).String()] = workspace
+			return nil
+		},
+		deleteWorkspace: func(ctx context.Context, workspace *tenancyv1alpha1.Workspace) error {
+			w.deleted = append(w.deleted, workspace.Name)
+			return nil
+		},
+		getLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path) (*corev1alpha1.LogicalCluster, error) {
+			lc, found := w.logicalClusters[cluster.String()]
+			if !found {
+				return nil, apierrors.NewNotFound(corev1alpha1.Resource("logicalclusters"), corev1alpha1.LogicalClusterName)
+			}
+			return lc, nil
+		},
+		updateLogicalCluster: func(ctx context.Context, cluster logicalcluster.Path, logicalCluster *corev1alpha1.LogicalCluster) error {
+			if w.updateLogicalClusterErr != nil {
+				return w.updateLogicalClusterErr
+			}
+			w.logicalClusters[cluster.String()] = logicalCluster
+			return nil
+		},
+		listShards: func(selector labels.Selector) ([]*corev1alpha1.Shard, error) {
+			root := shard("root")
+			root.Spec.ExternalURL = "https://root/"
+			return []*corev1alpha1.Shard{root}, nil
+		},
+		getParentLogicalCluster: func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error) {
+			return &corev1alpha1.LogicalCluster{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"kcp.io/path": w.paths[clusterName]}}}, nil
+		},
+		requeueAfter: func(workspace *tenancyv1alpha1.Workspace, after time.Duration) {
+			w.requeuedAfter = after
+		},
+		now: func() time.Time { return w.now },
+	}
+}
+
+func newFakeMoveWorld() (*fakeMoveWorld, *tenancyv1alpha1.Workspace, *tenancyv1alpha1.Workspace) {
+	source := workspace("team")
+	source.UID = "source"
+	source.Annotations["kcp.io/cluster"] = "org1"
+	source.Annotations[WorkspaceShardHashAnnotationKey] = ByBase36Sha224NameValue("root")
+	source.Finalizers = []string{corev1alpha1.LogicalClusterFinalizerName}
+	source.Spec.Cluster = "34"
+	source.Spec.URL = "https://root/clusters/34"
+	source.Spec.Type = &tenancyv1alpha1.WorkspaceTypeReference{Name: "universal", Path: "root"}
+	source.Status.Phase = corev1alpha1.LogicalClusterPhaseReady
+
+	target := workspace("team")
+	target.UID = "target"
+	target.Annotations["kcp.io/cluster"] = "org2"
+	target.Spec.MoveFrom = "root:org1:team"
+	target.Spec.Type = &tenancyv1alpha1.WorkspaceTypeReference{Name: "universal", Path: "root"}
+
+	w := &fakeMoveWorld{
+		workspaces: map[string]*tenancyv1alpha1.Workspace{"root:org1:team": source},
+		logicalClusters: map[string]*corev1alpha1.LogicalCluster{"34": {
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"kcp.io/path": "root:org1:team"}},
+			Spec:       corev1alpha1.LogicalClusterSpec{Owner: &corev1alpha1.LogicalClusterOwner{Name: "team", Cluster: "org1", UID: "source"}},
+		}},
+		paths: map[logicalcluster.Name]string{"org1": "root:org1", "org2": "root:org2", "34": "root:org1:team"},
+		now:   time.Now(),
+	}
+	return w, source, target
+}
+
+func TestMoveReconciler(t *testing.T) {
+	ctx := context.Background()
+	w, source, target := newFakeMoveWorld()
+	r := w.reconciler()
+
+	// the hand-over is recorded before the logical cluster is touched
+	status, err := r.reconcile(ctx, target)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusStopAndRequeue, status)
+	require.Equal(t, tenancyv1alpha1.WorkspaceMovedHandingOver, conditions.GetReason(target, tenancyv1alpha1.WorkspaceMoved))
+	require.Equal(t, "root:org1:team", target.Annotations[workspaceMoveSourcePathAnnotationKey])
+	require.Contains(t, target.Finalizers, corev1alpha1.LogicalClusterFinalizerName)
+	require.Equal(t, types.UID("source"), w.logicalClusters["34"].Spec.Owner.UID)
+	require.Empty(t, target.Spec.Cluster)
+
+	// the logical cluster is handed over to the new workspace
+	status, err = r.reconcile(ctx, target)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusStopAndRequeue, status)
+	lc := w.logicalClusters["34"]
+	require.Equal(t, types.UID("target"), lc.Spec.Owner.UID)
+	require.Equal(t, "org2", lc.Spec.Owner.Cluster)
+	require.Equal(t, "root:org2:team", lc.Annotations["kcp.io/path"])
+	require.Equal(t, "34", target.Spec.Cluster)
+	require.Equal(t, "34", target.Annotations[workspaceClusterAnnotationKey])
+	require.Equal(t, source.Annotations[WorkspaceShardHashAnnotationKey], target.Annotations[WorkspaceShardHashAnnotationKey])
+	require.Contains(t, target.Finalizers, corev1alpha1.LogicalClusterFinalizerName)
+	require.False(t, conditions.IsTrue(target, tenancyv1alpha1.WorkspaceMoved))
+
+	// the old workspace redirects to the new path
+	status, err = r.reconcile(ctx, target)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusContinue, status)
+	require.True(t, conditions.IsTrue(target, tenancyv1alpha1.WorkspaceMoved))
+	require.NotContains(t, target.Annotations, workspaceMoveSourcePathAnnotationKey)
+	source = w.workspaces["root:org1:team"]
+	require.Equal(t, "root:org2:team", source.Annotations[tenancyv1alpha1.WorkspaceMovedToAnnotationKey])
+
+	// the old workspace releases the logical cluster
+	status, err = r.reconcile(ctx, source)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusStopAndRequeue, status)
+	require.NotContains(t, source.Finalizers, corev1alpha1.LogicalClusterFinalizerName)
+
+	status, err = r.reconcile(ctx, source)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusContinue, status)
+	cond := conditions.Get(source, tenancyv1alpha1.WorkspaceMoved)
+	require.NotNil(t, cond)
+	require.Equal(t, corev1.ConditionTrue, cond.Status)
+	require.Equal(t, tenancyv1alpha1.WorkspaceMovedRedirecting, cond.Reason)
+	require.Equal(t, movedWorkspaceGracePeriod, w.requeuedAfter)
+	require.Empty(t, w.deleted)
+
+	// and is deleted after the grace period
+	for i := range source.Status.Conditions {
+		source.Status.Conditions[i].LastTransitionTime = metav1.NewTime(w.now.Add(-movedWorkspaceGracePeriod - time.Minute))
+	}
+	status, err = r.reconcile(ctx, source)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusContinue, status)
+	require.Equal(t, []string{"team"}, w.deleted)
+}
+
+func TestMoveReconcilerInvalidSource(t *testing.T) {
+	tests := map[string]struct {
+		mutate          func(w *fakeMoveWorld, source, target *tenancyv1alpha1.Workspace)
+		expectedMessage string
+		expectRequeue   bool
+	}{
+		"source does not exist": {
+			mutate: func(w *fakeMoveWorld, source, target *tenancyv1alpha1.Workspace) {
+				target.Spec.MoveFrom = "root:org1:other"
+			},
+			expectedMessage: `Workspace "root:org1:other" does not exist`,
+		},
+		"types differ": {
+			mutate: func(w *fakeMoveWorld, source, target *tenancyv1alpha1.Workspace) {
+				target.Spec.Type = &tenancyv1alpha1.WorkspaceTypeReference{Name: "team", Path: "root"}
+			},
+			expectedMessage: `Workspace "root:org1:team" cannot be moved: the workspace types differ`,
+		},
+		"source is mounted": {
+			mutate: func(w *fakeMoveWorld, source, target *tenancyv1alpha1.Workspace) {
+				source.Spec.Mount = &tenancyv1alpha1.Mount{}
+			},
+			expectedMessage: `Workspace "root:org1:team" cannot be moved: it is mounted`,
+		},
+		"source moved elsewhere": {
+			mutate: func(w *fakeMoveWorld, source, target *tenancyv1alpha1.Workspace) {
+				source.Annotations[tenancyv1alpha1.WorkspaceMovedToAnnotationKey] = "root:org3:team"
+			},
+			expectedMessage: `Workspace "root:org1:team" cannot be moved: it has been moved to root:org3:team`,
+		},
+		"into itself": {
+			mutate: func(w *fakeMoveWorld, source, target *tenancyv1alpha1.Workspace) {
+				target.Annotations["kcp.io/cluster"] = "34"
+			},
+			expectedMessage: `Workspace "root:org1:team" cannot be moved into itself`,
+		},
+		"logical cluster owned by someone else": {
+			mutate: func(w *fakeMoveWorld, source, target *tenancyv1alpha1.Workspace) {
+				w.logicalClusters["34"].Spec.Owner.UID = "other"
+			},
+			expectedMessage: `LogicalCluster 34 is not owned by workspace "root:org1:team"`,
+		},
+		"source not ready": {
+			mutate: func(w *fakeMoveWorld, source, target *tenancyv1alpha1.Workspace) {
+				source.Status.Phase = corev1alpha1.LogicalClusterPhaseInitializing
+			},
+			expectedMessage: `Waiting for workspace "root:org1:team" to be ready`,
+			expectRequeue:   true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			w, source, target := newFakeMoveWorld()
+			tc.mutate(w, source, target)
+			owner := w.logicalClusters["34"].Spec.Owner.UID
+
+			status, err := w.reconciler().reconcile(context.Background(), target)
+			require.NoError(t, err)
+			require.Equal(t, reconcileStatusContinue, status)
+			require.Empty(t, target.Spec.Cluster)
+			require.Equal(t, owner, w.logicalClusters["34"].Spec.Owner.UID)
+			require.Equal(t, "root:org1:team", w.logicalClusters["34"].Annotations["kcp.io/path"])
+
+			cond := conditions.Get(target, tenancyv1alpha1.WorkspaceMoved)
+			require.NotNil(t, cond)
+			require.Equal(t, corev1.ConditionFalse, cond.Status)
+			require.Equal(t, tc.expectedMessage, cond.Message)
+			require.Equal(t, tc.expectRequeue, w.requeuedAfter == movePollInterval)
+		})
+	}
+}
+
+func TestMoveReconcilerInterrupted(t *testing.T) {
+	ctx := context.Background()
+	w, source, target := newFakeMoveWorld()
+	r := w.reconciler()
+
+	status, err := r.reconcile(ctx, target)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusStopAndRequeue, status)
+
+	t.Log("A failing hand-over leaves the logical cluster and the workspace as they are")
+	w.updateLogicalClusterErr = errors.New("boom")
+	status, err = r.reconcile(ctx, target)
+	require.Error(t, err)
+	require.Equal(t, reconcileStatusStopAndRequeue, status)
+	require.Equal(t, types.UID("source"), w.logicalClusters["34"].Spec.Owner.UID)
+	require.Empty(t, target.Spec.Cluster)
+	w.updateLogicalClusterErr = nil
+
+	t.Log("The workspace update is lost after the hand-over, e.g. by a crash")
+	persisted := target.DeepCopy()
+	status, err = r.reconcile(ctx, target)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusStopAndRequeue, status)
+	require.Equal(t, types.UID("target"), w.logicalClusters["34"].Spec.Owner.UID)
+	require.Empty(t, w.workspaces["root:org1:team"].Annotations[tenancyv1alpha1.WorkspaceMovedToAnnotationKey], "the old workspace must not redirect yet")
+
+	t.Log("The move resumes with the logical cluster already handed over")
+	target = persisted
+	status, err = r.reconcile(ctx, target)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusStopAndRequeue, status)
+	require.Equal(t, "34", target.Spec.Cluster)
+	require.Equal(t, source.Annotations[WorkspaceShardHashAnnotationKey], target.Annotations[WorkspaceShardHashAnnotationKey])
+
+	status, err = r.reconcile(ctx, target)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusContinue, status)
+	require.True(t, conditions.IsTrue(target, tenancyv1alpha1.WorkspaceMoved))
+	require.Equal(t, "root:org2:team", w.workspaces["root:org1:team"].Annotations[tenancyv1alpha1.WorkspaceMovedToAnnotationKey])
+}
+
+func TestMoveReconcilerRollBack(t *testing.T) {
+	ctx := context.Background()
+	w, _, target := newFakeMoveWorld()
+	r := w.reconciler()
+
+	for range 2 {
+		status, err := r.reconcile(ctx, target)
+		require.NoError(t, err)
+		require.Equal(t, reconcileStatusStopAndRequeue, status)
+	}
+	require.Equal(t, types.UID("target"), w.logicalClusters["34"].Spec.Owner.UID)
+
+	t.Log("Deleting the new workspace before the move is finished hands the logical cluster back")
+	now := metav1.Now()
+	target.DeletionTimestamp = &now
+	status, err := r.reconcile(ctx, target)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusContinue, status)
+	lc := w.logicalClusters["34"]
+	require.Equal(t, types.UID("source"), lc.Spec.Owner.UID)
+	require.Equal(t, "team", lc.Spec.Owner.Name)
+	require.Equal(t, "org1", lc.Spec.Owner.Cluster)
+	require.Equal(t, "root:org1:team", lc.Annotations["kcp.io/path"])
+	require.Empty(t, w.workspaces["root:org1:team"].Annotations[tenancyv1alpha1.WorkspaceMovedToAnnotationKey])
+
+	t.Log("A finished move is not rolled back")
+	w, _, target = newFakeMoveWorld()
+	r = w.reconciler()
+	for range 3 {
+		_, err := r.reconcile(ctx, target)
+		require.NoError(t, err)
+	}
+	require.True(t, conditions.IsTrue(target, tenancyv1alpha1.WorkspaceMoved))
+	target.DeletionTimestamp = &now
+	_, err = r.reconcile(ctx, target)
+	require.NoError(t, err)
+	require.Equal(t, types.UID("target"), w.logicalClusters["34"].Spec.Owner.UID)
+}
+
+func TestMoveReconcilerHomeWorkspace(t *testing.T) {
+	ctx := context.Background()
+	w, _, target := newFakeMoveWorld()
+	r := w.reconciler()
+
+	home := indexrewriters.HomeClusterName("alice")
+	w.logicalClusters[home.String()] = &corev1alpha1.LogicalCluster{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			"kcp.io/path": "user:alice",
+			tenancyv1alpha1.LogicalClusterTypeAnnotationKey: "root:home",
+		}},
+		Status: corev1alpha1.LogicalClusterStatus{
+			Phase: corev1alpha1.LogicalClusterPhaseReady,
+			URL:   "https://root/clusters/" + home.String(),
+		},
+	}
+	target.Spec.MoveFrom = "user:alice"
+
+	for range 2 {
+		status, err := r.reconcile(ctx, target)
+		require.NoError(t, err)
+		require.Equal(t, reconcileStatusStopAndRequeue, status)
+	}
+	lc := w.logicalClusters[home.String()]
+	require.Equal(t, types.UID("target"), lc.Spec.Owner.UID)
+	require.Equal(t, "root:org2:team", lc.Annotations["kcp.io/path"])
+	require.Equal(t, "root:universal", lc.Annotations[tenancyv1alpha1.LogicalClusterTypeAnnotationKey])
+	require.Equal(t, home.String(), target.Spec.Cluster)
+	require.Equal(t, ByBase36Sha224NameValue("root"), target.Annotations[WorkspaceShardHashAnnotationKey])
+
+	status, err := r.reconcile(ctx, target)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusContinue, status)
+	require.True(t, conditions.IsTrue(target, tenancyv1alpha1.WorkspaceMoved))
+
+	t.Log("Moving the home workspace again fails")
+	other := target.DeepCopy()
+	other.UID = "other"
+	other.Spec.Cluster = ""
+	other.Status.Conditions = nil
+	status, err = r.reconcile(ctx, other)
+	require.NoError(t, err)
+	require.Equal(t, reconcileStatusContinue, status)
+	require.Equal(t, `Workspace "user:alice" cannot be moved: it has been moved to root:org2:team`, conditions.GetMessage(other, tenancyv1alpha1.WorkspaceMoved))
+
+	t.Log("An unfinished move of a home workspace is rolled back")
+	w, _, target = newFakeMoveWorld()
+	r = w.reconciler()
+	w.logicalClusters[home.String()] = &corev1alpha1.LogicalCluster{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			"kcp.io/path": "user:alice",
+			tenancyv1alpha1.LogicalClusterTypeAnnotationKey: "root:home",
+		}},
+		Status: corev1alpha1.LogicalClusterStatus{
+			Phase: corev1alpha1.LogicalClusterPhaseReady,
+			URL:   "https://root/clusters/" + home.String(),
+		},
+	}
+	target.Spec.MoveFrom = "user:alice"
+	for range 2 {
+		_, err := r.reconcile(ctx, target)
+		require.NoError(t, err)
+	}
+	now := metav1.Now()
+	target.DeletionTimestamp = &now
+	_, err = r.reconcile(ctx, target)
+	require.NoError(t, err)
+	lc = w.logicalClusters[home.String()]
+	require.Nil(t, lc.Spec.Owner)
+	require.Equal(t, "user:alice", lc.Annotations["kcp.io/path"])
+	require.Equal(t, "root:home", lc.Annotations[tenancyv1alpha1.LogicalClusterTypeAnnotationKey])
+}
@@ -24,10 +24,12 @@ import (
 	mathrand "math/rand"
 	"net/url"
 	"path"
+	"slices"
 	"strings"
 
 	"github.com/martinlindhe/base36"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -59,10 +61,6 @@ const (
 
 	// workspaceClusterAnnotationKey keeps track of the logical cluster on the shard.
 	workspaceClusterAnnotationKey = "internal.tenancy.kcp.io/cluster"
-
-	// unschedulableAnnotationKey is the annotation key used to indicate that a shard is unschedulable.
-	// The annotation is meant to be used by e2e tests that otherwise started a private instance of kcp server.
-	unschedulableAnnotationKey = "experimental.core.kcp.io/unschedulable"
 )
 
 type schedulingReconciler struct {
@@ -72,6 +70,8 @@ type schedulingReconciler struct {
 	getShardByHash func(hash string) (*corev1alpha1.Shard, error)
 	listShards     func(selector labels.Selector) ([]*corev1alpha1.Shard, error)
 
+	listWorkspaces func(clusterName logicalcluster.Name) ([]*tenancyv1alpha1.Workspace, error)
+
 	getWorkspaceType func(clusterName logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error)
 
 	getLogicalCluster func(clusterName logicalcluster.Name) (*corev1alpha1.LogicalCluster, error)
@@ -87,6 +87,9 @@ func (r *schedulingReconciler) reconcile(ctx context.Context, workspace *tenancy
 	if workspace.Spec.Mount != nil {
 		return reconcileStatusContinue, nil
 	}
+	if workspace.Spec.MoveFrom != "" && workspace.Spec.Cluster == "" {
+		return reconcileStatusContinue, nil // the move reconciler takes over an existing logical cluster
+	}
 
 	switch {
 	case !workspace.DeletionTimestamp.IsZero():
@@ -131,12 +134,17 @@ func (r *schedulingReconciler) reconcile(ctx context.Context, workspace *tenancy
 
 		u.Path = path.Join(u.Path, canonicalPath.RequestPath())
 		if workspace.Spec.URL != u.String() || workspace.Spec.Cluster != clusterName.String() {
+			// the canonical path changes when an ancestor workspace is moved. Pass it on to the
+			// logical cluster, which in turn makes the child workspaces in there follow.
+			if err := r.updateLogicalClusterPath(ctx, shard, clusterName.Path(), canonicalPath); err != nil {
+				return reconcileStatusStopAndRequeue, err
+			}
 			workspace.Spec.Cluster = clusterName.String()
 			workspace.Spec.URL = u.String()
 			return reconcileStatusStopAndRequeue, nil
 		}
 
-		conditions.MarkTrue(workspace, tenancyv1alpha1.WorkspaceScheduled)
+		markScheduled(workspace)
 		return reconcileStatusContinue, nil
 	case workspace.Spec.URL == "" || workspace.Spec.Cluster == "":
 		shardNameHash, hasShard := workspace.Annotations[WorkspaceShardHashAnnotationKey]
@@ -238,6 +246,24 @@ func (r *schedulingReconciler) reconcile(ctx context.Context, workspace *tenancy
 }
 
 func (r *schedulingReconciler) chooseShardAndMarkCondition(logger klog.Logger, workspace *tenancyv1alpha1.Workspace) (shard *corev1alpha1.Shard, reason string, err error) {
+	shard, message, err := chooseShard(logger, workspace, r.listShards, r.listWorkspaces)
+	if err != nil || shard == nil {
+		return nil, message, err
+	}
+	conditions.MarkUnknown(workspace, tenancyv1alpha1.WorkspaceScheduled, tenancyv1alpha1.WorkspaceReasonShardChosen, "%s", message)
+	return shard, "", nil
+}
+
+// chooseShard picks the shard with the most free capacity out of the schedulable shards
+// matching the location of the workspace, including its affinity, anti-affinity and
+// topology spread constraints towards sibling workspaces. The message explains the choice,
+// or why no shard could be chosen.
+func chooseShard(
+	logger klog.Logger,
+	workspace *tenancyv1alpha1.Workspace,
+	listShards func(selector labels.Selector) ([]*corev1alpha1.Shard, error),
+	listWorkspaces func(clusterName logicalcluster.Name) ([]*tenancyv1alpha1.Workspace, error),
+) (shard *corev1alpha1.Shard, message string, err error) {
 	selector := labels.Everything()
 	if workspace.Spec.Location != nil {
 		if workspace.Spec.Location.Selector != nil {
@@ -249,21 +275,38 @@ func (r *schedulingReconciler) chooseShardAndMarkCondition(logger klog.Logger, w
 		}
 	}
 
-	shards, err := r.listShards(selector)
+	placement, message, err := newPlacement(workspace, listWorkspaces, listShards)
 	if err != nil {
 		return nil, "", err
 	}
+	if message != "" {
+		return nil, message, nil // don't retry, cannot do anything useful
+	}
+
+	shards, err := listShards(selector)
+	if err != nil {
+		return nil, "", err
+	}
+	if workspace.Spec.Location != nil && workspace.Spec.Location.Shard != "" {
+		shards = slices.DeleteFunc(shards, func(shard *corev1alpha1.Shard) bool {
+			return shard.Name != workspace.Spec.Location.Shard
+		})
+	}
 
 	validShards := make([]*corev1alpha1.Shard, 0, len(shards))
 	invalidShards := map[string]struct {
 		reason, message string
 	}{}
 	for _, shard := range shards {
-		if _, ok := shard.Annotations[unschedulableAnnotationKey]; ok {
-			logger.V(4).Info("Skipping a shard because it is annotated as unschedulable", "shard", shard.Name, "annotation", unschedulableAnnotationKey)
+		if shard.Spec.Unschedulable || shard.Spec.Drain {
+			logger.V(4).Info("Skipping a shard because it is unschedulable", "shard", shard.Name)
 			continue
 		}
-		if valid, reason, message := isValidShard(shard); valid {
+		valid, reason, message := isValidShard(shard)
+		if valid {
+			valid, reason, message = hasFreeCapacity(shard)
+		}
+		if valid {
 			validShards = append(validShards, shard)
 		} else {
 			invalidShards[shard.Name] = struct {
@@ -274,17 +317,74 @@ func (r *schedulingReconciler) chooseShardAndMarkCondition(logger klog.Logger, w
 			}
 		}
 	}
+	if placement != nil {
+		var unplaceable map[string]struct{ reason, message string }
+		validShards, unplaceable = placement.filter(validShards)
+		for name, x := range unplaceable {
+			invalidShards[name] = x
+		}
+	}
 
 	if len(validShards) == 0 {
+		names := sets.List(sets.KeySet(invalidShards))
 		failures := make([]error, 0, len(invalidShards))
-		for name, x := range invalidShards {
+		details := make([]string, 0, len(invalidShards))
+		for _, name := range names {
+			x := invalidShards[name]
 			failures = append(failures, fmt.Errorf("  %s: reason %q, message %q", name, x.reason, x.message))
+			details = append(details, fmt.Sprintf("%s: %s (%s)", name, x.reason, x.message))
 		}
 		logger.Error(utilerrors.NewAggregate(failures), "no valid shards found for workspace, skipping")
-		return nil, "No available shards to schedule the workspace", nil // retry is automatic when new shards show up
+		if len(details) == 0 {
+			return nil, "No available shards to schedule the workspace", nil // retry is automatic when new shards show up
+		}
+		return nil, fmt.Sprintf("No available shards to schedule the workspace: %s", strings.Join(details, "; ")), nil // retry is automatic when shards change
+	}
+
+	// score the remaining shards and pick randomly among the best ones.
+	var best []*corev1alpha1.Shard
+	var bestScore shardScore
+	for _, shard := range validShards {
+		score := scoreShard(shard)
+		switch {
+		case len(best) == 0 || score.betterThan(bestScore):
+			best = []*corev1alpha1.Shard{shard}
+			bestScore = score
+		case !bestScore.betterThan(score):
+			best = append(best, shard)
+		}
+	}
+	targetShard := best[mathrand.Intn(len(best))]
+
+	message = fmt.Sprintf("Chose shard %q out of %d candidate(s) with %s", targetShard.Name, len(validShards), bestScore)
+	if len(invalidShards) > 0 {
+		message += fmt.Sprintf(", skipped %s", strings.Join(sets.List(sets.KeySet(invalidShards)), ", "))
 	}
-	targetShard := validShards[mathrand.Intn(len(validShards))]
-	return targetShard, "", nil
+
+	return targetShard, message, nil
+}
+
+func (r *schedulingReconciler) updateLogicalClusterPath(ctx context.Context, shard *corev1alpha1.Shard, cluster logicalcluster.Path, canonicalPath logicalcluster.Path) error {
+	logicalClusterAdminClient, err := r.kcpLogicalClusterAdminClientFor(shard)
+	if err != nil {
+		return err
+	}
+	logicalCluster, err := logicalClusterAdminClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Get(ctx, corev1alpha1.LogicalClusterName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil // nothing to update
+	} else if err != nil {
+		return err
+	}
+	if logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey] == canonicalPath.String() {
+		return nil
+	}
+	if logicalCluster.Annotations == nil {
+		logicalCluster.Annotations = map[string]string{}
+	}
+	logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey] = canonicalPath.String()
+	logging.WithObject(klog.FromContext(ctx), logicalCluster).Info("updating canonical path of LogicalCluster", "path", canonicalPath)
+	_, err = logicalClusterAdminClient.Cluster(cluster).CoreV1alpha1().LogicalClusters().Update(ctx, logicalCluster, metav1.UpdateOptions{})
+	return err
 }
 
 func (r *schedulingReconciler) createLogicalCluster(ctx context.Context, shard *corev1alpha1.Shard, cluster logicalcluster.Path, canonicalPath logicalcluster.Path, workspace *tenancyv1alpha1.Workspace) error {
@@ -364,16 +464,20 @@ func LogicalClustersInitializers(
 
 	initializers := make([]corev1alpha1.LogicalClusterInitializer, 0, len(wtAliases))
 
-	bindings := false
+	bindings, defaultResources := false, false
 	for _, alias := range wtAliases {
 		if alias.Spec.Initializer {
 			initializers = append(initializers, initialization.InitializerForType(alias))
 		}
 		bindings = bindings || len(alias.Spec.DefaultAPIBindings) > 0
+		defaultResources = defaultResources || len(alias.Spec.DefaultResourceQuotas) > 0 || len(alias.Spec.DefaultLimitRanges) > 0
 	}
 	if bindings {
 		initializers = append(initializers, tenancyv1alpha1.WorkspaceAPIBindingsInitializer)
 	}
+	if defaultResources {
+		initializers = append(initializers, tenancyv1alpha1.WorkspaceDefaultResourcesInitializer)
+	}
 
 	return initializers, nil
 }
@@ -420,10 +524,86 @@ func (r *schedulingReconciler) updateLogicalClusterPhase(ctx context.Context, sh
 	return err
 }
 
-func isValidShard(_ *corev1alpha1.Shard) (valid bool, reason, message string) {
+// isValidShard returns whether the shard can host logical clusters, i.e. whether
+// it does not report itself as not ready.
+func isValidShard(shard *corev1alpha1.Shard) (valid bool, reason, message string) {
+	if conditions.IsFalse(shard, conditionsv1alpha1.ReadyCondition) {
+		return false, "NotReady", conditions.GetMessage(shard, conditionsv1alpha1.ReadyCondition)
+	}
 	return true, "", ""
 }
 
+// hasFreeCapacity returns whether the shard has room for another logical cluster.
+// Shards without a declared logical cluster capacity are never full.
+func hasFreeCapacity(shard *corev1alpha1.Shard) (valid bool, reason, message string) {
+	capacity, found := shard.Status.Capacity[corev1alpha1.ShardResourceLogicalClusters]
+	if !found {
+		return true, "", ""
+	}
+	allocated := shard.Status.Allocated[corev1alpha1.ShardResourceLogicalClusters]
+	if allocated.Cmp(capacity) >= 0 {
+		return false, "Full", fmt.Sprintf("%s of %s logical clusters allocated", allocated.String(), capacity.String())
+	}
+	return true, "", ""
+}
+
+// shardScore describes how attractive a shard is for a new logical cluster.
+type shardScore struct {
+	// free is the fraction of free logical cluster capacity in the range [0,1].
+	// Shards without a declared capacity have a free fraction of 1.
+	free float64
+	// allocated is the number of logical clusters on the shard.
+	allocated int64
+	// capacity is the declared logical cluster capacity, or -1 if unbounded.
+	capacity int64
+}
+
+func scoreShard(shard *corev1alpha1.Shard) shardScore {
+	allocated := shard.Status.Allocated[corev1alpha1.ShardResourceLogicalClusters]
+	score := shardScore{free: 1, allocated: allocated.Value(), capacity: -1}
+	if capacity, found := shard.Status.Capacity[corev1alpha1.ShardResourceLogicalClusters]; found && capacity.Value() > 0 {
+		score.capacity = capacity.Value()
+		score.free = 1 - float64(score.allocated)/float64(score.capacity)
+		if score.free < 0 {
+			score.free = 0
+		}
+	}
+	return score
+}
+
+// betterThan returns true if s is strictly preferred over other: more free capacity
+// first, fewer allocated logical clusters second.
+func (s shardScore) betterThan(other shardScore) bool {
+	if s.free != other.free {
+		return s.free > other.free
+	}
+	return s.allocated < other.allocated
+}
+
+func (s shardScore) String() string {
+	if s.capacity < 0 {
+		return fmt.Sprintf("%d logical clusters allocated and no capacity limit", s.allocated)
+	}
+	return fmt.Sprintf("%d of %d logical clusters allocated", s.allocated, s.capacity)
+}
+
+// markScheduled sets the WorkspaceScheduled condition to true, keeping the
+// explanation of the shard choice if there is one.
+func markScheduled(workspace *tenancyv1alpha1.Workspace) {
+	if conditions.IsTrue(workspace, tenancyv1alpha1.WorkspaceScheduled) {
+		return
+	}
+	var message string
+	if conditions.GetReason(workspace, tenancyv1alpha1.WorkspaceScheduled) == tenancyv1alpha1.WorkspaceReasonShardChosen {
+		message = conditions.GetMessage(workspace, tenancyv1alpha1.WorkspaceScheduled)
+	}
+	conditions.Set(workspace, &conditionsv1alpha1.Condition{
+		Type:    tenancyv1alpha1.WorkspaceScheduled,
+		Status:  corev1.ConditionTrue,
+		Message: message,
+	})
+}
+
 func randomClusterName(path logicalcluster.Path) (logicalcluster.Name, error) {
 	token := make([]byte, 32)
 	_, err := rand.Read(token)
@@ -30,6 +30,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -73,9 +74,16 @@ func TestReconcileScheduling(t *testing.T) {
 			validateWorkspace: func(t *testing.T, initialWS, ws *tenancyv1alpha1.Workspace) {
 				t.Helper()
 
+				clearLastTransitionTimeOnWsConditions(ws)
 				initialWS.Annotations["internal.tenancy.kcp.io/cluster"] = "root-foo"
 				initialWS.Annotations["internal.tenancy.kcp.io/shard"] = "1pfxsevk"
 				initialWS.Finalizers = append(initialWS.Finalizers, "core.kcp.io/logicalcluster")
+				initialWS.Status.Conditions = append(initialWS.Status.Conditions, conditionsapi.Condition{
+					Type:    tenancyv1alpha1.WorkspaceScheduled,
+					Status:  corev1.ConditionUnknown,
+					Reason:  tenancyv1alpha1.WorkspaceReasonShardChosen,
+					Message: `Chose shard "root" out of 1 candidate(s) with 0 logical clusters allocated and no capacity limit`,
+				})
 				if !equality.Semantic.DeepEqual(ws, initialWS) {
 					t.Fatalf("unexpected Workspace:\n%s", cmp.Diff(ws, initialWS))
 				}
@@ -245,9 +253,16 @@ func TestReconcileScheduling(t *testing.T) {
 			validateWorkspace: func(t *testing.T, initialWS, wsAfterReconciliation *tenancyv1alpha1.Workspace) {
 				t.Helper()
 
+				clearLastTransitionTimeOnWsConditions(wsAfterReconciliation)
 				initialWS.Annotations["internal.tenancy.kcp.io/cluster"] = "root-foo"
 				initialWS.Annotations["internal.tenancy.kcp.io/shard"] = "29hdqnv7"
 				initialWS.Finalizers = append(initialWS.Finalizers, "core.kcp.io/logicalcluster")
+				initialWS.Status.Conditions = append(initialWS.Status.Conditions, conditionsapi.Condition{
+					Type:    tenancyv1alpha1.WorkspaceScheduled,
+					Status:  corev1.ConditionUnknown,
+					Reason:  tenancyv1alpha1.WorkspaceReasonShardChosen,
+					Message: `Chose shard "amber" out of 1 candidate(s) with 0 logical clusters allocated and no capacity limit`,
+				})
 				if !equality.Semantic.DeepEqual(wsAfterReconciliation, initialWS) {
 					t.Fatalf("unexpected Workspace:\n%s", cmp.Diff(wsAfterReconciliation, initialWS))
 				}
@@ -255,10 +270,42 @@ func TestReconcileScheduling(t *testing.T) {
 			expectedStatus: reconcileStatusStopAndRequeue,
 		},
 		{
-			name: "only an unschedulable shard is available, the ws is unscheduled",
+			name: "the ws is scheduled onto the shard in spec.location.shard",
+			targetWorkspace: func() *tenancyv1alpha1.Workspace {
+				ws := workspace("foo")
+				ws.Spec.Location.Shard = "amber"
+				return ws
+			}(),
+			targetLogicalCluster: &corev1alpha1.LogicalCluster{},
+			initialShards:        []*corev1alpha1.Shard{shardWithCapacity("root", 0, 100), shardWithCapacity("amber", 90, 100)},
+			validateWorkspace: func(t *testing.T, initialWS, wsAfterReconciliation *tenancyv1alpha1.Workspace) {
+				t.Helper()
+
+				clearLastTransitionTimeOnWsConditions(wsAfterReconciliation)
+				initialWS.Annotations["internal.tenancy.kcp.io/cluster"] = "root-foo"
+				initialWS.Annotations["internal.tenancy.kcp.io/shard"] = "29hdqnv7"
+				initialWS.Finalizers = append(initialWS.Finalizers, "core.kcp.io/logicalcluster")
+				initialWS.Status.Conditions = append(initialWS.Status.Conditions, conditionsapi.Condition{
+					Type:    tenancyv1alpha1.WorkspaceScheduled,
+					Status:  corev1.ConditionUnknown,
+					Reason:  tenancyv1alpha1.WorkspaceReasonShardChosen,
+					Message: `Chose shard "amber" out of 1 candidate(s) with 90 of 100 logical clusters allocated`,
+				})
+				if !equality.Semantic.DeepEqual(wsAfterReconciliation, initialWS) {
+					t.Fatalf("unexpected Workspace:\n%s", cmp.Diff(wsAfterReconciliation, initialWS))
+				}
+			},
+			expectedStatus: reconcileStatusStopAndRequeue,
+		},
+		{
+			name: "only unschedulable shards are available, the ws is unscheduled",
 			initialShards: []*corev1alpha1.Shard{func() *corev1alpha1.Shard {
 				s := shard("amber")
-				s.Annotations[unschedulableAnnotationKey] = "true"
+				s.Spec.Unschedulable = true
+				return s
+			}(), func() *corev1alpha1.Shard {
+				s := shard("beta")
+				s.Spec.Drain = true
 				return s
 			}()},
 			targetWorkspace:      workspace("foo"),
@@ -280,6 +327,122 @@ func TestReconcileScheduling(t *testing.T) {
 			},
 			expectedStatus: reconcileStatusContinue,
 		},
+		{
+			name: "the ws is scheduled onto the shard with the most free capacity",
+			initialShards: []*corev1alpha1.Shard{
+				shardWithCapacity("root", 80, 100),
+				shardWithCapacity("amber", 10, 100),
+				shardWithCapacity("beta", 5, 10),
+			},
+			targetWorkspace:      workspace("foo"),
+			targetLogicalCluster: &corev1alpha1.LogicalCluster{},
+			validateWorkspace: func(t *testing.T, initialWS, wsAfterReconciliation *tenancyv1alpha1.Workspace) {
+				t.Helper()
+
+				clearLastTransitionTimeOnWsConditions(wsAfterReconciliation)
+				initialWS.Annotations["internal.tenancy.kcp.io/cluster"] = "root-foo"
+				initialWS.Annotations["internal.tenancy.kcp.io/shard"] = "29hdqnv7"
+				initialWS.Finalizers = append(initialWS.Finalizers, "core.kcp.io/logicalcluster")
+				initialWS.Status.Conditions = append(initialWS.Status.Conditions, conditionsapi.Condition{
+					Type:    tenancyv1alpha1.WorkspaceScheduled,
+					Status:  corev1.ConditionUnknown,
+					Reason:  tenancyv1alpha1.WorkspaceReasonShardChosen,
+					Message: `Chose shard "amber" out of 3 candidate(s) with 10 of 100 logical clusters allocated`,
+				})
+				if !equality.Semantic.DeepEqual(wsAfterReconciliation, initialWS) {
+					t.Fatalf("unexpected Workspace:\n%s", cmp.Diff(wsAfterReconciliation, initialWS))
+				}
+			},
+			expectedStatus: reconcileStatusStopAndRequeue,
+		},
+		{
+			name: "full and not ready shards are skipped",
+			initialShards: []*corev1alpha1.Shard{
+				shardWithCapacity("root", 100, 100),
+				func() *corev1alpha1.Shard {
+					s := shard("beta")
+					s.Status.Conditions = conditionsapi.Conditions{{Type: conditionsapi.ReadyCondition, Status: corev1.ConditionFalse, Message: "draining"}}
+					return s
+				}(),
+				shardWithCapacity("amber", 99, 100),
+			},
+			targetWorkspace:      workspace("foo"),
+			targetLogicalCluster: &corev1alpha1.LogicalCluster{},
+			validateWorkspace: func(t *testing.T, initialWS, wsAfterReconciliation *tenancyv1alpha1.Workspace) {
+				t.Helper()
+
+				clearLastTransitionTimeOnWsConditions(wsAfterReconciliation)
+				initialWS.Annotations["internal.tenancy.kcp.io/cluster"] = "root-foo"
+				initialWS.Annotations["internal.tenancy.kcp.io/shard"] = "29hdqnv7"
+				initialWS.Finalizers = append(initialWS.Finalizers, "core.kcp.io/logicalcluster")
+				initialWS.Status.Conditions = append(initialWS.Status.Conditions, conditionsapi.Condition{
+					Type:    tenancyv1alpha1.WorkspaceScheduled,
+					Status:  corev1.ConditionUnknown,
+					Reason:  tenancyv1alpha1.WorkspaceReasonShardChosen,
+					Message: `Chose shard "amber" out of 1 candidate(s) with 99 of 100 logical clusters allocated, skipped beta, root`,
+				})
+				if !equality.Semantic.DeepEqual(wsAfterReconciliation, initialWS) {
+					t.Fatalf("unexpected Workspace:\n%s", cmp.Diff(wsAfterReconciliation, initialWS))
+				}
+			},
+			expectedStatus: reconcileStatusStopAndRequeue,
+		},
+		{
+			name:                 "all shards are full, the ws is unscheduled",
+			initialShards:        []*corev1alpha1.Shard{shardWithCapacity("root", 100, 100)},
+			targetWorkspace:      workspace("foo"),
+			targetLogicalCluster: &corev1alpha1.LogicalCluster{},
+			validateWorkspace: func(t *testing.T, initialWS, wsAfterReconciliation *tenancyv1alpha1.Workspace) {
+				t.Helper()
+
+				clearLastTransitionTimeOnWsConditions(wsAfterReconciliation)
+				initialWS.Status.Conditions = append(initialWS.Status.Conditions, conditionsapi.Condition{
+					Type:     tenancyv1alpha1.WorkspaceScheduled,
+					Severity: conditionsapi.ConditionSeverityError,
+					Status:   corev1.ConditionFalse,
+					Reason:   tenancyv1alpha1.WorkspaceReasonUnschedulable,
+					Message:  "No available shards to schedule the workspace: root: Full (100 of 100 logical clusters allocated)",
+				})
+				if !equality.Semantic.DeepEqual(wsAfterReconciliation, initialWS) {
+					t.Fatalf("unexpected Workspace:\n%s", cmp.Diff(wsAfterReconciliation, initialWS))
+				}
+			},
+			expectedStatus: reconcileStatusContinue,
+		},
+		{
+			name:                  "two-phase commit, part two: the shard choice explanation is kept",
+			initialShards:         []*corev1alpha1.Shard{shard("root")},
+			initialWorkspaceTypes: wellKnownWorkspaceTypes(),
+			targetWorkspace: func() *tenancyv1alpha1.Workspace {
+				ws := wellKnownFooWSForPhaseTwo()
+				ws.Status.Conditions = conditionsapi.Conditions{{
+					Type:    tenancyv1alpha1.WorkspaceScheduled,
+					Status:  corev1.ConditionUnknown,
+					Reason:  tenancyv1alpha1.WorkspaceReasonShardChosen,
+					Message: `Chose shard "root" out of 1 candidate(s) with 0 logical clusters allocated and no capacity limit`,
+				}}
+				return ws
+			}(),
+			targetLogicalCluster: &corev1alpha1.LogicalCluster{},
+			validateWorkspace: func(t *testing.T, initialWS, wsAfterReconciliation *tenancyv1alpha1.Workspace) {
+				t.Helper()
+
+				clearLastTransitionTimeOnWsConditions(wsAfterReconciliation)
+				initialWS.CreationTimestamp = wsAfterReconciliation.CreationTimestamp
+				initialWS.Spec.URL = `https://root/clusters/root:foo`
+				initialWS.Spec.Cluster = "root-foo"
+				initialWS.Status.Conditions = conditionsapi.Conditions{{
+					Type:    tenancyv1alpha1.WorkspaceScheduled,
+					Status:  corev1.ConditionTrue,
+					Message: `Chose shard "root" out of 1 candidate(s) with 0 logical clusters allocated and no capacity limit`,
+				}}
+				if !equality.Semantic.DeepEqual(wsAfterReconciliation, initialWS) {
+					t.Fatalf("unexpected Workspace:\n%s", cmp.Diff(wsAfterReconciliation, initialWS))
+				}
+			},
+			expectedStatus:           reconcileStatusContinue,
+			expectedKcpClientActions: []string{"create:logicalclusters", "get:logicalclusters", "update:logicalclusters"},
+		},
 	}
 	for _, scenario := range scenarios {
 		t.Run(scenario.name, func(t *testing.T) {
@@ -507,6 +670,13 @@ func shard(name string) *corev1alpha1.Shard {
 	}
 }
 
+func shardWithCapacity(name string, allocated, capacity int64) *corev1alpha1.Shard {
+	s := shard(name)
+	s.Status.Capacity = corev1.ResourceList{corev1alpha1.ShardResourceLogicalClusters: *resource.NewQuantity(capacity, resource.DecimalSI)}
+	s.Status.Allocated = corev1.ResourceList{corev1alpha1.ShardResourceLogicalClusters: *resource.NewQuantity(allocated, resource.DecimalSI)}
+	return s
+}
+
 func workspaceType(name string) *tenancyv1alpha1.WorkspaceType {
 	return &tenancyv1alpha1.WorkspaceType{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspaceexpiration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+)
+
+// defaultWarningPeriod is used when spec.expiration.warningPeriod is not set.
+const defaultWarningPeriod = time.Hour
+
+// reconcile sets the Expiring condition of the workspace. It returns whether the workspace
+// has expired and has to be deleted, and otherwise when it has to be reconciled again.
+func (c *controller) reconcile(_ context.Context, workspace *tenancyv1alpha1.Workspace) (bool, time.Duration) {
+	if workspace.Spec.Expiration == nil {
+		conditions.Delete(workspace, tenancyv1alpha1.WorkspaceExpiring)
+		return false, 0
+	}
+	if !workspace.DeletionTimestamp.IsZero() {
+		return false, 0
+	}
+
+	expiry, ok := expirationTime(workspace)
+	if !ok {
+		return false, 0 // prevented by validation
+	}
+	warningPeriod := defaultWarningPeriod
+	if workspace.Spec.Expiration.WarningPeriod != nil {
+		warningPeriod = workspace.Spec.Expiration.WarningPeriod.Duration
+	}
+
+	now := c.now()
+	switch {
+	case !now.Before(expiry):
+		setExpiring(workspace, tenancyv1alpha1.WorkspaceExpired, "Workspace expired at %s", expiry.UTC().Format(time.RFC3339))
+		return true, 0
+
+	case now.Before(expiry.Add(-warningPeriod)):
+		// not yet, or the expiry was postponed.
+		conditions.Delete(workspace, tenancyv1alpha1.WorkspaceExpiring)
+		return false, expiry.Add(-warningPeriod).Sub(now)
+
+	default:
+		setExpiring(workspace, tenancyv1alpha1.WorkspaceExpiringSoon, "Workspace expires at %s", expiry.UTC().Format(time.RFC3339))
+		return false, expiry.Sub(now)
+	}
+}
+
+// expirationTime returns the point in time at which the workspace expires.
+func expirationTime(workspace *tenancyv1alpha1.Workspace) (time.Time, bool) {
+	switch expiration := workspace.Spec.Expiration; {
+	case expiration.ExpiresAt != nil:
+		return expiration.ExpiresAt.Time, true
+	case expiration.TTL != nil:
+		return workspace.CreationTimestamp.Add(expiration.TTL.Duration), true
+	}
+	return time.Time{}, false
+}
+
+// setExpiring marks the Expiring condition true. Unlike conditions.MarkTrue, it keeps a reason
+// and message, as the point in time is the relevant information for the user.
+func setExpiring(workspace *tenancyv1alpha1.Workspace, reason, messageFormat string, messageArgs ...interface{}) {
+	cond := conditions.TrueCondition(tenancyv1alpha1.WorkspaceExpiring)
+	cond.Reason = reason
+	cond.Message = fmt.Sprintf(messageFormat, messageArgs...)
+	conditions.Set(workspace, cond)
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspaceexpiration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	tenancyv1alpha1 "github.com/kcp-dev/sdk/apis/tenancy/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+)
+
+func TestReconcile(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := metav1.NewTime(created.Add(48 * time.Hour))
+
+	tests := []struct {
+		name       string
+		expiration *tenancyv1alpha1.WorkspaceExpiration
+		conditions bool
+		deleting   bool
+		now        time.Time
+
+		wantExpired      bool
+		wantRequeueAfter time.Duration
+		wantReason       string
+	}{
+		{
+			name: "no expiration",
+			now:  created,
+		},
+		{
+			name:       "condition is removed with the expiration",
+			conditions: true,
+			now:        created,
+		},
+		{
+			name:             "ttl before the warning period",
+			expiration:       &tenancyv1alpha1.WorkspaceExpiration{TTL: &metav1.Duration{Duration: 24 * time.Hour}},
+			now:              created.Add(time.Hour),
+			wantRequeueAfter: 22 * time.Hour,
+		},
+		{
+			name:             "ttl in the warning period",
+			expiration:       &tenancyv1alpha1.WorkspaceExpiration{TTL: &metav1.Duration{Duration: 24 * time.Hour}},
+			now:              created.Add(23*time.Hour + 30*time.Minute),
+			wantRequeueAfter: 30 * time.Minute,
+			wantReason:       tenancyv1alpha1.WorkspaceExpiringSoon,
+		},
+		{
+			name:        "ttl expired",
+			expiration:  &tenancyv1alpha1.WorkspaceExpiration{TTL: &metav1.Duration{Duration: 24 * time.Hour}},
+			now:         created.Add(25 * time.Hour),
+			wantExpired: true,
+			wantReason:  tenancyv1alpha1.WorkspaceExpired,
+		},
+		{
+			name:             "expiresAt in a custom warning period",
+			expiration:       &tenancyv1alpha1.WorkspaceExpiration{ExpiresAt: &expiresAt, WarningPeriod: &metav1.Duration{Duration: 24 * time.Hour}},
+			now:              created.Add(36 * time.Hour),
+			wantRequeueAfter: 12 * time.Hour,
+			wantReason:       tenancyv1alpha1.WorkspaceExpiringSoon,
+		},
+		{
+			name:             "postponed expiry removes the warning",
+			expiration:       &tenancyv1alpha1.WorkspaceExpiration{ExpiresAt: &expiresAt},
+			conditions:       true,
+			now:              created.Add(36 * time.Hour),
+			wantRequeueAfter: 11 * time.Hour,
+		},
+		{
+			name:        "expiresAt expired",
+			expiration:  &tenancyv1alpha1.WorkspaceExpiration{ExpiresAt: &expiresAt},
+			now:         expiresAt.Time,
+			wantExpired: true,
+			wantReason:  tenancyv1alpha1.WorkspaceExpired,
+		},
+		{
+			name:       "deleting workspace is left alone",
+			expiration: &tenancyv1alpha1.WorkspaceExpiration{ExpiresAt: &expiresAt},
+			deleting:   true,
+			now:        expiresAt.Add(time.Hour),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ws := &tenancyv1alpha1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", CreationTimestamp: metav1.NewTime(created)},
+				Spec:       tenancyv1alpha1.WorkspaceSpec{Expiration: tt.expiration},
+			}
+			if tt.deleting {
+				ws.DeletionTimestamp = &metav1.Time{Time: tt.now}
+			}
+			if tt.conditions {
+				setExpiring(ws, tenancyv1alpha1.WorkspaceExpiringSoon, "Workspace expires soon")
+			}
+
+			c := &controller{now: func() time.Time { return tt.now }}
+			expired, requeueAfter := c.reconcile(context.Background(), ws)
+			require.Equal(t, tt.wantExpired, expired)
+			require.Equal(t, tt.wantRequeueAfter, requeueAfter)
+
+			cond := conditions.Get(ws, tenancyv1alpha1.WorkspaceExpiring)
+			if tt.wantReason == "" {
+				require.Nil(t, cond)
+				return
+			}
+			require.NotNil(t, cond)
+			require.True(t, conditions.IsTrue(ws, tenancyv1alpha1.WorkspaceExpiring))
+			require.Equal(t, tt.wantReason, cond.Reason)
+		})
+	}
+}
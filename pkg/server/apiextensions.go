@@ -177,6 +177,12 @@ func (c *apiBindingAwareCRDLister) List(ctx context.Context, selector labels.Sel
 				}
 			}
 
+			// Renamed resources are listed under the name they are served as. Requests for that name are
+			// rewritten to the bound resource by kcpfilters.WithResourceRenames.
+			if boundResource.ServedAs != nil {
+				crd = servedAsCRD(crd, boundResource)
+			}
+
 			ret = append(ret, crd)
 			seen.Insert(crdName(crd))
 		}
@@ -364,6 +370,15 @@ func tryDecorateCRDWithSchemaStorage(in *apiextensionsv1.CustomResourceDefinitio
 	return out, nil
 }
 
+// servedAsCRD returns a copy of the bound CRD with the group and names the bound resource is served as.
+// Name and UID are made unique to not clash with the bound CRD of the same resource in other workspaces.
+func servedAsCRD(in *apiextensionsv1.CustomResourceDefinition, boundResource apisv1alpha2.BoundAPIResource) *apiextensionsv1.CustomResourceDefinition {
+	out := apibinding.ServedCRD(in, boundResource)
+	out.Name = out.Spec.Names.Plural + "." + out.Spec.Group
+	out.UID = types.UID(string(in.UID) + "." + out.Name)
+	return out
+}
+
 // addPartialMetadataCRDAnnotation adds an annotation that marks this CRD as being
 // for a partial metadata request.
 func addPartialMetadataCRDAnnotation(crd *apiextensionsv1.CustomResourceDefinition) {
@@ -480,6 +495,12 @@ func (c *apiBindingAwareCRDLister) get(ctx context.Context, clusterName logicalc
 			// It is set if the request is coming from the virtual apiexport apiserver client.
 			matchingIdentity := identity == "" || boundResource.Schema.IdentityHash == identity
 
+			// Renamed resources are not served under their original name. Requests for the name they are
+			// served as carry the identity after being rewritten by kcpfilters.WithResourceRenames.
+			if boundResource.ServedAs != nil && identity == "" {
+				continue
+			}
+
 			if boundResource.Group == group && boundResource.Resource == resource && matchingIdentity {
 				crd, err = c.crdLister.Cluster(apibinding.SystemBoundCRDsClusterName).Get(boundResource.Schema.UID)
 				if err != nil && apierrors.IsNotFound(err) {
@@ -27,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
 
 	"github.com/kcp-dev/kcp/pkg/admission/reservedcrdgroups"
 )
@@ -170,3 +171,31 @@ func TestShallowCopyAndMakePartialMetadataCRD(t *testing.T) {
 		t.Error("expected shallow copy to not modify original schema type")
 	}
 }
+
+func TestServedAsCRD(t *testing.T) {
+	names := apiextensionsv1.CustomResourceDefinitionNames{Plural: "widgets", Singular: "widget", Kind: "Widget", ListKind: "WidgetList"}
+	original := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "b4c3e3a1",
+			UID:         "b4c3e3a1",
+			Annotations: map[string]string{apisv1alpha1.AnnotationAPIIdentityKey: "id"},
+		},
+		Spec:   apiextensionsv1.CustomResourceDefinitionSpec{Group: "example.io", Names: names},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{AcceptedNames: names},
+	}
+
+	served := servedAsCRD(original, apisv1alpha2.BoundAPIResource{
+		Group:    "example.io",
+		Resource: "widgets",
+		ServedAs: &apisv1alpha2.GroupResource{Group: "acme.example.com", Resource: "widgets"},
+	})
+
+	require.Equal(t, "widgets.acme.example.com", served.Name)
+	require.Equal(t, "b4c3e3a1.widgets.acme.example.com", string(served.UID))
+	require.Equal(t, "acme.example.com", served.Spec.Group)
+	require.Equal(t, "widgets", served.Status.AcceptedNames.Plural)
+	require.Equal(t, "id", served.Annotations[apisv1alpha1.AnnotationAPIIdentityKey], "identity must be retained for storage")
+
+	require.Equal(t, "b4c3e3a1", original.Name)
+	require.Equal(t, "example.io", original.Spec.Group)
+}
@@ -17,27 +17,41 @@ limitations under the License.
 package server
 
 import (
+	"fmt"
+	"sync"
+
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apiserver/conversion"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apiserver/pkg/util/webhook"
 
+	"github.com/kcp-dev/logicalcluster/v3"
 	"github.com/kcp-dev/sdk/apis/apis"
 	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
 	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+
+	kcpconversion "github.com/kcp-dev/kcp/pkg/conversion"
 )
 
 type CRConverterFactory struct {
 	delegate *conversion.CRConverterFactory
 	scheme   *runtime.Scheme
+
+	getAPIConversion func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIConversion, error)
 }
 
 // NewCRConverterFactory returns a wrapper around a conversion.CRConverterFactory that intercepts
-// conversion requests for apis.kcp.io and calls the conversion functions directly. All other
-// API groups are processed using the delegated converter.
-func NewCRConverterFactory(serviceResolver webhook.ServiceResolver, authResolverWrapper webhook.AuthenticationInfoResolverWrapper) (*CRConverterFactory, error) {
+// conversion requests for apis.kcp.io and calls the conversion functions directly. Bound CRDs
+// without a conversion webhook are converted using the rules of the APIConversion of their
+// APIResourceSchema, if there is one. All other API groups are processed using the delegated converter.
+func NewCRConverterFactory(
+	serviceResolver webhook.ServiceResolver,
+	authResolverWrapper webhook.AuthenticationInfoResolverWrapper,
+	getAPIConversion func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIConversion, error),
+) (*CRConverterFactory, error) {
 	delegate, err := conversion.NewCRConverterFactory(serviceResolver, authResolverWrapper)
 	if err != nil {
 		return nil, err
@@ -52,8 +66,9 @@ func NewCRConverterFactory(serviceResolver webhook.ServiceResolver, authResolver
 	}
 
 	return &CRConverterFactory{
-		delegate: delegate,
-		scheme:   scheme,
+		delegate:         delegate,
+		scheme:           scheme,
+		getAPIConversion: getAPIConversion,
 	}, nil
 }
 
@@ -65,7 +80,22 @@ func (f *CRConverterFactory) NewConverter(crd *apiextensionsv1.CustomResourceDef
 		}, nil
 	}
 
-	return f.delegate.NewConverter(crd)
+	delegate, err := f.delegate.NewConverter(crd)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, bound := crd.Annotations[apisv1alpha1.AnnotationBoundCRDKey]; bound && len(crd.Spec.Versions) > 1 &&
+		(crd.Spec.Conversion == nil || crd.Spec.Conversion.Strategy == apiextensionsv1.NoneConverter) {
+		return &apiConversionConverter{
+			schemaCluster:    logicalcluster.Name(crd.Annotations[apisv1alpha1.AnnotationSchemaClusterKey]),
+			schemaName:       crd.Annotations[apisv1alpha1.AnnotationSchemaNameKey],
+			getAPIConversion: f.getAPIConversion,
+			delegate:         delegate,
+		}, nil
+	}
+
+	return delegate, nil
 }
 
 type schemaBasedConverter struct {
@@ -105,3 +135,59 @@ func (s *schemaBasedConverter) Convert(in *unstructured.UnstructuredList, target
 
 	return out, nil
 }
+
+// apiConversionConverter converts the objects of a bound CRD using the rules of the APIConversion
+// of its APIResourceSchema. The APIConversion is looked up on every conversion, such that it can be
+// created or changed after the CRD. Without APIConversion, the delegate converter is used.
+type apiConversionConverter struct {
+	schemaCluster    logicalcluster.Name
+	schemaName       string
+	getAPIConversion func(clusterName logicalcluster.Name, name string) (*apisv1alpha1.APIConversion, error)
+	delegate         conversion.CRConverter
+
+	lock            sync.Mutex
+	resourceVersion string
+	converter       *kcpconversion.Converter
+}
+
+func (c *apiConversionConverter) Convert(in *unstructured.UnstructuredList, targetGV schema.GroupVersion) (*unstructured.UnstructuredList, error) {
+	apiConversion, err := c.getAPIConversion(c.schemaCluster, c.schemaName)
+	if apierrors.IsNotFound(err) {
+		return c.delegate.Convert(in, targetGV)
+	} else if err != nil {
+		return nil, err
+	}
+
+	converter, err := c.compile(apiConversion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid APIConversion %s|%s: %w", c.schemaCluster, c.schemaName, err)
+	}
+
+	out := &unstructured.UnstructuredList{}
+	for i := range in.Items {
+		converted, err := converter.Convert(&in.Items[i], targetGV)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %s to %s with APIConversion %s|%s: %w", in.Items[i].GetName(), targetGV, c.schemaCluster, c.schemaName, err)
+		}
+		out.Items = append(out.Items, *converted)
+	}
+
+	return out, nil
+}
+
+// compile returns the compiled rules of the APIConversion, reusing them until it changes.
+func (c *apiConversionConverter) compile(apiConversion *apisv1alpha1.APIConversion) (*kcpconversion.Converter, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.converter != nil && c.resourceVersion == apiConversion.ResourceVersion {
+		return c.converter, nil
+	}
+	converter, err := kcpconversion.Compile(apiConversion)
+	if err != nil {
+		return nil, err
+	}
+	c.converter = converter
+	c.resourceVersion = apiConversion.ResourceVersion
+	return converter, nil
+}
@@ -0,0 +1,418 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filters
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	apisv1alpha2informers "github.com/kcp-dev/sdk/client/informers/externalversions/apis/v1alpha2"
+
+	"github.com/kcp-dev/kcp/pkg/indexers"
+)
+
+// WithResourceRenames rewrites requests for resources an APIBinding serves under another group or resource
+// name (see spec.resourceRenames) to the bound resource, e.g. /apis/acme.example.com/v1/widgets to
+// /apis/example.io/v1/widgets:<identity>, which is then handled by WithResourceIdentity. If the group
+// differs, the apiVersion of objects is translated in request and response bodies.
+//
+// It runs after authorization, such that the renamed resource is authorized.
+func WithResourceRenames(handler http.Handler, apiBindingInformer apisv1alpha2informers.APIBindingClusterInformer, maxRequestBodyBytes int64) http.Handler {
+	return withResourceRenames(handler, apiBindingInformer.Informer().GetIndexer(), maxRequestBodyBytes)
+}
+
+func withResourceRenames(handler http.Handler, apiBindingIndexer cache.Indexer, maxRequestBodyBytes int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requestInfo, ok := request.RequestInfoFrom(req.Context())
+		if !ok {
+			responsewriters.ErrorNegotiated(
+				apierrors.NewInternalError(fmt.Errorf("missing requestInfo")),
+				errorCodecs, schema.GroupVersion{}, w, req,
+			)
+			return
+		}
+		cluster := request.ClusterFrom(req.Context())
+		if !requestInfo.IsResourceRequest || cluster == nil || cluster.Wildcard || strings.Contains(requestInfo.Resource, ":") {
+			handler.ServeHTTP(w, req)
+			return
+		}
+
+		boundResource, err := servedBoundResource(apiBindingIndexer, cluster.Name, requestInfo.APIGroup, requestInfo.Resource)
+		if err != nil {
+			responsewriters.ErrorNegotiated(
+				apierrors.NewInternalError(err),
+				errorCodecs, schema.GroupVersion{}, w, req,
+			)
+			return
+		}
+		if boundResource == nil {
+			handler.ServeHTTP(w, req)
+			return
+		}
+
+		servedGroupVersion := schema.GroupVersion{Group: requestInfo.APIGroup, Version: requestInfo.APIVersion}
+		boundGroupVersion := schema.GroupVersion{Group: boundResource.Group, Version: requestInfo.APIVersion}
+
+		path, ok := renamedPath(req.URL.Path, requestInfo, boundResource.Group, boundResource.Resource+":"+boundResource.Schema.IdentityHash)
+		if !ok {
+			responsewriters.ErrorNegotiated(
+				apierrors.NewInternalError(fmt.Errorf("unable to rewrite path %q of renamed resource", req.URL.Path)),
+				errorCodecs, servedGroupVersion, w, req,
+			)
+			return
+		}
+
+		renamedInfo := *requestInfo
+		renamedInfo.APIGroup = boundResource.Group
+		renamedInfo.Resource = boundResource.Resource + ":" + boundResource.Schema.IdentityHash
+		renamedInfo.Path = path
+
+		req = req.Clone(request.WithRequestInfo(req.Context(), &renamedInfo))
+		req.URL.Path = path
+		req.URL.RawPath = ""
+
+		if servedGroupVersion == boundGroupVersion {
+			handler.ServeHTTP(w, req)
+			return
+		}
+
+		// The objects carry the apiVersion of the bound resource, which has to be translated.
+		if req.Body != nil && req.Body != http.NoBody {
+			if err := translateRequestBody(req, servedGroupVersion, boundGroupVersion, maxRequestBodyBytes); err != nil {
+				responsewriters.ErrorNegotiated(err, errorCodecs, servedGroupVersion, w, req)
+				return
+			}
+		}
+
+		// Responses are translated as JSON, hence they must not be compressed.
+		req.Header.Del("Accept-Encoding")
+
+		if requestInfo.Verb == "watch" {
+			ww := &translatingWatchWriter{ResponseWriter: w, from: boundGroupVersion, to: servedGroupVersion}
+			handler.ServeHTTP(ww, req)
+			ww.flushRemainder()
+			return
+		}
+
+		bw := &bufferingResponseWriter{ResponseWriter: w, status: http.StatusOK}
+		handler.ServeHTTP(bw, req)
+		bw.writeTranslated(boundGroupVersion, servedGroupVersion)
+	})
+}
+
+// servedBoundResource returns the bound resource served as the given group and resource in the logical cluster,
+// or nil if there is none.
+func servedBoundResource(apiBindingIndexer cache.Indexer, clusterName logicalcluster.Name, group, resource string) (*apisv1alpha2.BoundAPIResource, error) {
+	objs, err := apiBindingIndexer.ByIndex(indexers.APIBindingByServedResources, indexers.APIBindingBoundResourceValue(clusterName, group, resource))
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		apiBinding := obj.(*apisv1alpha2.APIBinding)
+		for i := range apiBinding.Status.BoundResources {
+			br := &apiBinding.Status.BoundResources[i]
+			if br.ServedAs != nil && br.ServedAs.Group == group && br.ServedAs.Resource == resource {
+				return br, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// renamedPath replaces the group and resource segments of the given request path.
+func renamedPath(path string, requestInfo *request.RequestInfo, group, resource string) (string, bool) {
+	segments := strings.Split(path, "/")
+
+	// find the group version prefix, i.e. apis/<group>/<version>.
+	i := -1
+	for j := 0; j+2 < len(segments); j++ {
+		if segments[j] == "apis" && segments[j+1] == requestInfo.APIGroup && segments[j+2] == requestInfo.APIVersion {
+			i = j
+			break
+		}
+	}
+	if i < 0 {
+		return "", false
+	}
+	rest := segments[i+3:]
+
+	// skip namespaces/<namespace> to the resource.
+	r := 0
+	if requestInfo.Namespace != "" && len(rest) > 1 && rest[0] == "namespaces" {
+		r = 2
+	}
+	if len(rest) <= r || rest[r] != requestInfo.Resource {
+		return "", false
+	}
+	rest[r] = resource
+
+	prefix := append([]string{}, segments[:i]...)
+	if group == "" {
+		prefix = append(prefix, "api", requestInfo.APIVersion)
+	} else {
+		prefix = append(prefix, "apis", group, requestInfo.APIVersion)
+	}
+	return strings.Join(append(prefix, rest...), "/"), true
+}
+
+// translateRequestBody translates the apiVersion of the object in a JSON or apply YAML request body.
+func translateRequestBody(req *http.Request, from, to schema.GroupVersion, maxRequestBodyBytes int64) error {
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	isYAML := mediaType == "application/apply-patch+yaml"
+	if !isYAML && !isJSONMediaType(mediaType) {
+		return nil
+	}
+
+	reader := io.Reader(req.Body)
+	if maxRequestBodyBytes > 0 {
+		reader = io.LimitReader(req.Body, maxRequestBodyBytes+1)
+	}
+	body, err := io.ReadAll(reader)
+	req.Body.Close()
+	if err != nil {
+		return apierrors.NewBadRequest(fmt.Sprintf("unable to read request body: %v", err))
+	}
+	if maxRequestBodyBytes > 0 && int64(len(body)) > maxRequestBodyBytes {
+		return apierrors.NewRequestEntityTooLargeError(fmt.Sprintf("limit is %d", maxRequestBodyBytes))
+	}
+
+	translated := body
+	if isYAML {
+		// JSON is valid apply YAML.
+		if translated, err = yaml.YAMLToJSON(body); err != nil {
+			return apierrors.NewBadRequest(fmt.Sprintf("unable to decode request body: %v", err))
+		}
+	}
+	if translated, err = translateObject(translated, from, to); err != nil {
+		// Not an object, e.g. a JSON patch. Leave it to the handler.
+		translated = body
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(translated))
+	req.ContentLength = int64(len(translated))
+	req.Header.Set("Content-Length", strconv.Itoa(len(translated)))
+	return nil
+}
+
+// translateObject translates the apiVersion of a JSON object or list, and of its managed fields.
+func translateObject(data []byte, from, to schema.GroupVersion) ([]byte, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return data, nil
+	}
+
+	if err := translateAPIVersion(obj, "apiVersion", from, to); err != nil {
+		return nil, err
+	}
+
+	if metadata, ok := obj["metadata"]; ok {
+		var meta map[string]json.RawMessage
+		if err := json.Unmarshal(metadata, &meta); err == nil && meta != nil {
+			if managedFields, ok := meta["managedFields"]; ok {
+				var entries []map[string]json.RawMessage
+				if err := json.Unmarshal(managedFields, &entries); err == nil {
+					for _, entry := range entries {
+						if err := translateAPIVersion(entry, "apiVersion", from, to); err != nil {
+							return nil, err
+						}
+					}
+					if meta["managedFields"], err = json.Marshal(entries); err != nil {
+						return nil, err
+					}
+					if obj["metadata"], err = json.Marshal(meta); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+
+	if items, ok := obj["items"]; ok {
+		var list []json.RawMessage
+		if err := json.Unmarshal(items, &list); err == nil {
+			for i := range list {
+				if list[i], err = translateObject(list[i], from, to); err != nil {
+					return nil, err
+				}
+			}
+			if obj["items"], err = json.Marshal(list); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return json.Marshal(obj)
+}
+
+// translateAPIVersion replaces the given apiVersion field if it equals from.
+func translateAPIVersion(obj map[string]json.RawMessage, field string, from, to schema.GroupVersion) error {
+	raw, ok := obj[field]
+	if !ok {
+		return nil
+	}
+	var apiVersion string
+	if err := json.Unmarshal(raw, &apiVersion); err != nil {
+		return nil
+	}
+	if apiVersion != from.String() {
+		return nil
+	}
+	encoded, err := json.Marshal(to.String())
+	if err != nil {
+		return err
+	}
+	obj[field] = encoded
+	return nil
+}
+
+func isJSONMediaType(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// bufferingResponseWriter buffers a response to translate it when the handler is done.
+type bufferingResponseWriter struct {
+	http.ResponseWriter
+
+	status int
+	buf    bytes.Buffer
+}
+
+func (w *bufferingResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *bufferingResponseWriter) Write(data []byte) (int, error) {
+	return w.buf.Write(data)
+}
+
+// Flush is a no-op, the response is written when the handler is done.
+func (w *bufferingResponseWriter) Flush() {}
+
+func (w *bufferingResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *bufferingResponseWriter) writeTranslated(from, to schema.GroupVersion) {
+	body := w.buf.Bytes()
+
+	mediaType, _, _ := mime.ParseMediaType(w.Header().Get("Content-Type"))
+	if isJSONMediaType(mediaType) {
+		if translated, err := translateObject(body, from, to); err == nil {
+			body = translated
+		}
+	}
+
+	w.Header().Del("Content-Length")
+	w.ResponseWriter.WriteHeader(w.status)
+	_, _ = w.ResponseWriter.Write(body)
+}
+
+// translatingWatchWriter translates the objects of a JSON watch stream event by event.
+type translatingWatchWriter struct {
+	http.ResponseWriter
+
+	from, to schema.GroupVersion
+
+	passthrough bool
+	buf         []byte
+}
+
+func (w *translatingWatchWriter) WriteHeader(status int) {
+	mediaType, _, _ := mime.ParseMediaType(w.Header().Get("Content-Type"))
+	w.passthrough = !isJSONMediaType(mediaType) || status != http.StatusOK
+	w.Header().Del("Content-Length")
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *translatingWatchWriter) Write(data []byte) (int, error) {
+	if w.passthrough {
+		return w.ResponseWriter.Write(data)
+	}
+
+	w.buf = append(w.buf, data...)
+	dec := json.NewDecoder(bytes.NewReader(w.buf))
+	for {
+		var event map[string]json.RawMessage
+		if err := dec.Decode(&event); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			// Not a JSON stream. Write everything as is from now on.
+			w.passthrough = true
+			buf := w.buf
+			w.buf = nil
+			if _, err := w.ResponseWriter.Write(buf); err != nil {
+				return 0, err
+			}
+			return len(data), nil
+		}
+
+		if object, ok := event["object"]; ok {
+			if translated, err := translateObject(object, w.from, w.to); err == nil {
+				event["object"] = translated
+			}
+		}
+		encoded, err := json.Marshal(event)
+		if err != nil {
+			return 0, err
+		}
+		if _, err := w.ResponseWriter.Write(append(encoded, '\n')); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[dec.InputOffset():]
+		dec = json.NewDecoder(bytes.NewReader(w.buf))
+	}
+
+	return len(data), nil
+}
+
+func (w *translatingWatchWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *translatingWatchWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// flushRemainder writes an incomplete trailing event as is.
+func (w *translatingWatchWriter) flushRemainder() {
+	if len(bytes.TrimSpace(w.buf)) > 0 {
+		_, _ = w.ResponseWriter.Write(w.buf)
+		w.buf = nil
+	}
+}
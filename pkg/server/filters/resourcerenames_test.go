@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filters
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/tools/cache"
+
+	kcpcache "github.com/kcp-dev/apimachinery/v2/pkg/cache"
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+
+	"github.com/kcp-dev/kcp/pkg/indexers"
+)
+
+func TestWithResourceRenames(t *testing.T) {
+	apiBindingIndexer := cache.NewIndexer(kcpcache.MetaClusterNamespaceKeyFunc, cache.Indexers{
+		indexers.APIBindingByServedResources: indexers.IndexAPIBindingByServedResources,
+	})
+	require.NoError(t, apiBindingIndexer.Add(&apisv1alpha2.APIBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "widgets",
+			Annotations: map[string]string{logicalcluster.AnnotationKey: "org-ws"},
+		},
+		Status: apisv1alpha2.APIBindingStatus{
+			BoundResources: []apisv1alpha2.BoundAPIResource{
+				{
+					Group:    "example.io",
+					Resource: "widgets",
+					Schema:   apisv1alpha2.BoundAPIResourceSchema{IdentityHash: "id1"},
+					ServedAs: &apisv1alpha2.GroupResource{Group: "acme.example.com", Resource: "widgets"},
+				},
+				{
+					Group:    "example.io",
+					Resource: "gadgets",
+					Schema:   apisv1alpha2.BoundAPIResourceSchema{IdentityHash: "id1"},
+					ServedAs: &apisv1alpha2.GroupResource{Group: "example.io", Resource: "acmegadgets"},
+				},
+				{
+					Group:    "example.io",
+					Resource: "things",
+					Schema:   apisv1alpha2.BoundAPIResourceSchema{IdentityHash: "id1"},
+				},
+			},
+		},
+	}))
+
+	tests := map[string]struct {
+		cluster     request.Cluster
+		method      string
+		path        string
+		contentType string
+		body        string
+		response    []string
+
+		wantPath     string
+		wantGroup    string
+		wantResource string
+		wantBody     string
+		wantStatus   int
+		wantResponse string
+	}{
+		"not renamed": {
+			cluster:      request.Cluster{Name: "org-ws"},
+			method:       http.MethodGet,
+			path:         "/apis/example.io/v1/things",
+			response:     []string{`{"apiVersion":"example.io/v1","kind":"ThingList","items":[]}`},
+			wantPath:     "/apis/example.io/v1/things",
+			wantGroup:    "example.io",
+			wantResource: "things",
+			wantResponse: `{"apiVersion":"example.io/v1","kind":"ThingList","items":[]}`,
+		},
+		"other cluster": {
+			cluster:      request.Cluster{Name: "org-other"},
+			method:       http.MethodGet,
+			path:         "/apis/acme.example.com/v1/widgets",
+			wantPath:     "/apis/acme.example.com/v1/widgets",
+			wantGroup:    "acme.example.com",
+			wantResource: "widgets",
+		},
+		"wildcard": {
+			cluster:      request.Cluster{Wildcard: true},
+			method:       http.MethodGet,
+			path:         "/apis/acme.example.com/v1/widgets",
+			wantPath:     "/apis/acme.example.com/v1/widgets",
+			wantGroup:    "acme.example.com",
+			wantResource: "widgets",
+		},
+		"get renamed group": {
+			cluster:      request.Cluster{Name: "org-ws"},
+			method:       http.MethodGet,
+			path:         "/apis/acme.example.com/v1/namespaces/widgets/widgets/foo",
+			response:     []string{`{"apiVersion":"example.io/v1","kind":"Widget","metadata":{"name":"foo","managedFields":[{"apiVersion":"example.io/v1","manager":"kubectl"}]}}`},
+			wantPath:     "/apis/example.io/v1/namespaces/widgets/widgets:id1/foo",
+			wantGroup:    "example.io",
+			wantResource: "widgets:id1",
+			wantResponse: `{"apiVersion":"acme.example.com/v1","kind":"Widget","metadata":{"managedFields":[{"apiVersion":"acme.example.com/v1","manager":"kubectl"}],"name":"foo"}}`,
+		},
+		"list renamed group": {
+			cluster:      request.Cluster{Name: "org-ws"},
+			method:       http.MethodGet,
+			path:         "/apis/acme.example.com/v1/widgets",
+			response:     []string{`{"apiVersion":"example.io/v1","kind":"WidgetList","items":[{"apiVersion":"example.io/v1","kind":"Widget","spec":{"size":12345678901234567890}}]}`},
+			wantPath:     "/apis/example.io/v1/widgets:id1",
+			wantGroup:    "example.io",
+			wantResource: "widgets:id1",
+			wantResponse: `{"apiVersion":"acme.example.com/v1","items":[{"apiVersion":"acme.example.com/v1","kind":"Widget","spec":{"size":12345678901234567890}}],"kind":"WidgetList"}`,
+		},
+		"create renamed group": {
+			cluster:      request.Cluster{Name: "org-ws"},
+			method:       http.MethodPost,
+			path:         "/apis/acme.example.com/v1/namespaces/default/widgets",
+			contentType:  "application/json",
+			body:         `{"apiVersion":"acme.example.com/v1","kind":"Widget","metadata":{"name":"foo"}}`,
+			response:     []string{`{"apiVersion":"example.io/v1","kind":"Widget","metadata":{"name":"foo"}}`},
+			wantPath:     "/apis/example.io/v1/namespaces/default/widgets:id1",
+			wantGroup:    "example.io",
+			wantResource: "widgets:id1",
+			wantBody:     `{"apiVersion":"example.io/v1","kind":"Widget","metadata":{"name":"foo"}}`,
+			wantResponse: `{"apiVersion":"acme.example.com/v1","kind":"Widget","metadata":{"name":"foo"}}`,
+		},
+		"apply renamed group": {
+			cluster:      request.Cluster{Name: "org-ws"},
+			method:       http.MethodPatch,
+			path:         "/apis/acme.example.com/v1/namespaces/default/widgets/foo",
+			contentType:  "application/apply-patch+yaml",
+			body:         "apiVersion: acme.example.com/v1\nkind: Widget\nmetadata:\n  name: foo\n",
+			wantPath:     "/apis/example.io/v1/namespaces/default/widgets:id1/foo",
+			wantGroup:    "example.io",
+			wantResource: "widgets:id1",
+			wantBody:     `{"apiVersion":"example.io/v1","kind":"Widget","metadata":{"name":"foo"}}`,
+		},
+		"json patch renamed group": {
+			cluster:      request.Cluster{Name: "org-ws"},
+			method:       http.MethodPatch,
+			path:         "/apis/acme.example.com/v1/namespaces/default/widgets/foo",
+			contentType:  "application/json-patch+json",
+			body:         `[{"op":"replace","path":"/spec/size","value":1}]`,
+			wantPath:     "/apis/example.io/v1/namespaces/default/widgets:id1/foo",
+			wantGroup:    "example.io",
+			wantResource: "widgets:id1",
+			wantBody:     `[{"op":"replace","path":"/spec/size","value":1}]`,
+		},
+		"request body too large": {
+			cluster:     request.Cluster{Name: "org-ws"},
+			method:      http.MethodPost,
+			path:        "/apis/acme.example.com/v1/namespaces/default/widgets",
+			contentType: "application/json",
+			body:        `{"apiVersion":"acme.example.com/v1","kind":"Widget","metadata":{"name":"` + strings.Repeat("a", 1024) + `"}}`,
+			wantStatus:  http.StatusRequestEntityTooLarge,
+		},
+		"watch renamed group": {
+			cluster: request.Cluster{Name: "org-ws"},
+			method:  http.MethodGet,
+			path:    "/apis/acme.example.com/v1/widgets?watch=true",
+			response: []string{
+				`{"type":"ADDED","object":{"apiVersion":"example.io/v1","kind":"Widget"}}` + "\n" + `{"type":"MODIFIED","obj`,
+				`ect":{"apiVersion":"example.io/v1","kind":"Widget"}}` + "\n",
+			},
+			wantPath:     "/apis/example.io/v1/widgets:id1",
+			wantGroup:    "example.io",
+			wantResource: "widgets:id1",
+			wantResponse: `{"object":{"apiVersion":"acme.example.com/v1","kind":"Widget"},"type":"ADDED"}` + "\n" + `{"object":{"apiVersion":"acme.example.com/v1","kind":"Widget"},"type":"MODIFIED"}` + "\n",
+		},
+		"renamed resource": {
+			cluster:      request.Cluster{Name: "org-ws"},
+			method:       http.MethodGet,
+			path:         "/apis/example.io/v1/acmegadgets",
+			response:     []string{`{"apiVersion":"example.io/v1","kind":"GadgetList","items":[]}`},
+			wantPath:     "/apis/example.io/v1/gadgets:id1",
+			wantGroup:    "example.io",
+			wantResource: "gadgets:id1",
+			wantResponse: `{"apiVersion":"example.io/v1","kind":"GadgetList","items":[]}`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotPath, gotGroup, gotResource, gotBody string
+			handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestInfo, _ := request.RequestInfoFrom(req.Context())
+				gotPath = req.URL.Path
+				gotGroup = requestInfo.APIGroup
+				gotResource = requestInfo.Resource
+				if req.Body != nil {
+					body, err := io.ReadAll(req.Body)
+					require.NoError(t, err)
+					gotBody = string(body)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				for _, chunk := range tc.response {
+					_, err := w.Write([]byte(chunk))
+					require.NoError(t, err)
+				}
+			})
+
+			var body io.Reader
+			if tc.body != "" {
+				body = strings.NewReader(tc.body)
+			}
+			req := httptest.NewRequest(tc.method, tc.path, body)
+			if tc.contentType != "" {
+				req.Header.Set("Content-Type", tc.contentType)
+			}
+			requestInfoFactory := &request.RequestInfoFactory{
+				APIPrefixes:          sets.NewString("api", "apis"),
+				GrouplessAPIPrefixes: sets.NewString("api"),
+			}
+			requestInfo, err := requestInfoFactory.NewRequestInfo(req)
+			require.NoError(t, err)
+			ctx := request.WithRequestInfo(req.Context(), requestInfo)
+			ctx = request.WithCluster(ctx, tc.cluster)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+			withResourceRenames(handler, apiBindingIndexer, 1024).ServeHTTP(rec, req)
+
+			if tc.wantStatus != 0 {
+				require.Equal(t, tc.wantStatus, rec.Code)
+				return
+			}
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, tc.wantPath, gotPath)
+			require.Equal(t, tc.wantGroup, gotGroup)
+			require.Equal(t, tc.wantResource, gotResource)
+			if tc.body != "" {
+				require.Equal(t, tc.wantBody, gotBody)
+			}
+			require.Equal(t, tc.wantResponse, rec.Body.String())
+		})
+	}
+}
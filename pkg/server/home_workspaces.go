@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -308,7 +309,7 @@ func (h *homeWorkspaceHandler) ServeHTTP(rw http.ResponseWriter, req *http.Reque
 		},
 		Spec: tenancyv1alpha1.WorkspaceSpec{
 			Cluster: logicalcluster.From(logicalCluster).String(),
-			URL:     logicalCluster.Status.URL,
+			URL:     homeWorkspaceURL(logicalCluster),
 		},
 		Status: tenancyv1alpha1.WorkspaceStatus{
 			Phase:        logicalCluster.Status.Phase,
@@ -319,6 +320,20 @@ func (h *homeWorkspaceHandler) ServeHTTP(rw http.ResponseWriter, req *http.Reque
 	responsewriters.WriteObjectNegotiated(homeWorkspaceCodecs, negotiation.DefaultEndpointRestrictions, tenancyv1alpha1.SchemeGroupVersion, rw, req, http.StatusOK, homeWorkspace, false)
 }
 
+// homeWorkspaceURL returns the URL of the home workspace. A home workspace that has been moved
+// into the workspace hierarchy is owned by a workspace, and is referenced by its new path.
+func homeWorkspaceURL(logicalCluster *corev1alpha1.LogicalCluster) string {
+	path, found := logicalCluster.Annotations[core.LogicalClusterPathAnnotationKey]
+	if logicalCluster.Spec.Owner == nil || !found {
+		return logicalCluster.Status.URL
+	}
+	clusterPath := logicalcluster.From(logicalCluster).Path().RequestPath()
+	if !strings.HasSuffix(logicalCluster.Status.URL, clusterPath) {
+		return logicalCluster.Status.URL
+	}
+	return strings.TrimSuffix(logicalCluster.Status.URL, clusterPath) + logicalcluster.NewPath(path).RequestPath()
+}
+
 func (h *homeWorkspaceHandler) getWorkspaceType(path logicalcluster.Path, name string) (*tenancyv1alpha1.WorkspaceType, error) {
 	return indexers.ByPathAndName[*tenancyv1alpha1.WorkspaceType](tenancyv1alpha1.Resource("workspacetypes"), h.workspaceTypeIndexer, path, name)
 }
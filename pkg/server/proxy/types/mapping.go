@@ -30,6 +30,11 @@ type PathMapping struct {
 	UserHeader        string `json:"user_header,omitempty"`
 	GroupHeader       string `json:"group_header,omitempty"`
 	ExtraHeaderPrefix string `json:"extra_header_prefix"`
+
+	// Backends are replicas of Backend sharing its certificates. The front-proxy
+	// sends requests to the first healthy one of Backend and Backends, and retries
+	// idempotent requests against the next one if a backend cannot be reached.
+	Backends []string `json:"backends,omitempty"`
 }
 
 // httpHandlerMapping is used to route traffic to the correct backend server.
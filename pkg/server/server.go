@@ -256,6 +256,9 @@ func (s *Server) installControllers(ctx context.Context, controllerConfig *rest.
 		if err := s.installWorkspaceMountsScheduler(ctx, controllerConfig); err != nil {
 			return err
 		}
+		if err := s.installWorkspaceExpirationController(ctx, controllerConfig); err != nil {
+			return err
+		}
 		if err := s.installTenancyLogicalClusterController(ctx, controllerConfig); err != nil {
 			return err
 		}
@@ -265,6 +268,9 @@ func (s *Server) installControllers(ctx context.Context, controllerConfig *rest.
 		if err := s.installLogicalCluster(ctx, controllerConfig); err != nil {
 			return err
 		}
+		if err := s.installShardAllocationController(ctx); err != nil {
+			return err
+		}
 	}
 
 	if s.Options.Controllers.EnableAll || enabled.Has("apibinding") {
@@ -335,6 +341,12 @@ func (s *Server) installControllers(ctx context.Context, controllerConfig *rest.
 		}
 	}
 
+	if s.Options.Controllers.EnableAll || enabled.Has("apideployment") {
+		if err := s.installAPIDeploymentController(ctx, controllerConfig); err != nil {
+			return err
+		}
+	}
+
 	if s.Options.Controllers.EnableAll || enabled.Has("apiexportendpointslice") {
 		if err := s.installAPIExportEndpointSliceController(ctx, controllerConfig); err != nil {
 			return err
@@ -356,6 +368,12 @@ func (s *Server) installControllers(ctx context.Context, controllerConfig *rest.
 		}
 	}
 
+	if s.Options.Controllers.EnableAll || enabled.Has("defaultresources") {
+		if err := s.installDefaultResourcesController(ctx, controllerConfig); err != nil {
+			return err
+		}
+	}
+
 	if s.Options.Controllers.EnableAll || enabled.Has("partition") {
 		if err := s.installPartitionSetController(ctx, controllerConfig); err != nil {
 			return err
@@ -358,11 +358,20 @@ func (s *Server) getAPIBindingForRequest(
 		return nil, err
 	}
 
-	if len(apiBindings) > 0 {
+	for _, apiBinding := range apiBindings {
 		// Matching cluster/identity and bound GR should mean we have the correct APIBinding.
 		// This is similar to what we're doing in apiBindingAwareCRDLister when selecting
-		// a binding by identity wildcard.
-		return apiBindings[0], nil
+		// a binding by identity wildcard. In a single cluster, a GR can also be bound by a second
+		// APIBinding serving it under another name, which is requested with its identity.
+		for _, br := range apiBinding.Status.BoundResources {
+			if br.Group != gr.Group || br.Resource != gr.Resource {
+				continue
+			}
+			if (identity != "" && br.Schema.IdentityHash != identity) || (identity == "" && br.ServedAs != nil) {
+				continue
+			}
+			return apiBinding, nil
+		}
 	}
 
 	// This GR does not seem to be provided by an APIBinding.
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -104,8 +105,8 @@ func (s *selectorAdmission) Admit(ctx context.Context, a kubeadmission.Attribute
 	}
 
 	for _, permissionClaim := range apiBinding.Spec.PermissionClaims {
-		if permissionClaim.State != apisv1alpha2.ClaimAccepted {
-			// if the claim is not accepted it cannot be used.
+		if !permissionClaim.IsAcceptedAt(time.Now()) {
+			// if the claim is not accepted or the acceptance expired, it cannot be used.
 			continue
 		}
 
@@ -196,8 +197,8 @@ func (s *selectorAdmission) Validate(ctx context.Context, a kubeadmission.Attrib
 	}
 
 	for _, permissionClaim := range apiBinding.Spec.PermissionClaims {
-		if permissionClaim.State != apisv1alpha2.ClaimAccepted {
-			// if the claim is not accepted it cannot be used.
+		if !permissionClaim.IsAcceptedAt(time.Now()) {
+			// if the claim is not accepted or the acceptance expired, it cannot be used.
 			continue
 		}
 
@@ -218,6 +219,10 @@ func (s *selectorAdmission) Validate(ctx context.Context, a kubeadmission.Attrib
 				return kubeadmission.NewForbidden(a, fmt.Errorf("unexpected type %T", obj))
 			}
 
+			if !permissionClaim.Selector.MatchesNamespaceAndName(u.GetNamespace(), u.GetName()) {
+				return kubeadmission.NewForbidden(a, fmt.Errorf("object namespace or name is not selected by the permission claim"))
+			}
+
 			lbls := u.GetLabels()
 			if lbls == nil {
 				lbls = map[string]string{}
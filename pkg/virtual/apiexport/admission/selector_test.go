@@ -138,6 +138,33 @@ func apiBindingMatchExpressions(state apisv1alpha2.AcceptablePermissionClaimStat
 	}
 }
 
+func apiBindingNamespacesAndNames(state apisv1alpha2.AcceptablePermissionClaimState) *apisv1alpha2.APIBinding {
+	return &apisv1alpha2.APIBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cool-something",
+		},
+		Spec: apisv1alpha2.APIBindingSpec{
+			PermissionClaims: []apisv1alpha2.AcceptablePermissionClaim{
+				{
+					ScopedPermissionClaim: apisv1alpha2.ScopedPermissionClaim{
+						PermissionClaim: apisv1alpha2.PermissionClaim{
+							GroupResource: apisv1alpha2.GroupResource{
+								Group:    "",
+								Resource: "configmaps",
+							},
+						},
+						Selector: apisv1alpha2.PermissionClaimSelector{
+							Namespaces: []string{metav1.NamespaceDefault},
+							Names:      []string{"cool-something"},
+						},
+					},
+					State: state,
+				},
+			},
+		},
+	}
+}
+
 func init() {
 	scheme.AddKnownTypes(corev1.SchemeGroupVersion,
 		&corev1.ConfigMap{},
@@ -708,6 +735,51 @@ func TestValidate(t *testing.T) {
 				return apiBindingMatchExpressions(apisv1alpha2.ClaimAccepted), nil
 			},
 		},
+		"namespaces and names, object selected": {
+			apidomainKey: apiDomainKey,
+			resource:     corev1.SchemeGroupVersion.WithResource("configmaps"),
+			obj: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cool-something",
+					Namespace: metav1.NamespaceDefault,
+				},
+			},
+			update:    false,
+			wantError: "",
+			getAPIBindingByExport: func(clusterName, apiExportName, apiExportCluster string) (*apisv1alpha2.APIBinding, error) {
+				return apiBindingNamespacesAndNames(apisv1alpha2.ClaimAccepted), nil
+			},
+		},
+		"namespaces and names, object in another namespace": {
+			apidomainKey: apiDomainKey,
+			resource:     corev1.SchemeGroupVersion.WithResource("configmaps"),
+			obj: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cool-something",
+					Namespace: "other",
+				},
+			},
+			update:    true,
+			wantError: "object namespace or name is not selected by the permission claim",
+			getAPIBindingByExport: func(clusterName, apiExportName, apiExportCluster string) (*apisv1alpha2.APIBinding, error) {
+				return apiBindingNamespacesAndNames(apisv1alpha2.ClaimAccepted), nil
+			},
+		},
+		"namespaces and names, object with another name": {
+			apidomainKey: apiDomainKey,
+			resource:     corev1.SchemeGroupVersion.WithResource("configmaps"),
+			obj: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "other",
+					Namespace: metav1.NamespaceDefault,
+				},
+			},
+			update:    false,
+			wantError: "object namespace or name is not selected by the permission claim",
+			getAPIBindingByExport: func(clusterName, apiExportName, apiExportCluster string) (*apisv1alpha2.APIBinding, error) {
+				return apiBindingNamespacesAndNames(apisv1alpha2.ClaimAccepted), nil
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
@@ -118,8 +119,8 @@ func (a *boundAPIAuthorizer) Authorize(ctx context.Context, attr authorizer.Attr
 
 	// check if a resource claim for this resource has been accepted and has correct verbs.
 	for _, permissionClaim := range apiBinding.Spec.PermissionClaims {
-		if permissionClaim.State != apisv1alpha2.ClaimAccepted {
-			// if the claim is not accepted it cannot be used.
+		if !permissionClaim.IsAcceptedAt(time.Now()) {
+			// if the claim is not accepted or the acceptance expired, it cannot be used.
 			continue
 		}
 
@@ -142,6 +143,11 @@ func (a *boundAPIAuthorizer) Authorize(ctx context.Context, attr authorizer.Attr
 				continue
 			}
 
+			if !selectorAllowsRequest(permissionClaim.Selector, attr) {
+				// if the requested namespace or name is not selected, the claim cannot be used.
+				continue
+			}
+
 			return a.delegate.Authorize(ctx, attr)
 		}
 	}
@@ -160,3 +166,17 @@ func (a *boundAPIAuthorizer) Authorize(ctx context.Context, attr authorizer.Attr
 	// The APIExport owner has not been invited in.
 	return authorizer.DecisionDeny, "failed to find suitable reason to allow access in APIBinding", nil
 }
+
+// selectorAllowsRequest returns false if the request targets a namespace or a name
+// that is not selected by the namespaces or names of the permission claim selector.
+// Requests without namespace or name, e.g. cross-namespace lists, are allowed because
+// the results are limited to the objects labeled for the claim.
+func selectorAllowsRequest(selector apisv1alpha2.PermissionClaimSelector, attr authorizer.Attributes) bool {
+	if len(selector.Namespaces) > 0 && attr.GetNamespace() != "" && !slices.Contains(selector.Namespaces, attr.GetNamespace()) {
+		return false
+	}
+	if len(selector.Names) > 0 && attr.GetName() != "" && !slices.Contains(selector.Names, attr.GetName()) {
+		return false
+	}
+	return true
+}
@@ -19,6 +19,7 @@ package authorizer
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -46,6 +47,52 @@ var (
 )
 
 func TestBoundAPIAuthorizer(t *testing.T) {
+	getNamespacedClaimBinding := func(clusterName, apiExportName, apiExportCluster string) (*apisv1alpha2.APIBinding, error) {
+		return &apisv1alpha2.APIBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "bar",
+			},
+			Spec: apisv1alpha2.APIBindingSpec{
+				PermissionClaims: []apisv1alpha2.AcceptablePermissionClaim{
+					{
+						ScopedPermissionClaim: apisv1alpha2.ScopedPermissionClaim{
+							PermissionClaim: apisv1alpha2.PermissionClaim{
+								GroupResource: apisv1alpha2.GroupResource{
+									Group:    "foo",
+									Resource: "bar",
+								},
+								Verbs: []string{"get"},
+							},
+							Selector: apisv1alpha2.PermissionClaimSelector{
+								Namespaces: []string{"selected"},
+								Names:      []string{"selected"},
+							},
+						},
+						State: apisv1alpha2.ClaimAccepted,
+					},
+				},
+			},
+		}, nil
+	}
+	getNamespacedClaimExport := func(clusterName, apiExportName string) (*apisv1alpha2.APIExport, error) {
+		return &apisv1alpha2.APIExport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "bar",
+			},
+			Spec: apisv1alpha2.APIExportSpec{
+				PermissionClaims: []apisv1alpha2.PermissionClaim{
+					{
+						GroupResource: apisv1alpha2.GroupResource{
+							Group:    "foo",
+							Resource: "bar",
+						},
+						Verbs: []string{"get"},
+					},
+				},
+			},
+		}, nil
+	}
+
 	for _, tc := range []struct {
 		name                  string
 		attr                  authorizer.Attributes
@@ -342,6 +389,63 @@ func TestBoundAPIAuthorizer(t *testing.T) {
 			expectedDecision: authorizer.DecisionDeny,
 			expectedReason:   "failed to find suitable reason to allow access in APIBinding",
 		},
+		{
+			name: "list request to the API bound via permission claims (expired claim)",
+			attr: &authorizer.AttributesRecord{
+				User:     &user.DefaultInfo{},
+				APIGroup: "foo",
+				Resource: "bar",
+				Verb:     "list",
+			},
+			apidomainKey: apidomainKey,
+			getAPIBindingByExport: func(clusterName, apiExportName, apiExportCluster string) (*apisv1alpha2.APIBinding, error) {
+				return &apisv1alpha2.APIBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "bar",
+					},
+					Spec: apisv1alpha2.APIBindingSpec{
+						PermissionClaims: []apisv1alpha2.AcceptablePermissionClaim{
+							{
+								ScopedPermissionClaim: apisv1alpha2.ScopedPermissionClaim{
+									PermissionClaim: apisv1alpha2.PermissionClaim{
+										GroupResource: apisv1alpha2.GroupResource{
+											Group:    "foo",
+											Resource: "bar",
+										},
+										Verbs: []string{"list"},
+									},
+									Selector: apisv1alpha2.PermissionClaimSelector{
+										MatchAll: true,
+									},
+								},
+								State:     apisv1alpha2.ClaimAccepted,
+								ExpiresAt: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+							},
+						},
+					},
+				}, nil
+			},
+			getAPIExport: func(clusterName, apiExportName string) (*apisv1alpha2.APIExport, error) {
+				return &apisv1alpha2.APIExport{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "bar",
+					},
+					Spec: apisv1alpha2.APIExportSpec{
+						PermissionClaims: []apisv1alpha2.PermissionClaim{
+							{
+								GroupResource: apisv1alpha2.GroupResource{
+									Group:    "foo",
+									Resource: "bar",
+								},
+								Verbs: []string{"list"},
+							},
+						},
+					},
+				}, nil
+			},
+			expectedDecision: authorizer.DecisionDeny,
+			expectedReason:   "failed to find suitable reason to allow access in APIBinding",
+		},
 		{
 			name: "list request when both APIExport and APIBinding do not allow list",
 			attr: &authorizer.AttributesRecord{
@@ -565,6 +669,53 @@ func TestBoundAPIAuthorizer(t *testing.T) {
 			},
 			expectedDecision: authorizer.DecisionAllow,
 		},
+		{
+			name: "get request to the API bound via permission claims in a selected namespace and name",
+			attr: &authorizer.AttributesRecord{
+				User:      &user.DefaultInfo{},
+				APIGroup:  "foo",
+				Resource:  "bar",
+				Namespace: "selected",
+				Name:      "selected",
+				Verb:      "get",
+			},
+			apidomainKey:          apidomainKey,
+			getAPIBindingByExport: getNamespacedClaimBinding,
+			getAPIExport:          getNamespacedClaimExport,
+			expectedDecision:      authorizer.DecisionAllow,
+		},
+		{
+			name: "get request to the API bound via permission claims in a namespace not selected",
+			attr: &authorizer.AttributesRecord{
+				User:      &user.DefaultInfo{},
+				APIGroup:  "foo",
+				Resource:  "bar",
+				Namespace: "other",
+				Name:      "selected",
+				Verb:      "get",
+			},
+			apidomainKey:          apidomainKey,
+			getAPIBindingByExport: getNamespacedClaimBinding,
+			getAPIExport:          getNamespacedClaimExport,
+			expectedDecision:      authorizer.DecisionDeny,
+			expectedReason:        "failed to find suitable reason to allow access in APIBinding",
+		},
+		{
+			name: "get request to the API bound via permission claims with a name not selected",
+			attr: &authorizer.AttributesRecord{
+				User:      &user.DefaultInfo{},
+				APIGroup:  "foo",
+				Resource:  "bar",
+				Namespace: "selected",
+				Name:      "other",
+				Verb:      "get",
+			},
+			apidomainKey:          apidomainKey,
+			getAPIBindingByExport: getNamespacedClaimBinding,
+			getAPIExport:          getNamespacedClaimExport,
+			expectedDecision:      authorizer.DecisionDeny,
+			expectedReason:        "failed to find suitable reason to allow access in APIBinding",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			lc, _ := logicalcluster.NewPath(tc.apidomainKey).Name()
@@ -27,9 +27,11 @@ import (
 	"k8s.io/component-base/version"
 	"k8s.io/klog/v2"
 
+	apiexportcmd "github.com/kcp-dev/cli/pkg/apiexport/cmd"
 	bindcmd "github.com/kcp-dev/cli/pkg/bind/cmd"
 	claimscmd "github.com/kcp-dev/cli/pkg/claims/cmd"
 	crdcmd "github.com/kcp-dev/cli/pkg/crd/cmd"
+	shardcmd "github.com/kcp-dev/cli/pkg/shard/cmd"
 	workspacecmd "github.com/kcp-dev/cli/pkg/workspace/cmd"
 	"github.com/kcp-dev/sdk/cmd/help"
 )
@@ -77,8 +79,14 @@ func KubectlKcpCommand() *cobra.Command {
 	bindCmd := bindcmd.New(genericclioptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr})
 	root.AddCommand(bindCmd)
 
+	apiexportCmd := apiexportcmd.New(genericclioptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr})
+	root.AddCommand(apiexportCmd)
+
 	claimsCmd := claimscmd.New(genericclioptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr})
 	root.AddCommand(claimsCmd)
 
+	shardCmd := shardcmd.New(genericclioptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr})
+	root.AddCommand(shardCmd)
+
 	return root
 }
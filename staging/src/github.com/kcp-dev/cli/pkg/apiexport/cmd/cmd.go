@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/kcp-dev/cli/pkg/apiexport/plugin"
+)
+
+var (
+	checkUpgradeExample = `
+# Check whether the APIResourceSchema "v2.widgets.example.io" can replace the schemas currently bound by all APIBindings to the APIExport "example.io".
+%[1]s apiexport check-upgrade example.io v2.widgets.example.io
+
+# Check multiple new APIResourceSchemas at once, using the endpoints of a specific APIExportEndpointSlice.
+%[1]s apiexport check-upgrade example.io v2.widgets.example.io v2.gadgets.example.io --endpoint-slice example.io-eu
+`
+)
+
+// New returns a cobra.Command for APIExport related actions.
+func New(streams genericclioptions.IOStreams) *cobra.Command {
+	cliName := "kubectl"
+	if pflag.CommandLine.Name() == "kubectl-kcp" {
+		cliName = "kubectl kcp"
+	}
+
+	cmd := &cobra.Command{
+		Use:              "apiexport",
+		Short:            "Operations related to APIExports",
+		SilenceUsage:     true,
+		TraverseChildren: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	checkUpgradeOpts := plugin.NewCheckUpgradeOptions(streams)
+	checkUpgradeCmd := &cobra.Command{
+		Use:          "check-upgrade <apiexport-name> <apiresourceschema-name>...",
+		Short:        "Check which APIBindings would break if the given APIResourceSchemas were exported",
+		Example:      fmt.Sprintf(checkUpgradeExample, cliName),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkUpgradeOpts.Complete(args); err != nil {
+				return err
+			}
+
+			if err := checkUpgradeOpts.Validate(); err != nil {
+				return err
+			}
+
+			return checkUpgradeOpts.Run(cmd.Context())
+		},
+	}
+	checkUpgradeOpts.BindFlags(checkUpgradeCmd)
+
+	cmd.AddCommand(checkUpgradeCmd)
+	return cmd
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/multierr"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/rest"
+
+	"github.com/kcp-dev/cli/pkg/base"
+	pluginhelpers "github.com/kcp-dev/cli/pkg/helpers"
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+	"github.com/kcp-dev/sdk/schemacompat"
+)
+
+// CheckUpgradeOptions contains the options for checking whether new APIResourceSchemas
+// are compatible with the schemas currently bound by the APIBindings of an APIExport.
+type CheckUpgradeOptions struct {
+	*base.Options
+
+	// APIExportName is the name of the APIExport in the current workspace.
+	APIExportName string
+	// APIResourceSchemaNames are the names of the new APIResourceSchemas in the current workspace.
+	APIResourceSchemaNames []string
+	// EndpointSliceName is the name of the APIExportEndpointSlice whose endpoints are used
+	// to find the APIBindings. Defaults to the APIExport name.
+	EndpointSliceName string
+}
+
+// NewCheckUpgradeOptions returns new CheckUpgradeOptions.
+func NewCheckUpgradeOptions(streams genericclioptions.IOStreams) *CheckUpgradeOptions {
+	return &CheckUpgradeOptions{
+		Options: base.NewOptions(streams),
+	}
+}
+
+// BindFlags binds fields to cmd's flagset.
+func (o *CheckUpgradeOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+
+	cmd.Flags().StringVar(&o.EndpointSliceName, "endpoint-slice", o.EndpointSliceName, "Name of the APIExportEndpointSlice used to find the APIBindings. Defaults to the APIExport name. Only APIBindings on shards covered by the slice are checked.")
+}
+
+// Complete ensures all fields are initialized.
+func (o *CheckUpgradeOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		o.APIExportName = args[0]
+		o.APIResourceSchemaNames = args[1:]
+	}
+	if o.EndpointSliceName == "" {
+		o.EndpointSliceName = o.APIExportName
+	}
+	return nil
+}
+
+// Validate validates the CheckUpgradeOptions are complete and usable.
+func (o *CheckUpgradeOptions) Validate() error {
+	if o.APIExportName == "" {
+		return errors.New("an APIExport name is required")
+	}
+	if len(o.APIResourceSchemaNames) == 0 {
+		return errors.New("at least one APIResourceSchema name is required")
+	}
+	return o.Options.Validate()
+}
+
+// Run checks every schema bound through the APIExport against the new APIResourceSchemas
+// and prints the incompatible field paths together with the affected APIBindings.
+func (o *CheckUpgradeOptions) Run(ctx context.Context) error {
+	config, err := o.ClientConfig.ClientConfig()
+	if err != nil {
+		return err
+	}
+
+	_, currentClusterName, err := pluginhelpers.ParseClusterURL(config.Host)
+	if err != nil {
+		return fmt.Errorf("current URL %q does not point to workspace", config.Host)
+	}
+
+	kcpClusterClient, err := newKCPClusterClient(config)
+	if err != nil {
+		return fmt.Errorf("error while creating kcp client: %w", err)
+	}
+	kcpClient := kcpClusterClient.Cluster(currentClusterName)
+
+	if _, err := kcpClient.ApisV1alpha2().APIExports().Get(ctx, o.APIExportName, metav1.GetOptions{}); err != nil {
+		return fmt.Errorf("error getting APIExport %q: %w", o.APIExportName, err)
+	}
+
+	newSchemas := make([]*apisv1alpha1.APIResourceSchema, 0, len(o.APIResourceSchemaNames))
+	for _, name := range o.APIResourceSchemaNames {
+		schema, err := kcpClient.ApisV1alpha1().APIResourceSchemas().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("error getting APIResourceSchema %q: %w", name, err)
+		}
+		newSchemas = append(newSchemas, schema)
+	}
+
+	slice, err := kcpClient.ApisV1alpha1().APIExportEndpointSlices().Get(ctx, o.EndpointSliceName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting APIExportEndpointSlice %q: %w", o.EndpointSliceName, err)
+	}
+	if slice.Spec.APIExport.Name != o.APIExportName {
+		return fmt.Errorf("APIExportEndpointSlice %q belongs to APIExport %q, not %q", slice.Name, slice.Spec.APIExport.Name, o.APIExportName)
+	}
+
+	// The virtual workspace of every endpoint serves the APIBindings of the APIExport on its shard.
+	var bindings []apisv1alpha2.APIBinding
+	for _, endpoint := range slice.Status.APIExportEndpoints {
+		vwConfig := rest.CopyConfig(config)
+		vwConfig.Host = endpoint.URL
+		vwClient, err := kcpclientset.NewForConfig(vwConfig)
+		if err != nil {
+			return fmt.Errorf("error while creating client for %q: %w", endpoint.URL, err)
+		}
+		list, err := vwClient.Cluster(logicalcluster.Wildcard).ApisV1alpha2().APIBindings().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("error listing APIBindings at %q: %w", endpoint.URL, err)
+		}
+		bindings = append(bindings, list.Items...)
+	}
+
+	schemas := map[string]*apisv1alpha1.APIResourceSchema{}
+	getSchema := func(name string) (*apisv1alpha1.APIResourceSchema, error) {
+		if schema, ok := schemas[name]; ok {
+			return schema, nil
+		}
+		schema, err := kcpClient.ApisV1alpha1().APIResourceSchemas().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		schemas[name] = schema
+		return schema, nil
+	}
+
+	results, err := checkUpgrade(newSchemas, bindings, getSchema)
+	if err != nil {
+		return err
+	}
+
+	if incompatible := printUpgradeResults(o.Out, results); incompatible > 0 {
+		return fmt.Errorf("%d bound APIResourceSchema(s) are incompatible with the new APIResourceSchemas", incompatible)
+	}
+	return nil
+}
+
+// upgradeResult is the outcome of checking one bound APIResourceSchema against the new
+// APIResourceSchema for the same resource.
+type upgradeResult struct {
+	BoundSchema string
+	NewSchema   string
+	// Errors are the incompatibilities found, one per field path. Empty if the
+	// new schema is compatible.
+	Errors []error
+	// Bindings are the affected APIBindings, formatted as "<cluster> (APIBinding <name>)".
+	Bindings []string
+}
+
+// checkUpgrade compares the schema bound by every APIBinding against the new APIResourceSchema
+// for the same group and resource. Bound resources without a new schema, or that are already
+// bound to the new schema, are skipped. Results are sorted by bound and new schema name.
+func checkUpgrade(newSchemas []*apisv1alpha1.APIResourceSchema, bindings []apisv1alpha2.APIBinding, getSchema func(name string) (*apisv1alpha1.APIResourceSchema, error)) ([]upgradeResult, error) {
+	type key struct {
+		bound, new string
+	}
+	affected := map[key]sets.Set[string]{}
+
+	for i := range bindings {
+		binding := &bindings[i]
+		for _, bound := range binding.Status.BoundResources {
+			for _, schema := range newSchemas {
+				if bound.Group != schema.Spec.Group || bound.Resource != schema.Spec.Names.Plural || bound.Schema.Name == schema.Name {
+					continue
+				}
+				k := key{bound: bound.Schema.Name, new: schema.Name}
+				if affected[k] == nil {
+					affected[k] = sets.New[string]()
+				}
+				affected[k].Insert(fmt.Sprintf("%s (APIBinding %s)", logicalcluster.From(binding), binding.Name))
+			}
+		}
+	}
+
+	newSchemasByName := make(map[string]*apisv1alpha1.APIResourceSchema, len(newSchemas))
+	for _, schema := range newSchemas {
+		newSchemasByName[schema.Name] = schema
+	}
+
+	results := make([]upgradeResult, 0, len(affected))
+	for k, bindings := range affected {
+		result := upgradeResult{
+			BoundSchema: k.bound,
+			NewSchema:   k.new,
+			Bindings:    sets.List(bindings),
+		}
+
+		existing, err := getSchema(k.bound)
+		switch {
+		case apierrors.IsNotFound(err):
+			result.Errors = []error{fmt.Errorf("bound APIResourceSchema %q not found", k.bound)}
+		case err != nil:
+			return nil, fmt.Errorf("error getting APIResourceSchema %q: %w", k.bound, err)
+		default:
+			result.Errors = multierr.Errors(schemacompat.EnsureAPIResourceSchemaCompatibility(existing, newSchemasByName[k.new]))
+		}
+
+		results = append(results, result)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].BoundSchema != results[j].BoundSchema {
+			return results[i].BoundSchema < results[j].BoundSchema
+		}
+		return results[i].NewSchema < results[j].NewSchema
+	})
+
+	return results, nil
+}
+
+// printUpgradeResults prints the results and returns the number of incompatible bound schemas.
+func printUpgradeResults(out io.Writer, results []upgradeResult) int {
+	if len(results) == 0 {
+		fmt.Fprintln(out, "No APIBindings are bound to an older schema of the given resources.")
+		return 0
+	}
+
+	incompatible := 0
+	for _, result := range results {
+		if len(result.Errors) == 0 {
+			fmt.Fprintf(out, "%s -> %s: compatible, %d APIBinding(s) can be upgraded\n", result.BoundSchema, result.NewSchema, len(result.Bindings))
+			continue
+		}
+
+		incompatible++
+		fmt.Fprintf(out, "%s -> %s: incompatible, %d APIBinding(s) would break\n", result.BoundSchema, result.NewSchema, len(result.Bindings))
+		fmt.Fprintln(out, "  Incompatible fields:")
+		for _, err := range result.Errors {
+			fmt.Fprintf(out, "    %v\n", err)
+		}
+		fmt.Fprintln(out, "  Affected APIBindings:")
+		for _, binding := range result.Bindings {
+			fmt.Fprintf(out, "    %s\n", binding)
+		}
+	}
+	return incompatible
+}
+
+func newKCPClusterClient(config *rest.Config) (kcpclientset.ClusterInterface, error) {
+	clusterConfig := rest.CopyConfig(config)
+	u, err := url.Parse(config.Host)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = ""
+	clusterConfig.Host = u.String()
+	clusterConfig.UserAgent = rest.DefaultKubernetesUserAgent()
+	return kcpclientset.NewForConfig(clusterConfig)
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+)
+
+func newSchema(name, nameType string) *apisv1alpha1.APIResourceSchema {
+	return &apisv1alpha1.APIResourceSchema{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: apisv1alpha1.APIResourceSchemaSpec{
+			Group: "example.io",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Plural: "widgets", Kind: "Widget"},
+			Scope: apiextensionsv1.NamespaceScoped,
+			Versions: []apisv1alpha1.APIResourceVersion{{
+				Name:    "v1",
+				Served:  true,
+				Storage: true,
+				Schema: runtime.RawExtension{
+					Raw: []byte(`{"type":"object","properties":{"name":{"type":"` + nameType + `"}}}`),
+				},
+			}},
+		},
+	}
+}
+
+func newBinding(cluster, name, schemaName string) apisv1alpha2.APIBinding {
+	return apisv1alpha2.APIBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{logicalcluster.AnnotationKey: cluster},
+		},
+		Status: apisv1alpha2.APIBindingStatus{
+			BoundResources: []apisv1alpha2.BoundAPIResource{{
+				Group:    "example.io",
+				Resource: "widgets",
+				Schema:   apisv1alpha2.BoundAPIResourceSchema{Name: schemaName},
+			}},
+		},
+	}
+}
+
+func TestCheckUpgrade(t *testing.T) {
+	existing := map[string]*apisv1alpha1.APIResourceSchema{
+		"v1.widgets.example.io": newSchema("v1.widgets.example.io", "string"),
+		"v2.widgets.example.io": newSchema("v2.widgets.example.io", "integer"),
+	}
+	getSchema := func(name string) (*apisv1alpha1.APIResourceSchema, error) {
+		if schema, ok := existing[name]; ok {
+			return schema, nil
+		}
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: "apis.kcp.io", Resource: "apiresourceschemas"}, name)
+	}
+
+	tests := map[string]struct {
+		newSchema  *apisv1alpha1.APIResourceSchema
+		bindings   []apisv1alpha2.APIBinding
+		wantErrors map[string][]string
+		wantOutput string
+	}{
+		"no bindings": {
+			newSchema:  newSchema("v3.widgets.example.io", "string"),
+			wantErrors: map[string][]string{},
+			wantOutput: "No APIBindings are bound to an older schema of the given resources.\n",
+		},
+		"already bound to the new schema": {
+			newSchema:  newSchema("v1.widgets.example.io", "string"),
+			bindings:   []apisv1alpha2.APIBinding{newBinding("one", "widgets", "v1.widgets.example.io")},
+			wantErrors: map[string][]string{},
+			wantOutput: "No APIBindings are bound to an older schema of the given resources.\n",
+		},
+		"compatible": {
+			newSchema: newSchema("v3.widgets.example.io", "string"),
+			bindings: []apisv1alpha2.APIBinding{
+				newBinding("one", "widgets", "v1.widgets.example.io"),
+				newBinding("two", "widgets", "v1.widgets.example.io"),
+			},
+			wantErrors: map[string][]string{"v1.widgets.example.io": nil},
+			wantOutput: "v1.widgets.example.io -> v3.widgets.example.io: compatible, 2 APIBinding(s) can be upgraded\n",
+		},
+		"incompatible with one of the bound schemas": {
+			newSchema: newSchema("v3.widgets.example.io", "string"),
+			bindings: []apisv1alpha2.APIBinding{
+				newBinding("one", "widgets", "v1.widgets.example.io"),
+				newBinding("two", "widgets", "v2.widgets.example.io"),
+				newBinding("three", "other-widgets", "v2.widgets.example.io"),
+			},
+			wantErrors: map[string][]string{
+				"v1.widgets.example.io": nil,
+				"v2.widgets.example.io": {`spec.versions[v1].schema.openAPIV3Schema.properties[name].type: Invalid value: "string": The type changed (was "integer", now "string")`},
+			},
+			wantOutput: `v1.widgets.example.io -> v3.widgets.example.io: compatible, 1 APIBinding(s) can be upgraded
+v2.widgets.example.io -> v3.widgets.example.io: incompatible, 2 APIBinding(s) would break
+  Incompatible fields:
+    spec.versions[v1].schema.openAPIV3Schema.properties[name].type: Invalid value: "string": The type changed (was "integer", now "string")
+  Affected APIBindings:
+    three (APIBinding other-widgets)
+    two (APIBinding widgets)
+`,
+		},
+		"bound schema not found": {
+			newSchema:  newSchema("v3.widgets.example.io", "string"),
+			bindings:   []apisv1alpha2.APIBinding{newBinding("one", "widgets", "v0.widgets.example.io")},
+			wantErrors: map[string][]string{"v0.widgets.example.io": {`bound APIResourceSchema "v0.widgets.example.io" not found`}},
+			wantOutput: `v0.widgets.example.io -> v3.widgets.example.io: incompatible, 1 APIBinding(s) would break
+  Incompatible fields:
+    bound APIResourceSchema "v0.widgets.example.io" not found
+  Affected APIBindings:
+    one (APIBinding widgets)
+`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			results, err := checkUpgrade([]*apisv1alpha1.APIResourceSchema{tc.newSchema}, tc.bindings, getSchema)
+			require.NoError(t, err)
+
+			gotErrors := map[string][]string{}
+			for _, result := range results {
+				require.Equal(t, tc.newSchema.Name, result.NewSchema)
+				var errs []string
+				for _, err := range result.Errors {
+					errs = append(errs, err.Error())
+				}
+				gotErrors[result.BoundSchema] = errs
+			}
+			require.Equal(t, tc.wantErrors, gotErrors)
+
+			var out bytes.Buffer
+			printUpgradeResults(&out, results)
+			require.Equal(t, tc.wantOutput, out.String())
+		})
+	}
+}
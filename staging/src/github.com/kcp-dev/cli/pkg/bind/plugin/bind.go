@@ -56,11 +56,21 @@ type BindOptions struct {
 	AcceptedPermissionClaims []string
 	// RejectedPermissionClaims is the list of rejected permission claims for the APIBinding.
 	RejectedPermissionClaims []string
+	// Release is the release of the APIExport to pin the APIBinding to.
+	Release string
+	// Channel is the channel of the APIExport the APIBinding follows.
+	Channel string
+	// UpgradePolicy defines when the APIBinding moves to the release the channel points at.
+	UpgradePolicy string
+	// Resources is the list of resources of the APIExport to bind. All resources are bound if empty.
+	Resources []string
 
 	// acceptedPermissionClaims is the parsed list of accepted permission claims for the APIBinding parsed from AcceptedPermissionClaims.
 	acceptedPermissionClaims []apisv1alpha2.AcceptablePermissionClaim
 	// rejectedPermissionClaims is the parsed list of rejected permission claims for the APIBinding parsed from RejectedPermissionClaims.
 	rejectedPermissionClaims []apisv1alpha2.AcceptablePermissionClaim
+	// resources is the parsed list of resources to bind parsed from Resources.
+	resources []apisv1alpha2.GroupResource
 }
 
 // NewBindOptions returns new BindOptions.
@@ -78,6 +88,10 @@ func (b *BindOptions) BindFlags(cmd *cobra.Command) {
 	cmd.Flags().DurationVar(&b.BindWaitTimeout, "timeout", time.Second*30, "Duration to wait for APIBinding to be created successfully.")
 	cmd.Flags().StringSliceVar(&b.AcceptedPermissionClaims, "accept-permission-claim", nil, "List of accepted permission claims for the APIBinding. Format:  --accept-permission-claim resource.group")
 	cmd.Flags().StringSliceVarP(&b.RejectedPermissionClaims, "reject-permission-claim", "", nil, "List of rejected permission claims for the APIBinding. Format:  --reject-permission-claim resource.group")
+	cmd.Flags().StringVar(&b.Release, "release", b.Release, "Release of the APIExport to pin the APIBinding to.")
+	cmd.Flags().StringVar(&b.Channel, "channel", b.Channel, "Channel of the APIExport the APIBinding follows.")
+	cmd.Flags().StringVar(&b.UpgradePolicy, "upgrade-policy", b.UpgradePolicy, "When the APIBinding moves along its channel: Automatic or Compatible.")
+	cmd.Flags().StringSliceVar(&b.Resources, "resource", nil, "List of resources of the APIExport to bind. All resources are bound if not set. Format:  --resource resource.group")
 }
 
 // Complete ensures all fields are initialized.
@@ -127,6 +141,31 @@ func (b *BindOptions) Validate() error {
 			return fmt.Errorf("invalid rejected permission claims: %v", errs)
 		}
 	}
+	if b.Resources != nil {
+		var errs []error
+		for _, resource := range b.Resources {
+			if err := b.parseResource(resource); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if len(errs) > 0 {
+			return fmt.Errorf("invalid resources: %v", errs)
+		}
+	}
+	if b.Release != "" && b.Channel != "" {
+		return errors.New("--release and --channel are mutually exclusive")
+	}
+	if b.UpgradePolicy != "" {
+		if b.Channel == "" {
+			return errors.New("--upgrade-policy requires --channel")
+		}
+		switch apisv1alpha2.ReleaseUpgradePolicy(b.UpgradePolicy) {
+		case apisv1alpha2.ReleaseUpgradePolicyAutomatic, apisv1alpha2.ReleaseUpgradePolicyCompatible:
+		default:
+			return fmt.Errorf("invalid upgrade policy %q, must be %s or %s", b.UpgradePolicy, apisv1alpha2.ReleaseUpgradePolicyAutomatic, apisv1alpha2.ReleaseUpgradePolicyCompatible)
+		}
+	}
+
 	// once parsed we can validate if the dont conflict with each other
 	for _, acceptedClaim := range b.acceptedPermissionClaims {
 		for _, rejectedClaim := range b.rejectedPermissionClaims {
@@ -217,14 +256,24 @@ func (b *BindOptions) newAPIBinding(preferredAPIBindingVersion string) (apishelp
 			Spec: apisv1alpha2.APIBindingSpec{
 				Reference: apisv1alpha2.BindingReference{
 					Export: &apisv1alpha2.ExportBindingReference{
-						Path: path.String(),
-						Name: apiExportName,
+						Path:          path.String(),
+						Name:          apiExportName,
+						Release:       b.Release,
+						Channel:       b.Channel,
+						UpgradePolicy: apisv1alpha2.ReleaseUpgradePolicy(b.UpgradePolicy),
 					},
 				},
+				Resources: b.resources,
 			},
 		})
 
 	case "v1alpha1":
+		if b.Release != "" || b.Channel != "" {
+			return nil, errors.New("releases and channels require APIBinding v1alpha2")
+		}
+		if len(b.resources) > 0 {
+			return nil, errors.New("selecting resources requires APIBinding v1alpha2")
+		}
 		binding = apishelpers.NewAPIBinding(&apisv1alpha1.APIBinding{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: apiBindingName,
@@ -254,6 +303,21 @@ func (b *BindOptions) newAPIBinding(preferredAPIBindingVersion string) (apishelp
 	return binding, nil
 }
 
+func (b *BindOptions) parseResource(resource string) error {
+	parts := strings.SplitN(resource, ".", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return fmt.Errorf("invalid resource %q", resource)
+	}
+
+	group := parts[1]
+	if group == "core" {
+		group = ""
+	}
+
+	b.resources = append(b.resources, apisv1alpha2.GroupResource{Group: group, Resource: parts[0]})
+	return nil
+}
+
 func (b *BindOptions) parsePermissionClaim(claim string, accepted bool) error {
 	claimParts := strings.SplitN(claim, ".", 2)
 	if len(claimParts) != 2 {
@@ -97,6 +97,57 @@ func TestBindOptionsValidate(t *testing.T) {
 			},
 			wantValid: false,
 		},
+		{
+			description: "Channel with upgrade policy",
+			bindOptions: BindOptions{
+				APIExportRef:  "test-root:test-workspace:test-apiexport",
+				Channel:       "stable",
+				UpgradePolicy: "Compatible",
+			},
+			wantValid: true,
+		},
+		{
+			description: "Release and channel",
+			bindOptions: BindOptions{
+				APIExportRef: "test-root:test-workspace:test-apiexport",
+				Release:      "v1",
+				Channel:      "stable",
+			},
+			wantValid: false,
+		},
+		{
+			description: "Upgrade policy without channel",
+			bindOptions: BindOptions{
+				APIExportRef:  "test-root:test-workspace:test-apiexport",
+				UpgradePolicy: "Automatic",
+			},
+			wantValid: false,
+		},
+		{
+			description: "Invalid upgrade policy",
+			bindOptions: BindOptions{
+				APIExportRef:  "test-root:test-workspace:test-apiexport",
+				Channel:       "stable",
+				UpgradePolicy: "Sometimes",
+			},
+			wantValid: false,
+		},
+		{
+			description: "Valid resources",
+			bindOptions: BindOptions{
+				APIExportRef: "test-root:test-workspace:test-apiexport",
+				Resources:    []string{"widgets.example.io", "configmaps.core"},
+			},
+			wantValid: true,
+		},
+		{
+			description: "Invalid resource format",
+			bindOptions: BindOptions{
+				APIExportRef: "test-root:test-workspace:test-apiexport",
+				Resources:    []string{"widgets"},
+			},
+			wantValid: false,
+		},
 	}
 
 	for _, c := range testCases {
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/kcp-dev/cli/pkg/shard/plugin"
+)
+
+var (
+	shardExample = `
+# Stop scheduling new workspaces onto the shard "beta".
+%[1]s shard cordon beta
+
+# Allow scheduling new workspaces onto the shard "beta" again, and stop draining it.
+%[1]s shard uncordon beta
+
+# Move all workspaces away from the shard "beta", and wait until it is empty.
+%[1]s shard drain beta --wait
+`
+)
+
+// New returns a cobra.Command for shard related actions.
+func New(streams genericclioptions.IOStreams) *cobra.Command {
+	cliName := "kubectl"
+	if pflag.CommandLine.Name() == "kubectl-kcp" {
+		cliName = "kubectl kcp"
+	}
+
+	shardCmd := &cobra.Command{
+		Use:              "shard",
+		Short:            "Operations to take shards out of rotation",
+		SilenceUsage:     true,
+		Example:          fmt.Sprintf(shardExample, cliName),
+		TraverseChildren: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cordonOpts := plugin.NewCordonOptions(streams, true)
+	cordonCmd := &cobra.Command{
+		Use:          "cordon <shard_name>",
+		Short:        "Mark a shard as unschedulable for new workspaces",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cordonOpts.Complete(args); err != nil {
+				return err
+			}
+			if err := cordonOpts.Validate(); err != nil {
+				return err
+			}
+			return cordonOpts.Run(cmd.Context())
+		},
+	}
+	cordonOpts.BindFlags(cordonCmd)
+	shardCmd.AddCommand(cordonCmd)
+
+	uncordonOpts := plugin.NewCordonOptions(streams, false)
+	uncordonCmd := &cobra.Command{
+		Use:          "uncordon <shard_name>",
+		Short:        "Mark a shard as schedulable again, and stop draining it",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := uncordonOpts.Complete(args); err != nil {
+				return err
+			}
+			if err := uncordonOpts.Validate(); err != nil {
+				return err
+			}
+			return uncordonOpts.Run(cmd.Context())
+		},
+	}
+	uncordonOpts.BindFlags(uncordonCmd)
+	shardCmd.AddCommand(uncordonCmd)
+
+	drainOpts := plugin.NewDrainOptions(streams)
+	drainCmd := &cobra.Command{
+		Use:          "drain <shard_name>",
+		Short:        "Cordon a shard and move all workspaces away from it",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := drainOpts.Complete(args); err != nil {
+				return err
+			}
+			if err := drainOpts.Validate(); err != nil {
+				return err
+			}
+			return drainOpts.Run(cmd.Context())
+		},
+	}
+	drainOpts.BindFlags(drainCmd)
+	shardCmd.AddCommand(drainCmd)
+
+	return shardCmd
+}
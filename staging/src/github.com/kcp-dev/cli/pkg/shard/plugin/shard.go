@@ -0,0 +1,238 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/kcp-dev/cli/pkg/base"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+)
+
+// CordonOptions contains the options for cordoning and uncordoning a shard.
+type CordonOptions struct {
+	*base.Options
+
+	// ShardName is the name of the shard in the root workspace.
+	ShardName string
+	// Unschedulable is the value spec.unschedulable of the shard is set to.
+	Unschedulable bool
+
+	kcpClusterClient kcpclientset.ClusterInterface
+}
+
+// NewCordonOptions returns new CordonOptions.
+func NewCordonOptions(streams genericclioptions.IOStreams, unschedulable bool) *CordonOptions {
+	return &CordonOptions{
+		Options:       base.NewOptions(streams),
+		Unschedulable: unschedulable,
+	}
+}
+
+// BindFlags binds fields to cmd's flagset.
+func (o *CordonOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+}
+
+// Complete ensures all fields are initialized.
+func (o *CordonOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		o.ShardName = args[0]
+	}
+
+	kcpClusterClient, err := newKCPClusterClient(o.ClientConfig)
+	if err != nil {
+		return err
+	}
+	o.kcpClusterClient = kcpClusterClient
+
+	return nil
+}
+
+// Validate validates the CordonOptions are complete and usable.
+func (o *CordonOptions) Validate() error {
+	if o.ShardName == "" {
+		return errors.New("shard name is required as an argument")
+	}
+	return o.Options.Validate()
+}
+
+// Run cordons or uncordons the shard. Uncordoning also stops draining the shard.
+func (o *CordonOptions) Run(ctx context.Context) error {
+	spec := map[string]interface{}{"unschedulable": o.Unschedulable}
+	if !o.Unschedulable {
+		spec["drain"] = false
+	}
+	if _, err := patchShardSpec(ctx, o.kcpClusterClient, o.ShardName, spec); err != nil {
+		return err
+	}
+
+	verb := "cordoned"
+	if !o.Unschedulable {
+		verb = "uncordoned"
+	}
+	_, err := fmt.Fprintf(o.Out, "shard %s %s\n", o.ShardName, verb)
+	return err
+}
+
+// DrainOptions contains the options for draining a shard.
+type DrainOptions struct {
+	*base.Options
+
+	// ShardName is the name of the shard in the root workspace.
+	ShardName string
+	// Wait waits until all logical clusters have been moved away from the shard.
+	Wait bool
+	// WaitTimeout is how long to wait for the shard to be drained.
+	WaitTimeout time.Duration
+
+	kcpClusterClient kcpclientset.ClusterInterface
+	pollInterval     time.Duration
+}
+
+// NewDrainOptions returns new DrainOptions.
+func NewDrainOptions(streams genericclioptions.IOStreams) *DrainOptions {
+	return &DrainOptions{
+		Options:      base.NewOptions(streams),
+		WaitTimeout:  time.Hour,
+		pollInterval: 5 * time.Second,
+	}
+}
+
+// BindFlags binds fields to cmd's flagset.
+func (o *DrainOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+
+	cmd.Flags().BoolVar(&o.Wait, "wait", o.Wait, "Wait until all logical clusters have been moved away from the shard.")
+	cmd.Flags().DurationVar(&o.WaitTimeout, "timeout", o.WaitTimeout, "Duration to wait for the shard to be drained.")
+}
+
+// Complete ensures all fields are initialized.
+func (o *DrainOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		o.ShardName = args[0]
+	}
+
+	kcpClusterClient, err := newKCPClusterClient(o.ClientConfig)
+	if err != nil {
+		return err
+	}
+	o.kcpClusterClient = kcpClusterClient
+
+	return nil
+}
+
+// Validate validates the DrainOptions are complete and usable.
+func (o *DrainOptions) Validate() error {
+	if o.ShardName == "" {
+		return errors.New("shard name is required as an argument")
+	}
+	if o.WaitTimeout <= 0 {
+		return errors.New("--timeout must be positive")
+	}
+	return o.Options.Validate()
+}
+
+// Run starts draining the shard, and optionally waits until it is drained.
+func (o *DrainOptions) Run(ctx context.Context) error {
+	shard, err := patchShardSpec(ctx, o.kcpClusterClient, o.ShardName, map[string]interface{}{"drain": true})
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(o.Out, "shard %s cordoned and draining\n", o.ShardName); err != nil {
+		return err
+	}
+	if !o.Wait {
+		return nil
+	}
+
+	lastMessage := ""
+	if err := wait.PollUntilContextTimeout(ctx, o.pollInterval, o.WaitTimeout, true, func(ctx context.Context) (bool, error) {
+		shard, err = o.kcpClusterClient.Cluster(core.RootCluster.Path()).CoreV1alpha1().Shards().Get(ctx, o.ShardName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if conditions.IsTrue(shard, corev1alpha1.ShardDrained) {
+			return true, nil
+		}
+		if message := conditions.GetMessage(shard, corev1alpha1.ShardDrained); message != "" && message != lastMessage {
+			lastMessage = message
+			if _, err := fmt.Fprintf(o.Out, "%s\n", message); err != nil {
+				return false, err
+			}
+		}
+		return false, nil
+	}); err != nil {
+		return fmt.Errorf("shard %s was not drained: %w", o.ShardName, err)
+	}
+
+	_, err = fmt.Fprintf(o.Out, "shard %s drained\n", o.ShardName)
+	return err
+}
+
+func patchShardSpec(ctx context.Context, client kcpclientset.ClusterInterface, name string, spec map[string]interface{}) (*corev1alpha1.Shard, error) {
+	patch, err := json.Marshal(map[string]interface{}{"spec": spec})
+	if err != nil {
+		return nil, err
+	}
+	shard, err := client.Cluster(core.RootCluster.Path()).CoreV1alpha1().Shards().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update shard %s: %w", name, err)
+	}
+	return shard, nil
+}
+
+func newKCPClusterClient(clientConfig clientcmd.ClientConfig) (kcpclientset.ClusterInterface, error) {
+	config, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	clusterConfig := rest.CopyConfig(config)
+	u, err := url.Parse(config.Host)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = ""
+	clusterConfig.Host = u.String()
+	clusterConfig.UserAgent = rest.DefaultKubernetesUserAgent()
+	return kcpclientset.NewForConfig(clusterConfig)
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	"github.com/kcp-dev/sdk/apis/core"
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+	"github.com/kcp-dev/sdk/apis/third_party/conditions/util/conditions"
+	kcpfakeclient "github.com/kcp-dev/sdk/client/clientset/versioned/cluster/fake"
+)
+
+func newShard(name string) *corev1alpha1.Shard {
+	return &corev1alpha1.Shard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{logicalcluster.AnnotationKey: core.RootCluster.String()},
+		},
+		Spec: corev1alpha1.ShardSpec{BaseURL: "https://" + name},
+	}
+}
+
+func getShard(t *testing.T, client *kcpfakeclient.ClusterClientset, name string) *corev1alpha1.Shard {
+	t.Helper()
+	shard, err := client.Cluster(core.RootCluster.Path()).CoreV1alpha1().Shards().Get(context.Background(), name, metav1.GetOptions{})
+	require.NoError(t, err)
+	return shard
+}
+
+func TestCordon(t *testing.T) {
+	client := kcpfakeclient.NewSimpleClientset(newShard("beta"))
+	streams, _, out, _ := genericclioptions.NewTestIOStreams()
+
+	opts := NewCordonOptions(streams, true)
+	opts.ShardName = "beta"
+	opts.kcpClusterClient = client
+	require.NoError(t, opts.Run(context.Background()))
+	require.True(t, getShard(t, client, "beta").Spec.Unschedulable)
+	require.Equal(t, "shard beta cordoned\n", out.String())
+
+	t.Log("Uncordoning stops draining, too")
+	_, err := patchShardSpec(context.Background(), client, "beta", map[string]interface{}{"drain": true})
+	require.NoError(t, err)
+	out.Reset()
+	opts = NewCordonOptions(streams, false)
+	opts.ShardName = "beta"
+	opts.kcpClusterClient = client
+	require.NoError(t, opts.Run(context.Background()))
+	shard := getShard(t, client, "beta")
+	require.False(t, shard.Spec.Unschedulable)
+	require.False(t, shard.Spec.Drain)
+	require.Equal(t, "shard beta uncordoned\n", out.String())
+
+	t.Log("Unknown shards are reported")
+	opts.ShardName = "unknown"
+	require.Error(t, opts.Run(context.Background()))
+}
+
+func TestDrain(t *testing.T) {
+	shard := newShard("beta")
+	conditions.MarkTrue(shard, corev1alpha1.ShardDrained)
+	client := kcpfakeclient.NewSimpleClientset(shard)
+	streams, _, out, _ := genericclioptions.NewTestIOStreams()
+
+	opts := NewDrainOptions(streams)
+	opts.ShardName = "beta"
+	opts.Wait = true
+	opts.pollInterval = time.Millisecond
+	opts.kcpClusterClient = client
+	require.NoError(t, opts.Run(context.Background()))
+	require.True(t, getShard(t, client, "beta").Spec.Drain)
+	require.Equal(t, "shard beta cordoned and draining\nshard beta drained\n", out.String())
+}
+
+func TestValidate(t *testing.T) {
+	require.Error(t, NewCordonOptions(genericclioptions.NewTestIOStreamsDiscard(), true).Validate())
+
+	opts := NewDrainOptions(genericclioptions.NewTestIOStreamsDiscard())
+	opts.ShardName = "beta"
+	opts.WaitTimeout = 0
+	require.Error(t, opts.Validate())
+}
@@ -72,6 +72,12 @@ var (
 
 # create a context with the current workspace, named context-name
 %[1]s workspace create-context context-name
+
+# export all objects of the current workspace into an archive
+%[1]s workspace export -f backup.yaml
+
+# create the child workspace my-clone from an archive
+%[1]s workspace import my-clone -f backup.yaml
 `
 )
 
@@ -89,7 +95,7 @@ func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
 
 	cmd := &cobra.Command{
 		Aliases:          []string{"ws", "workspaces"},
-		Use:              "workspace [create|create-context|use|current|<workspace>|..|.|-|~|<root:absolute:workspace>] [-i|--interactive]",
+		Use:              "workspace [create|create-context|use|current|export|import|<workspace>|..|.|-|~|<root:absolute:workspace>] [-i|--interactive]",
 		Short:            "Manages kcp workspaces",
 		Example:          fmt.Sprintf(workspaceExample, cliName),
 		SilenceUsage:     true,
@@ -222,11 +228,55 @@ func New(streams genericclioptions.IOStreams) (*cobra.Command, error) {
 	}
 	treeCmdOpts.BindFlags(treeCmd)
 
+	exportOpts := plugin.NewExportWorkspaceOptions(streams)
+	exportCmd := &cobra.Command{
+		Use:          "export [-f <file>]",
+		Short:        "Export all objects of the current workspace into an archive",
+		Example:      "kcp workspace export -f backup.yaml",
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return c.Help()
+			}
+			if err := exportOpts.Complete(); err != nil {
+				return err
+			}
+			if err := exportOpts.Validate(); err != nil {
+				return err
+			}
+			return exportOpts.Run(c.Context())
+		},
+	}
+	exportOpts.BindFlags(exportCmd)
+
+	importOpts := plugin.NewImportWorkspaceOptions(streams)
+	importCmd := &cobra.Command{
+		Use:          "import <workspace name> -f <file> [--type=<type>] [--keep-identities]",
+		Short:        "Create a child workspace from an archive written by export",
+		Example:      "kcp workspace import my-clone -f backup.yaml",
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return c.Help()
+			}
+			if err := importOpts.Complete(args); err != nil {
+				return err
+			}
+			if err := importOpts.Validate(); err != nil {
+				return err
+			}
+			return importOpts.Run(c.Context())
+		},
+	}
+	importOpts.BindFlags(importCmd)
+
 	cmd.AddCommand(useCmd)
 	cmd.AddCommand(treeCmd)
 	cmd.AddCommand(currentCmd)
 	cmd.AddCommand(createCmd)
 	cmd.AddCommand(createContextCmd)
+	cmd.AddCommand(exportCmd)
+	cmd.AddCommand(importCmd)
 	return cmd, nil
 }
 
@@ -0,0 +1,253 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+)
+
+const (
+	// archiveVersion is the version of the archive format written by export.
+	archiveVersion = "v1alpha1"
+	// archiveKind is the kind of the archive document.
+	archiveKind = "WorkspaceArchive"
+)
+
+// WorkspaceArchive is a portable representation of all objects in a logical cluster,
+// written by "workspace export" and read by "workspace import".
+type WorkspaceArchive struct {
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+
+	// Workspace is the path of the exported workspace.
+	Workspace string `json:"workspace"`
+
+	// Identities are the APIExports behind the identity hashes used in the archive. They
+	// are resolved again on import, as identities differ between workspaces and installations.
+	Identities []ArchivedIdentity `json:"identities,omitempty"`
+
+	// Resources are the exported objects by resource, in the order they are imported.
+	Resources []ArchivedResource `json:"resources,omitempty"`
+}
+
+// ArchivedIdentity references the APIExport an identity hash belongs to.
+type ArchivedIdentity struct {
+	// Hash is the identity hash in the exported workspace.
+	Hash string `json:"hash"`
+	// Path is the workspace of the APIExport. It is empty for APIExports in the exported workspace.
+	Path string `json:"path,omitempty"`
+	// Export is the name of the APIExport.
+	Export string `json:"export"`
+}
+
+// ArchivedResource holds the objects of one resource.
+type ArchivedResource struct {
+	Group      string `json:"group,omitempty"`
+	Version    string `json:"version"`
+	Resource   string `json:"resource"`
+	Namespaced bool   `json:"namespaced,omitempty"`
+
+	Objects []unstructured.Unstructured `json:"objects"`
+}
+
+// GroupVersionResource returns the GroupVersionResource of the archived objects.
+func (r *ArchivedResource) GroupVersionResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: r.Group, Version: r.Version, Resource: r.Resource}
+}
+
+var (
+	// archiveSkippedResources are not exported. They belong to the logical cluster itself,
+	// are child logical clusters, or are transient.
+	archiveSkippedResources = sets.New[schema.GroupResource](
+		schema.GroupResource{Group: "core.kcp.io", Resource: "logicalclusters"},
+		schema.GroupResource{Group: "tenancy.kcp.io", Resource: "workspaces"},
+		schema.GroupResource{Group: "", Resource: "events"},
+		schema.GroupResource{Group: "events.k8s.io", Resource: "events"},
+		schema.GroupResource{Group: "coordination.k8s.io", Resource: "leases"},
+		schema.GroupResource{Group: "authentication.k8s.io", Resource: "tokenreviews"},
+	)
+
+	// archiveImportOrder is the order in which resources are imported. Resources are only
+	// served after their definitions, i.e. CRDs and APIBindings, are imported.
+	archiveImportOrder = []schema.GroupResource{
+		{Group: "", Resource: "namespaces"},
+		{Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"},
+		{Group: "apis.kcp.io", Resource: "apiresourceschemas"},
+		{Group: "", Resource: "secrets"},
+		{Group: "apis.kcp.io", Resource: "apiexports"},
+		{Group: "apis.kcp.io", Resource: "apibindings"},
+		{Group: "", Resource: "serviceaccounts"},
+		{Group: "rbac.authorization.k8s.io", Resource: "clusterroles"},
+		{Group: "rbac.authorization.k8s.io", Resource: "roles"},
+		{Group: "rbac.authorization.k8s.io", Resource: "clusterrolebindings"},
+		{Group: "rbac.authorization.k8s.io", Resource: "rolebindings"},
+	}
+
+	// archiveSkippedAnnotations are specific to the logical cluster the object lives in.
+	archiveSkippedAnnotations = []string{
+		logicalcluster.AnnotationKey,
+		"kcp.io/path",
+	}
+)
+
+// archiveObject removes the metadata of the object that is specific to the logical
+// cluster it lives in. It returns false if the object is not exported at all.
+func archiveObject(gr schema.GroupResource, obj *unstructured.Unstructured) bool {
+	switch {
+	case gr == schema.GroupResource{Resource: "namespaces"} && sets.New("default", "kube-system", "kube-public").Has(obj.GetName()):
+		return false
+	case gr == schema.GroupResource{Resource: "configmaps"} && obj.GetName() == "kube-root-ca.crt":
+		return false
+	case gr == schema.GroupResource{Resource: "serviceaccounts"} && obj.GetName() == "default":
+		return false
+	case gr == schema.GroupResource{Resource: "secrets"} && obj.Object["type"] == "kubernetes.io/service-account-token":
+		return false
+	}
+
+	for _, field := range []string{"uid", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp", "deletionGracePeriodSeconds", "managedFields", "selfLink", "ownerReferences", "finalizers"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	if annotations := obj.GetAnnotations(); annotations != nil {
+		for _, key := range archiveSkippedAnnotations {
+			delete(annotations, key)
+		}
+		if len(annotations) == 0 {
+			annotations = nil
+		}
+		obj.SetAnnotations(annotations)
+	}
+	unstructured.RemoveNestedField(obj.Object, "status")
+
+	return true
+}
+
+// sortArchivedResources sorts the resources into import order. Resources not in
+// archiveImportOrder go last, in a stable order.
+func sortArchivedResources(resources []ArchivedResource) {
+	rank := func(r ArchivedResource) int {
+		for i, gr := range archiveImportOrder {
+			if gr.Group == r.Group && gr.Resource == r.Resource {
+				return i
+			}
+		}
+		return len(archiveImportOrder)
+	}
+	sort.SliceStable(resources, func(i, j int) bool {
+		ri, rj := rank(resources[i]), rank(resources[j])
+		if ri != rj {
+			return ri < rj
+		}
+		if resources[i].Group != resources[j].Group {
+			return resources[i].Group < resources[j].Group
+		}
+		return resources[i].Resource < resources[j].Resource
+	})
+}
+
+// archivedIdentities returns the APIExports behind the identity hashes known in the exported
+// workspace, i.e. those of its own APIExports, and those of the APIExports bound by its APIBindings.
+func archivedIdentities(exports []*apisv1alpha2.APIExport, bindings []*apisv1alpha2.APIBinding) []ArchivedIdentity {
+	seen := sets.New[string]()
+	var identities []ArchivedIdentity
+	for _, export := range exports {
+		if export.Status.IdentityHash == "" || seen.Has(export.Status.IdentityHash) {
+			continue
+		}
+		seen.Insert(export.Status.IdentityHash)
+		identities = append(identities, ArchivedIdentity{Hash: export.Status.IdentityHash, Export: export.Name})
+	}
+	for _, binding := range bindings {
+		if binding.Spec.Reference.Export == nil {
+			continue
+		}
+		for _, bound := range binding.Status.BoundResources {
+			hash := bound.Schema.IdentityHash
+			if hash == "" || seen.Has(hash) {
+				continue
+			}
+			seen.Insert(hash)
+			identities = append(identities, ArchivedIdentity{Hash: hash, Path: binding.Spec.Reference.Export.Path, Export: binding.Spec.Reference.Export.Name})
+		}
+	}
+	sort.Slice(identities, func(i, j int) bool { return identities[i].Hash < identities[j].Hash })
+	return identities
+}
+
+// rewriteIdentityHashes replaces the identity hashes in all "identityHash" fields of the object.
+func rewriteIdentityHashes(obj interface{}, hashes map[string]string) {
+	switch v := obj.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if s, ok := value.(string); ok && key == "identityHash" {
+				if replacement, found := hashes[s]; found {
+					v[key] = replacement
+				}
+				continue
+			}
+			rewriteIdentityHashes(value, hashes)
+		}
+	case []interface{}:
+		for _, value := range v {
+			rewriteIdentityHashes(value, hashes)
+		}
+	}
+}
+
+// rewriteExportPath points references to APIExports in the exported workspace to the
+// imported workspace.
+func rewriteExportPath(binding *unstructured.Unstructured, from, to logicalcluster.Path) error {
+	path, found, err := unstructured.NestedString(binding.Object, "spec", "reference", "export", "path")
+	if err != nil || !found || path != from.String() {
+		return err
+	}
+	return unstructured.SetNestedField(binding.Object, to.String(), "spec", "reference", "export", "path")
+}
+
+func writeArchive(w io.Writer, archive *WorkspaceArchive) error {
+	bs, err := yaml.Marshal(archive)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bs)
+	return err
+}
+
+func readArchive(r io.Reader) (*WorkspaceArchive, error) {
+	bs, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var archive WorkspaceArchive
+	if err := yaml.Unmarshal(bs, &archive); err != nil {
+		return nil, fmt.Errorf("failed to decode archive: %w", err)
+	}
+	if archive.Kind != archiveKind || archive.Version != archiveVersion {
+		return nil, fmt.Errorf("unsupported archive %s/%s, expected %s/%s", archive.Kind, archive.Version, archiveKind, archiveVersion)
+	}
+	return &archive, nil
+}
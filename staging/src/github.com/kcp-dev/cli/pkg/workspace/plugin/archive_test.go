@@ -0,0 +1,322 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/discovery"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/dynamic"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/rest"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	kcpfakeclient "github.com/kcp-dev/sdk/client/clientset/versioned/cluster/fake"
+)
+
+func TestArchiveObject(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":              "cm",
+			"namespace":         "ns",
+			"uid":               "1234",
+			"resourceVersion":   "42",
+			"creationTimestamp": "2026-01-01T00:00:00Z",
+			"managedFields":     []interface{}{map[string]interface{}{"manager": "kubectl"}},
+			"finalizers":        []interface{}{"example.com/finalizer"},
+			"labels":            map[string]interface{}{"app": "demo"},
+			"annotations": map[string]interface{}{
+				"kcp.io/cluster": "2x8bkz4ib1yjwnwg",
+				"kcp.io/path":    "root:org:team",
+				"keep":           "me",
+			},
+		},
+		"data":   map[string]interface{}{"key": "value"},
+		"status": map[string]interface{}{"phase": "Whatever"},
+	}}
+
+	require.True(t, archiveObject(schema.GroupResource{Resource: "configmaps"}, obj))
+	require.Equal(t, map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":        "cm",
+			"namespace":   "ns",
+			"labels":      map[string]interface{}{"app": "demo"},
+			"annotations": map[string]interface{}{"keep": "me"},
+		},
+		"data": map[string]interface{}{"key": "value"},
+	}, obj.Object)
+
+	for _, tc := range []struct {
+		gr  schema.GroupResource
+		obj map[string]interface{}
+	}{
+		{gr: schema.GroupResource{Resource: "namespaces"}, obj: map[string]interface{}{"metadata": map[string]interface{}{"name": "default"}}},
+		{gr: schema.GroupResource{Resource: "configmaps"}, obj: map[string]interface{}{"metadata": map[string]interface{}{"name": "kube-root-ca.crt", "namespace": "ns"}}},
+		{gr: schema.GroupResource{Resource: "serviceaccounts"}, obj: map[string]interface{}{"metadata": map[string]interface{}{"name": "default", "namespace": "ns"}}},
+		{gr: schema.GroupResource{Resource: "secrets"}, obj: map[string]interface{}{"metadata": map[string]interface{}{"name": "token", "namespace": "ns"}, "type": "kubernetes.io/service-account-token"}},
+	} {
+		require.False(t, archiveObject(tc.gr, &unstructured.Unstructured{Object: tc.obj}), "expected %s %v to be skipped", tc.gr, tc.obj)
+	}
+}
+
+func TestSortArchivedResources(t *testing.T) {
+	resources := []ArchivedResource{
+		{Group: "example.io", Resource: "widgets"},
+		{Group: "apis.kcp.io", Resource: "apibindings"},
+		{Resource: "configmaps"},
+		{Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"},
+		{Group: "apis.kcp.io", Resource: "apiexports"},
+		{Resource: "namespaces"},
+	}
+	sortArchivedResources(resources)
+
+	var order []string
+	for _, r := range resources {
+		order = append(order, schema.GroupResource{Group: r.Group, Resource: r.Resource}.String())
+	}
+	require.Equal(t, []string{
+		"namespaces",
+		"customresourcedefinitions.apiextensions.k8s.io",
+		"apiexports.apis.kcp.io",
+		"apibindings.apis.kcp.io",
+		"configmaps",
+		"widgets.example.io",
+	}, order)
+}
+
+func TestArchivedIdentities(t *testing.T) {
+	exports := []*apisv1alpha2.APIExport{
+		{ObjectMeta: metav1.ObjectMeta{Name: "local"}, Status: apisv1alpha2.APIExportStatus{IdentityHash: "aaa"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "pending"}},
+	}
+	bindings := []*apisv1alpha2.APIBinding{
+		{
+			Spec: apisv1alpha2.APIBindingSpec{Reference: apisv1alpha2.BindingReference{Export: &apisv1alpha2.ExportBindingReference{Path: "root:provider", Name: "widgets"}}},
+			Status: apisv1alpha2.APIBindingStatus{BoundResources: []apisv1alpha2.BoundAPIResource{
+				{Resource: "widgets", Schema: apisv1alpha2.BoundAPIResourceSchema{IdentityHash: "ccc"}},
+				{Resource: "gadgets", Schema: apisv1alpha2.BoundAPIResourceSchema{IdentityHash: "ccc"}},
+			}},
+		},
+		{
+			Spec: apisv1alpha2.APIBindingSpec{Reference: apisv1alpha2.BindingReference{Export: &apisv1alpha2.ExportBindingReference{Name: "local"}}},
+			Status: apisv1alpha2.APIBindingStatus{BoundResources: []apisv1alpha2.BoundAPIResource{
+				{Resource: "things", Schema: apisv1alpha2.BoundAPIResourceSchema{IdentityHash: "aaa"}},
+			}},
+		},
+	}
+
+	require.Equal(t, []ArchivedIdentity{
+		{Hash: "aaa", Export: "local"},
+		{Hash: "ccc", Path: "root:provider", Export: "widgets"},
+	}, archivedIdentities(exports, bindings))
+}
+
+func TestRewriteIdentityHashes(t *testing.T) {
+	obj := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"permissionClaims": []interface{}{
+				map[string]interface{}{"resource": "widgets", "identityHash": "old"},
+				map[string]interface{}{"resource": "configmaps", "identityHash": "unknown"},
+			},
+		},
+	}
+	rewriteIdentityHashes(obj, map[string]string{"old": "new"})
+	require.Equal(t, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"permissionClaims": []interface{}{
+				map[string]interface{}{"resource": "widgets", "identityHash": "new"},
+				map[string]interface{}{"resource": "configmaps", "identityHash": "unknown"},
+			},
+		},
+	}, obj)
+}
+
+func TestExportImport(t *testing.T) {
+	var (
+		namespaces  = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+		configmaps  = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+		apibindings = apisv1alpha2.SchemeGroupVersion.WithResource("apibindings")
+		widgets     = schema.GroupVersionResource{Group: "example.io", Version: "v1", Resource: "widgets"}
+	)
+	listKinds := map[schema.GroupVersionResource]string{
+		namespaces:  "NamespaceList",
+		configmaps:  "ConfigMapList",
+		apibindings: "APIBindingList",
+		widgets:     "WidgetList",
+	}
+	object := func(apiVersion, kind, namespace, name string, fields map[string]interface{}) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: fields}
+		if obj.Object == nil {
+			obj.Object = map[string]interface{}{}
+		}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		obj.SetUID(types.UID("uid-" + name))
+		obj.SetAnnotations(map[string]string{"kcp.io/cluster": "source"})
+		return obj
+	}
+
+	source := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds,
+		object("v1", "Namespace", "", "default", nil),
+		object("v1", "Namespace", "", "app", nil),
+		object("v1", "ConfigMap", "app", "settings", map[string]interface{}{"data": map[string]interface{}{"color": "blue"}}),
+		object("v1", "ConfigMap", "app", "kube-root-ca.crt", nil),
+		object("apis.kcp.io/v1alpha2", "APIBinding", "", "widgets", map[string]interface{}{
+			"spec": map[string]interface{}{
+				"reference": map[string]interface{}{"export": map[string]interface{}{"path": "root:provider", "name": "widgets"}},
+				"permissionClaims": []interface{}{
+					map[string]interface{}{"resource": "gadgets", "group": "example.io", "identityHash": "old-hash", "verbs": []interface{}{"get"}, "state": "Accepted"},
+				},
+			},
+			"status": map[string]interface{}{
+				"phase":          "Bound",
+				"boundResources": []interface{}{map[string]interface{}{"group": "example.io", "resource": "widgets", "schema": map[string]interface{}{"identityHash": "old-hash"}}},
+			},
+		}),
+		object("example.io/v1", "Widget", "app", "w1", map[string]interface{}{"spec": map[string]interface{}{"size": int64(3)}}),
+	)
+	sourceDiscovery := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: []*metav1.APIResourceList{
+		{GroupVersion: "v1", APIResources: []metav1.APIResource{
+			{Name: "namespaces", Verbs: []string{"list", "create"}},
+			{Name: "configmaps", Namespaced: true, Verbs: []string{"list", "create"}},
+			{Name: "events", Namespaced: true, Verbs: []string{"list", "create"}},
+			{Name: "bindings", Namespaced: true, Verbs: []string{"create"}},
+		}},
+		{GroupVersion: "apis.kcp.io/v1alpha2", APIResources: []metav1.APIResource{
+			{Name: "apibindings", Verbs: []string{"list", "create"}},
+			{Name: "apibindings/status", Verbs: []string{"get", "update"}},
+		}},
+		{GroupVersion: "example.io/v1", APIResources: []metav1.APIResource{
+			{Name: "widgets", Namespaced: true, Verbs: []string{"list", "create"}},
+		}},
+	}}}
+
+	config := clientcmdapi.Config{
+		CurrentContext: "test",
+		Contexts:       map[string]*clientcmdapi.Context{"test": {Cluster: "test", AuthInfo: "test"}},
+		Clusters:       map[string]*clientcmdapi.Cluster{"test": {Server: "https://test/clusters/root:org:source"}},
+		AuthInfos:      map[string]*clientcmdapi.AuthInfo{"test": {Token: "test"}},
+	}
+
+	// export
+	streams, _, stdout, _ := genericclioptions.NewTestIOStreams()
+	exportOpts := NewExportWorkspaceOptions(streams)
+	exportOpts.ClientConfig = clientcmd.NewDefaultClientConfig(*config.DeepCopy(), nil)
+	exportOpts.newDiscoveryClient = func(*rest.Config) (discovery.DiscoveryInterface, error) { return sourceDiscovery, nil }
+	exportOpts.newDynamicClient = func(*rest.Config) (dynamic.Interface, error) { return source, nil }
+	require.NoError(t, exportOpts.Validate())
+	require.NoError(t, exportOpts.Complete())
+	require.NoError(t, exportOpts.Run(context.Background()))
+
+	exportedArchive := stdout.Bytes()
+	archive, err := readArchive(bytes.NewReader(exportedArchive))
+	require.NoError(t, err)
+	require.Equal(t, "root:org:source", archive.Workspace)
+	require.Equal(t, []ArchivedIdentity{{Hash: "old-hash", Path: "root:provider", Export: "widgets"}}, archive.Identities)
+	var exported []string
+	for _, r := range archive.Resources {
+		for _, obj := range r.Objects {
+			require.Empty(t, obj.GetUID())
+			require.Empty(t, obj.GetAnnotations())
+			exported = append(exported, r.Resource+"/"+objectName(&obj))
+		}
+	}
+	require.Equal(t, []string{"namespaces/app", "apibindings/widgets", "configmaps/app/settings", "widgets/app/w1"}, exported)
+
+	// export to a file, which is only readable by the user
+	filename := filepath.Join(t.TempDir(), "archive.yaml")
+	exportOpts.Filename = filename
+	require.NoError(t, exportOpts.Run(context.Background()))
+	info, err := os.Stat(filename)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	fromFile, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	require.Equal(t, exportedArchive, fromFile)
+
+	// import
+	target := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds)
+	target.PrependReactor("create", "apibindings", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		obj := action.(clienttesting.CreateAction).GetObject().(*unstructured.Unstructured)
+		require.NoError(t, unstructured.SetNestedField(obj.Object, "Bound", "status", "phase"))
+		return false, nil, nil
+	})
+	provider := &apisv1alpha2.APIExport{
+		ObjectMeta: metav1.ObjectMeta{Name: "widgets", Annotations: map[string]string{logicalcluster.AnnotationKey: "root:provider"}},
+		Status:     apisv1alpha2.APIExportStatus{IdentityHash: "new-hash"},
+	}
+
+	streams, stdin, stdout, _ := genericclioptions.NewTestIOStreams()
+	_, err = stdin.Write(exportedArchive)
+	require.NoError(t, err)
+	var createdWorkspace bool
+	var targetHost string
+	importOpts := NewImportWorkspaceOptions(streams)
+	importOpts.ClientConfig = clientcmd.NewDefaultClientConfig(*config.DeepCopy(), nil)
+	importOpts.Filename = "-"
+	importOpts.ReadyWaitTimeout = 5 * time.Second
+	importOpts.kcpClusterClient = kcpfakeclient.NewSimpleClientset(provider)
+	importOpts.createWorkspace = func(ctx context.Context) error {
+		createdWorkspace = true
+		return nil
+	}
+	importOpts.newDynamicClient = func(config *rest.Config) (dynamic.Interface, error) {
+		targetHost = config.Host
+		return target, nil
+	}
+	require.NoError(t, importOpts.Complete([]string{"clone"}))
+	require.NoError(t, importOpts.Validate())
+	require.NoError(t, importOpts.Run(context.Background()))
+
+	require.True(t, createdWorkspace)
+	require.Equal(t, "https://test/clusters/root:org:source:clone", targetHost)
+	require.Contains(t, stdout.String(), `Imported 4 objects into workspace "root:org:source:clone"`)
+
+	binding, err := target.Resource(apibindings).Get(context.Background(), "widgets", metav1.GetOptions{})
+	require.NoError(t, err)
+	claims, _, err := unstructured.NestedSlice(binding.Object, "spec", "permissionClaims")
+	require.NoError(t, err)
+	require.Equal(t, "new-hash", claims[0].(map[string]interface{})["identityHash"])
+
+	widget, err := target.Resource(widgets).Namespace("app").Get(context.Background(), "w1", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), widget.Object["spec"].(map[string]interface{})["size"])
+}
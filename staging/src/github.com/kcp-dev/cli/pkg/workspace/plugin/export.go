@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+
+	"github.com/kcp-dev/cli/pkg/base"
+	pluginhelpers "github.com/kcp-dev/cli/pkg/helpers"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+)
+
+// ExportWorkspaceOptions contains options for exporting the current workspace into an archive.
+type ExportWorkspaceOptions struct {
+	*base.Options
+
+	// Filename is the file the archive is written to, or - for stdout.
+	Filename string
+
+	// for testing
+	newDiscoveryClient func(config *rest.Config) (discovery.DiscoveryInterface, error)
+	newDynamicClient   func(config *rest.Config) (dynamic.Interface, error)
+}
+
+// NewExportWorkspaceOptions returns a new ExportWorkspaceOptions.
+func NewExportWorkspaceOptions(streams genericclioptions.IOStreams) *ExportWorkspaceOptions {
+	return &ExportWorkspaceOptions{
+		Options:  base.NewOptions(streams),
+		Filename: "-",
+		newDiscoveryClient: func(config *rest.Config) (discovery.DiscoveryInterface, error) {
+			return discovery.NewDiscoveryClientForConfig(config)
+		},
+		newDynamicClient: func(config *rest.Config) (dynamic.Interface, error) {
+			return dynamic.NewForConfig(config)
+		},
+	}
+}
+
+// BindFlags binds fields to cmd's flagset.
+func (o *ExportWorkspaceOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	cmd.Flags().StringVarP(&o.Filename, "filename", "f", o.Filename, "File to write the archive to, or - for stdout")
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *ExportWorkspaceOptions) Complete() error {
+	return o.Options.Complete()
+}
+
+// Validate validates the ExportWorkspaceOptions are complete and usable.
+func (o *ExportWorkspaceOptions) Validate() error {
+	if o.Filename == "" {
+		return fmt.Errorf("--filename is required")
+	}
+	return o.Options.Validate()
+}
+
+// Run exports all objects of the current workspace.
+func (o *ExportWorkspaceOptions) Run(ctx context.Context) error {
+	config, err := o.ClientConfig.ClientConfig()
+	if err != nil {
+		return err
+	}
+	_, currentClusterName, err := pluginhelpers.ParseClusterURL(config.Host)
+	if err != nil {
+		return fmt.Errorf("current URL %q does not point to a workspace", config.Host)
+	}
+
+	discoveryClient, err := o.newDiscoveryClient(config)
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := o.newDynamicClient(config)
+	if err != nil {
+		return err
+	}
+
+	resourceLists, err := discovery.ServerPreferredResources(discoveryClient)
+	if err != nil {
+		if !discovery.IsGroupDiscoveryFailedError(err) {
+			return fmt.Errorf("failed to discover APIs: %w", err)
+		}
+		// export what is there, but be loud about it.
+		if _, err := fmt.Fprintf(o.ErrOut, "Warning: %v\n", err); err != nil {
+			return err
+		}
+	}
+
+	archive := &WorkspaceArchive{
+		Version:   archiveVersion,
+		Kind:      archiveKind,
+		Workspace: currentClusterName.String(),
+	}
+	var (
+		exports  []*apisv1alpha2.APIExport
+		bindings []*apisv1alpha2.APIBinding
+		count    int
+	)
+	for _, resourceList := range resourceLists {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, resource := range resourceList.APIResources {
+			verbs := sets.New[string](resource.Verbs...)
+			gr := gv.WithResource(resource.Name).GroupResource()
+			if strings.Contains(resource.Name, "/") || !verbs.HasAll("list", "create") || archiveSkippedResources.Has(gr) {
+				continue
+			}
+
+			list, err := dynamicClient.Resource(gv.WithResource(resource.Name)).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to list %s: %w", gr, err)
+			}
+
+			archived := ArchivedResource{Group: gv.Group, Version: gv.Version, Resource: resource.Name, Namespaced: resource.Namespaced}
+			for i := range list.Items {
+				obj := &list.Items[i]
+				switch gr {
+				case apisv1alpha2.Resource("apiexports"):
+					var export apisv1alpha2.APIExport
+					if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &export); err != nil {
+						return fmt.Errorf("failed to decode APIExport %s: %w", obj.GetName(), err)
+					}
+					exports = append(exports, &export)
+				case apisv1alpha2.Resource("apibindings"):
+					var binding apisv1alpha2.APIBinding
+					if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &binding); err != nil {
+						return fmt.Errorf("failed to decode APIBinding %s: %w", obj.GetName(), err)
+					}
+					bindings = append(bindings, &binding)
+				}
+				if !archiveObject(gr, obj) {
+					continue
+				}
+				archived.Objects = append(archived.Objects, *obj)
+			}
+			if len(archived.Objects) == 0 {
+				continue
+			}
+			count += len(archived.Objects)
+			archive.Resources = append(archive.Resources, archived)
+		}
+	}
+	archive.Identities = archivedIdentities(exports, bindings)
+	sortArchivedResources(archive.Resources)
+
+	out := o.Out
+	if o.Filename != "-" {
+		// the archive contains secrets, keep it private.
+		f, err := os.OpenFile(o.Filename, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+		if err != nil {
+			return fmt.Errorf("error creating %s: %w", o.Filename, err)
+		}
+		defer f.Close()
+		out = f
+	}
+	if err := writeArchive(out, archive); err != nil {
+		return err
+	}
+
+	if o.Filename != "-" {
+		if _, err := fmt.Fprintf(o.Out, "Exported %d objects of %d resources from workspace %q to %s.\n", count, len(archive.Resources), currentClusterName, o.Filename); err != nil {
+			return err
+		}
+	}
+	return nil
+}
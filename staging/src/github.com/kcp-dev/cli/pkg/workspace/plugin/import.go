@@ -0,0 +1,367 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+
+	"github.com/kcp-dev/cli/pkg/base"
+	pluginhelpers "github.com/kcp-dev/cli/pkg/helpers"
+	"github.com/kcp-dev/logicalcluster/v3"
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	kcpclientset "github.com/kcp-dev/sdk/client/clientset/versioned/cluster"
+)
+
+// ImportWorkspaceOptions contains options for importing an archive into a new workspace.
+type ImportWorkspaceOptions struct {
+	*base.Options
+
+	// Filename is the archive to import, or - for stdin.
+	Filename string
+	// Name is the name of the workspace to create.
+	Name string
+	// Type is the type of the workspace to create.
+	Type string
+	// KeepIdentities keeps the identities of the archived APIExports, i.e. restores them instead
+	// of cloning them. Only one APIExport in an installation can have a given identity.
+	KeepIdentities bool
+	// ReadyWaitTimeout is how long to wait for the workspace, and the imported APIs in it, to be ready.
+	ReadyWaitTimeout time.Duration
+
+	kcpClusterClient kcpclientset.ClusterInterface
+
+	// for testing
+	newDynamicClient func(config *rest.Config) (dynamic.Interface, error)
+	createWorkspace  func(ctx context.Context) error
+}
+
+// NewImportWorkspaceOptions returns a new ImportWorkspaceOptions.
+func NewImportWorkspaceOptions(streams genericclioptions.IOStreams) *ImportWorkspaceOptions {
+	return &ImportWorkspaceOptions{
+		Options:          base.NewOptions(streams),
+		ReadyWaitTimeout: time.Minute,
+		newDynamicClient: func(config *rest.Config) (dynamic.Interface, error) {
+			return dynamic.NewForConfig(config)
+		},
+	}
+}
+
+// BindFlags binds fields to cmd's flagset.
+func (o *ImportWorkspaceOptions) BindFlags(cmd *cobra.Command) {
+	o.Options.BindFlags(cmd)
+	cmd.Flags().StringVarP(&o.Filename, "filename", "f", o.Filename, "Archive to import, or - for stdin")
+	cmd.Flags().StringVar(&o.Type, "type", o.Type, "A workspace type. The default type depends on where this child workspace is created.")
+	cmd.Flags().BoolVar(&o.KeepIdentities, "keep-identities", o.KeepIdentities, "Restore the identities of the archived APIExports instead of creating new ones. The original APIExports must not exist anymore.")
+	cmd.Flags().DurationVar(&o.ReadyWaitTimeout, "timeout", o.ReadyWaitTimeout, "How long to wait for the workspace and each imported API to become ready")
+}
+
+// Complete ensures all dynamically populated fields are initialized.
+func (o *ImportWorkspaceOptions) Complete(args []string) error {
+	if err := o.Options.Complete(); err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	if o.kcpClusterClient == nil {
+		kcpClusterClient, err := newKCPClusterClient(o.ClientConfig)
+		if err != nil {
+			return err
+		}
+		o.kcpClusterClient = kcpClusterClient
+	}
+
+	if o.createWorkspace == nil {
+		o.createWorkspace = func(ctx context.Context) error {
+			createOpts := NewCreateWorkspaceOptions(o.IOStreams)
+			createOpts.ClientConfig = o.ClientConfig
+			createOpts.Name = o.Name
+			createOpts.Type = o.Type
+			createOpts.ReadyWaitTimeout = o.ReadyWaitTimeout
+			createOpts.kcpClusterClient = o.kcpClusterClient
+			return createOpts.Run(ctx)
+		}
+	}
+
+	return nil
+}
+
+// Validate validates the ImportWorkspaceOptions are complete and usable.
+func (o *ImportWorkspaceOptions) Validate() error {
+	if o.Filename == "" {
+		return fmt.Errorf("--filename is required")
+	}
+	if o.Name == "" {
+		return fmt.Errorf("workspace name is required")
+	}
+	return o.Options.Validate()
+}
+
+// Run creates a new workspace and imports the archive into it.
+func (o *ImportWorkspaceOptions) Run(ctx context.Context) error {
+	var in io.Reader = o.In
+	if o.Filename != "-" {
+		f, err := os.Open(o.Filename)
+		if err != nil {
+			return fmt.Errorf("error opening %s: %w", o.Filename, err)
+		}
+		defer f.Close()
+		in = f
+	}
+	archive, err := readArchive(in)
+	if err != nil {
+		return err
+	}
+
+	config, err := o.ClientConfig.ClientConfig()
+	if err != nil {
+		return err
+	}
+	u, currentClusterName, err := pluginhelpers.ParseClusterURL(config.Host)
+	if err != nil {
+		return fmt.Errorf("current URL %q does not point to a workspace", config.Host)
+	}
+	targetPath := currentClusterName.Join(o.Name)
+
+	if err := o.createWorkspace(ctx); err != nil {
+		return err
+	}
+
+	targetConfig := rest.CopyConfig(config)
+	u.Path = path.Join(u.Path, targetPath.RequestPath())
+	targetConfig.Host = u.String()
+	dynamicClient, err := o.newDynamicClient(targetConfig)
+	if err != nil {
+		return err
+	}
+
+	importer := &archiveImporter{
+		out:             o.Out,
+		client:          dynamicClient,
+		timeout:         o.ReadyWaitTimeout,
+		sourcePath:      logicalcluster.NewPath(archive.Workspace),
+		targetPath:      targetPath,
+		hashes:          map[string]string{},
+		localIdentities: map[string]string{},
+		skipIdentities:  !o.KeepIdentities,
+	}
+
+	// identities of APIExports in other workspaces are resolved upfront, those of the
+	// imported APIExports once they are created.
+	for _, identity := range archive.Identities {
+		if identity.Path == "" || identity.Path == archive.Workspace {
+			importer.localIdentities[identity.Export] = identity.Hash
+			continue
+		}
+		export, err := o.kcpClusterClient.Cluster(logicalcluster.NewPath(identity.Path)).ApisV1alpha2().APIExports().Get(ctx, identity.Export, metav1.GetOptions{})
+		if err != nil {
+			if _, err := fmt.Fprintf(o.ErrOut, "Warning: cannot resolve identity of APIExport %s|%s: %v\n", identity.Path, identity.Export, err); err != nil {
+				return err
+			}
+			continue
+		}
+		if export.Status.IdentityHash != "" {
+			importer.hashes[identity.Hash] = export.Status.IdentityHash
+		}
+	}
+
+	if err := importer.importResources(ctx, archive.Resources); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(o.Out, "Imported %d objects into workspace %q, %d already existed.\n", importer.created, targetPath, importer.existing)
+	return err
+}
+
+// archiveImporter creates the objects of an archive in a workspace.
+type archiveImporter struct {
+	out     io.Writer
+	client  dynamic.Interface
+	timeout time.Duration
+
+	sourcePath, targetPath logicalcluster.Path
+
+	// hashes maps identity hashes of the archive to those in the target workspace.
+	hashes map[string]string
+	// localIdentities maps the names of the archived APIExports to their identity hashes.
+	localIdentities map[string]string
+	// skipIdentities drops the identities of the archived APIExports, such that new ones are created.
+	skipIdentities bool
+
+	created, existing int
+}
+
+func (i *archiveImporter) importResources(ctx context.Context, resources []ArchivedResource) error {
+	identitySecrets := sets.New[types.NamespacedName]()
+	if i.skipIdentities {
+		for _, resource := range resources {
+			if resource.Group != apisv1alpha2.SchemeGroupVersion.Group || resource.Resource != "apiexports" {
+				continue
+			}
+			for _, obj := range resource.Objects {
+				namespace, _, _ := unstructured.NestedString(obj.Object, "spec", "identity", "secretRef", "namespace")
+				name, _, _ := unstructured.NestedString(obj.Object, "spec", "identity", "secretRef", "name")
+				if name != "" {
+					identitySecrets.Insert(types.NamespacedName{Namespace: namespace, Name: name})
+				}
+			}
+		}
+	}
+
+	for _, resource := range resources {
+		gvr := resource.GroupVersionResource()
+		gr := gvr.GroupResource()
+		for _, archived := range resource.Objects {
+			obj := archived.DeepCopy()
+
+			switch gr {
+			case schema.GroupResource{Resource: "secrets"}:
+				if identitySecrets.Has(types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}) {
+					continue
+				}
+			case apisv1alpha2.Resource("apiexports"):
+				if i.skipIdentities {
+					unstructured.RemoveNestedField(obj.Object, "spec", "identity")
+				}
+			case apisv1alpha2.Resource("apibindings"):
+				if err := rewriteExportPath(obj, i.sourcePath, i.targetPath); err != nil {
+					return err
+				}
+			}
+			rewriteIdentityHashes(obj.Object, i.hashes)
+
+			if err := i.create(ctx, resource, obj); err != nil {
+				return err
+			}
+		}
+
+		// wait for the imported APIs to be served before importing their objects.
+		var err error
+		switch gr {
+		case schema.GroupResource{Group: "apiextensions.k8s.io", Resource: "customresourcedefinitions"}:
+			err = i.waitFor(ctx, resource, "CustomResourceDefinitions to be established", func(obj *unstructured.Unstructured) bool {
+				return hasTrueCondition(obj, "Established")
+			})
+		case apisv1alpha2.Resource("apiexports"):
+			err = i.waitFor(ctx, resource, "APIExport identities", func(obj *unstructured.Unstructured) bool {
+				hash, _, _ := unstructured.NestedString(obj.Object, "status", "identityHash")
+				if hash == "" {
+					return false
+				}
+				if old, found := i.localIdentities[obj.GetName()]; found {
+					i.hashes[old] = hash
+				}
+				return true
+			})
+		case apisv1alpha2.Resource("apibindings"):
+			err = i.waitFor(ctx, resource, "APIBindings to be bound", func(obj *unstructured.Unstructured) bool {
+				phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+				return phase == string(apisv1alpha2.APIBindingPhaseBound)
+			})
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// create creates the object, retrying while its resource is not served yet.
+func (i *archiveImporter) create(ctx context.Context, resource ArchivedResource, obj *unstructured.Unstructured) error {
+	client := i.resourceClient(resource, obj.GetNamespace())
+	return wait.PollUntilContextTimeout(ctx, time.Millisecond*500, i.timeout, true, func(ctx context.Context) (bool, error) {
+		_, err := client.Create(ctx, obj, metav1.CreateOptions{})
+		switch {
+		case err == nil:
+			i.created++
+			return true, nil
+		case apierrors.IsAlreadyExists(err):
+			i.existing++
+			return true, nil
+		case apierrors.IsNotFound(err):
+			return false, nil // the resource is not served yet
+		default:
+			return false, fmt.Errorf("failed to create %s %s: %w", resource.GroupVersionResource().GroupResource(), objectName(obj), err)
+		}
+	})
+}
+
+// waitFor waits until the condition holds for all objects of the resource.
+func (i *archiveImporter) waitFor(ctx context.Context, resource ArchivedResource, what string, condition func(obj *unstructured.Unstructured) bool) error {
+	if _, err := fmt.Fprintf(i.out, "Waiting for %s...\n", what); err != nil {
+		return err
+	}
+	for _, archived := range resource.Objects {
+		client := i.resourceClient(resource, archived.GetNamespace())
+		if err := wait.PollUntilContextTimeout(ctx, time.Millisecond*500, i.timeout, true, func(ctx context.Context) (bool, error) {
+			obj, err := client.Get(ctx, archived.GetName(), metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			return condition(obj), nil
+		}); err != nil {
+			return fmt.Errorf("failed waiting for %s %s: %w", resource.GroupVersionResource().GroupResource(), objectName(&archived), err)
+		}
+	}
+	return nil
+}
+
+func (i *archiveImporter) resourceClient(resource ArchivedResource, namespace string) dynamic.ResourceInterface {
+	if resource.Namespaced {
+		return i.client.Resource(resource.GroupVersionResource()).Namespace(namespace)
+	}
+	return i.client.Resource(resource.GroupVersionResource())
+}
+
+func hasTrueCondition(obj *unstructured.Unstructured, conditionType string) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if ok && condition["type"] == conditionType && condition["status"] == "True" {
+			return true
+		}
+	}
+	return false
+}
+
+func objectName(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
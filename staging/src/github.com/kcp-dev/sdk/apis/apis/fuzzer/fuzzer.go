@@ -198,7 +198,7 @@ func Funcs(codecs runtimeserializer.CodecFactory) []any {
 
 				selector := v1alpha2.PermissionClaimSelector{}
 
-				switch c.Intn(3) {
+				switch c.Intn(4) {
 				case 0:
 					selector.MatchAll = true
 				case 1:
@@ -208,6 +208,13 @@ func Funcs(codecs runtimeserializer.CodecFactory) []any {
 						labels[nonEmptyString(c.String)] = nonEmptyString(c.String)
 					}
 					selector.MatchLabels = labels
+				case 2:
+					for range c.Intn(3) + 1 {
+						selector.Namespaces = append(selector.Namespaces, nonEmptyString(c.String))
+					}
+					for range c.Intn(3) {
+						selector.Names = append(selector.Names, nonEmptyString(c.String))
+					}
 				default:
 					numExpressions := c.Intn(5) + 1
 					expressions := make([]metav1.LabelSelectorRequirement, numExpressions)
@@ -237,6 +244,10 @@ func Funcs(codecs runtimeserializer.CodecFactory) []any {
 					},
 					State: v1alpha2.ClaimAccepted,
 				})
+				if c.Bool() {
+					expiresAt := metav1.Unix(c.Int63n(1<<32), 0)
+					r.PermissionClaims[len(r.PermissionClaims)-1].ExpiresAt = &expiresAt
+				}
 			}
 		},
 		func(r *v1alpha2.APIBindingStatus, c randfill.Continue) {
@@ -254,7 +265,7 @@ func Funcs(codecs runtimeserializer.CodecFactory) []any {
 
 				selector := v1alpha2.PermissionClaimSelector{}
 
-				switch c.Intn(3) {
+				switch c.Intn(4) {
 				case 0:
 					selector.MatchAll = true
 				case 1:
@@ -264,6 +275,13 @@ func Funcs(codecs runtimeserializer.CodecFactory) []any {
 						labels[nonEmptyString(c.String)] = nonEmptyString(c.String)
 					}
 					selector.MatchLabels = labels
+				case 2:
+					for range c.Intn(3) + 1 {
+						selector.Namespaces = append(selector.Namespaces, nonEmptyString(c.String))
+					}
+					for range c.Intn(3) {
+						selector.Names = append(selector.Names, nonEmptyString(c.String))
+					}
 				default:
 					numExpressions := c.Intn(5) + 1
 					expressions := make([]metav1.LabelSelectorRequirement, numExpressions)
@@ -100,10 +100,10 @@ type APIExportSpec struct {
 	// with this APIExport.
 	//
 	// The schemas can be changed in the life-cycle of the APIExport. These changes
-	// have no effect on existing APIBindings, but only on newly bound ones.
+	// are applied to all APIBindings at once.
 	//
-	// For updating existing APIBindings, use an APIDeployment keeping bound
-	// workspaces up-to-date.
+	// For rolling out new schemas to existing APIBindings gradually, use an
+	// APIDeployment, which updates this field when the rollout is complete.
 	//
 	// +optional
 	// +listType=set
@@ -68,8 +68,8 @@ type APIResourceSchemaSpec struct {
 
 	// versions is the API version of the defined custom resource.
 	//
-	// Note: the OpenAPI v3 schemas must be equal for all versions until CEL
-	//       version migration is supported.
+	// Note: versions with different OpenAPI v3 schemas need a conversion webhook, or
+	//       an APIConversion of the same name as this APIResourceSchema.
 	//
 	// +required
 	// +listType=map
@@ -275,6 +275,12 @@ type APIConversion struct {
 	Spec APIConversionSpec `json:"spec"`
 }
 
+// AnnotationPreservedFieldsKey is the annotation key set on objects converted with the rules of
+// an APIConversion that preserve fields. It holds the values of the preserved fields of the
+// originating version as a JSON object keyed by their path, and is removed when the values are
+// restored by the next conversion.
+const AnnotationPreservedFieldsKey = "apis.kcp.io/conversion-preserved-fields"
+
 // APIConversionSpec contains rules to convert between different API versions in an APIResourceSchema.
 type APIConversionSpec struct {
 	// conversions specify rules to convert between different API versions in an APIResourceSchema.
@@ -17,7 +17,11 @@ limitations under the License.
 package v1alpha2
 
 import (
+	"slices"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
 )
@@ -65,7 +69,7 @@ type APIBindingSpec struct {
 	//
 	// +required
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="APIExport reference must not be changed"
+	// +kubebuilder:validation:XValidation:rule="has(self.export) == has(oldSelf.export) && (!has(self.export) || (self.export.name == oldSelf.export.name && (has(self.export.path) ? self.export.path : '') == (has(oldSelf.export.path) ? oldSelf.export.path : '')))",message="APIExport reference must not be changed"
 	Reference BindingReference `json:"reference"`
 
 	// permissionClaims records decisions about permission claims requested by the API service provider.
@@ -79,6 +83,64 @@ type APIBindingSpec struct {
 	// +listMapKey=resource
 	// +listMapKey=identityHash
 	PermissionClaims []AcceptablePermissionClaim `json:"permissionClaims,omitempty"`
+
+	// resourceRenames serve resources of the APIExport under another group or resource name in this
+	// workspace, e.g. to avoid naming conflicts with other APIBindings or CRDs. Objects are stored
+	// under the identity of the APIExport independently of the name they are served as, hence the
+	// APIExport owner sees them under the exported name.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=group
+	// +listMapKey=resource
+	ResourceRenames []ResourceRename `json:"resourceRenames,omitempty"`
+
+	// resources restricts the APIBinding to the listed resources of the APIExport. Other resources
+	// of the APIExport are neither bound nor served in this workspace. All resources are bound if
+	// the list is empty. Resources can be added, but not removed once bound.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=group
+	// +listMapKey=resource
+	Resources []GroupResource `json:"resources,omitempty"`
+}
+
+// SelectsResource returns true if the exported resource is to be bound, i.e. if no resources are
+// selected at all or if the resource is one of the selected ones.
+func (in *APIBindingSpec) SelectsResource(group, resource string) bool {
+	if len(in.Resources) == 0 {
+		return true
+	}
+	for _, gr := range in.Resources {
+		if gr.Group == group && gr.Resource == resource {
+			return true
+		}
+	}
+	return false
+}
+
+// ResourceRename serves a resource of an APIExport under another group or resource name.
+type ResourceRename struct {
+	// GroupResource is the resource as exported by the APIExport.
+	GroupResource `json:",inline"`
+
+	// as is the group and resource the resource is served as in the workspace. The group
+	// must not be the core group, nor a group reserved for Kubernetes or kcp.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	As GroupResource `json:"as"`
+}
+
+// FindResourceRename returns the rename of the given exported resource, or nil if it is not renamed.
+func (in *APIBindingSpec) FindResourceRename(group, resource string) *ResourceRename {
+	for i := range in.ResourceRenames {
+		if in.ResourceRenames[i].Group == group && in.ResourceRenames[i].Resource == resource {
+			return &in.ResourceRenames[i]
+		}
+	}
+	return nil
 }
 
 // ScopedPermissionClaim embeds a PermissionClaim and adds a selector to
@@ -87,12 +149,14 @@ type ScopedPermissionClaim struct {
 	PermissionClaim `json:",inline"`
 
 	// selector configures which objects for the claimed resource
-	// are made available to the APIExport owner. This field is immutable.
-	// Only one of matchLabels, matchExpressions or matchAll can be set.
+	// are made available to the APIExport owner. When the selector of an
+	// accepted claim is changed, the previous selector stays applied until
+	// the objects of the claimed resource have been relabeled.
+	// matchAll cannot be combined with any other field. Otherwise,
+	// matchLabels, matchExpressions, namespaces and names all have to match.
 
 	// +required
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Permission claim selector is immutable"
 	Selector PermissionClaimSelector `json:"selector"`
 }
 
@@ -106,6 +170,19 @@ type AcceptablePermissionClaim struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Enum=Accepted;Rejected
 	State AcceptablePermissionClaimState `json:"state"`
+
+	// expiresAt is the point in time at which the acceptance of the claim expires.
+	// After that, the claim is treated as not accepted and the API service provider
+	// loses access, until the claim is accepted again with a later or no expiry.
+	//
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// IsAcceptedAt returns true if the claim is accepted and the acceptance has not
+// expired at the given time.
+func (c AcceptablePermissionClaim) IsAcceptedAt(now time.Time) bool {
+	return c.State == ClaimAccepted && (c.ExpiresAt == nil || now.Before(c.ExpiresAt.Time))
 }
 
 type AcceptablePermissionClaimState string
@@ -122,6 +199,36 @@ type PermissionClaimSelector struct {
 
 	// matchAll grants access to all objects of the claimed resource.
 	MatchAll bool `json:"matchAll,omitempty"`
+
+	// namespaces limits access to objects in one of the given namespaces.
+	// Cluster-scoped objects are never selected if this is set.
+	//
+	// +optional
+	// +listType=set
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// names limits access to objects with one of the given names.
+	//
+	// +optional
+	// +listType=set
+	Names []string `json:"names,omitempty"`
+}
+
+// IsEmpty returns true if no field of the selector is set.
+func (s PermissionClaimSelector) IsEmpty() bool {
+	return !s.MatchAll && len(s.MatchLabels) == 0 && len(s.MatchExpressions) == 0 && len(s.Namespaces) == 0 && len(s.Names) == 0
+}
+
+// MatchesNamespaceAndName returns true if an object with the given namespace and name
+// is selected by namespaces and names. Labels are not taken into account.
+func (s PermissionClaimSelector) MatchesNamespaceAndName(namespace, name string) bool {
+	if len(s.Namespaces) > 0 && !slices.Contains(s.Namespaces, namespace) {
+		return false
+	}
+	if len(s.Names) > 0 && !slices.Contains(s.Names, name) {
+		return false
+	}
+	return true
 }
 
 // BindingReference describes a reference to an APIExport. Exactly one of the
@@ -136,6 +243,9 @@ type BindingReference struct {
 }
 
 // ExportBindingReference is a reference to an APIExport by cluster and name.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.release) || !has(self.channel)",message="release and channel are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.upgradePolicy) || has(self.channel)",message="upgradePolicy requires channel"
 type ExportBindingReference struct {
 	// path is a logical cluster path where the APIExport is defined.
 	// If the path is unset, the logical cluster of the APIBinding is used.
@@ -150,8 +260,40 @@ type ExportBindingReference struct {
 	// +kubebuilder:validation:Required
 	// +kube:validation:MinLength=1
 	Name string `json:"name"`
+
+	// release pins the APIBinding to a release of the APIExport. If neither release nor
+	// channel is set, the resources of the APIExport are bound.
+	//
+	// +optional
+	Release string `json:"release,omitempty"`
+
+	// channel makes the APIBinding follow a channel of the APIExport, i.e. it is bound to the
+	// release the channel points at.
+	//
+	// +optional
+	Channel string `json:"channel,omitempty"`
+
+	// upgradePolicy defines when an APIBinding following a channel moves to the release the
+	// channel points at:
+	// - Automatic (default): as soon as the channel points at another release.
+	// - Compatible: only if the schemas of the new release are backwards compatible with the
+	//   schemas of the bound release.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=Automatic;Compatible
+	UpgradePolicy ReleaseUpgradePolicy `json:"upgradePolicy,omitempty"`
 }
 
+// ReleaseUpgradePolicy defines when an APIBinding following a channel moves to a new release.
+type ReleaseUpgradePolicy string
+
+const (
+	// ReleaseUpgradePolicyAutomatic moves the APIBinding as soon as the channel points at another release.
+	ReleaseUpgradePolicyAutomatic ReleaseUpgradePolicy = "Automatic"
+	// ReleaseUpgradePolicyCompatible moves the APIBinding only to releases with compatible schemas.
+	ReleaseUpgradePolicyCompatible ReleaseUpgradePolicy = "Compatible"
+)
+
 // APIBindingPhaseType is the type of the current phase of an APIBinding.
 type APIBindingPhaseType string
 
@@ -167,6 +309,12 @@ type APIBindingStatus struct {
 	// +optional
 	APIExportClusterName string `json:"apiExportClusterName,omitempty"`
 
+	// release is the name of the release of the APIExport that is bound. It is empty if
+	// the resources of the APIExport are bound.
+	//
+	// +optional
+	Release string `json:"release,omitempty"`
+
 	// boundResources records the state of bound APIs.
 	//
 	// +optional
@@ -204,6 +352,14 @@ type APIBindingStatus struct {
 	// the binding to grant.
 	// +optional
 	ExportPermissionClaims []PermissionClaim `json:"exportPermissionClaims,omitempty"`
+
+	// dependencies are the names of the APIBindings in this workspace that bind the
+	// dependencies of the referenced APIExport. They cannot be deleted while this
+	// APIBinding exists.
+	//
+	// +optional
+	// +listType=set
+	Dependencies []string `json:"dependencies,omitempty"`
 }
 
 // These are valid conditions of APIBinding.
@@ -256,6 +412,42 @@ const (
 	// PermissionClaimsApplied is a condition for APIBinding that indicates that all the accepted permission claims
 	// have been applied.
 	PermissionClaimsApplied conditionsv1alpha1.ConditionType = "PermissionClaimsApplied"
+
+	// ReleaseNotFoundReason is a reason for the APIExportValid condition that the release or channel referenced
+	// by the APIBinding is not found in the APIExport.
+	ReleaseNotFoundReason = "ReleaseNotFound"
+
+	// ResourceNotFoundReason is a reason for the APIExportValid condition that a resource selected
+	// by the APIBinding is not exported by the APIExport.
+	ResourceNotFoundReason = "ResourceNotFound"
+
+	// ReleaseUpToDate is a condition for APIBinding that indicates that an APIBinding following a channel is
+	// bound to the release the channel points at.
+	ReleaseUpToDate conditionsv1alpha1.ConditionType = "ReleaseUpToDate"
+
+	// IncompatibleReleaseReason is a reason for the ReleaseUpToDate condition that the APIBinding does not move
+	// to the release of its channel because the schemas are incompatible.
+	IncompatibleReleaseReason = "IncompatibleRelease"
+
+	// DependenciesValid is a condition for APIBinding that indicates that the APIExports the referenced APIExport
+	// depends on are bound in the workspace.
+	DependenciesValid conditionsv1alpha1.ConditionType = "DependenciesValid"
+
+	// DependencyNotFoundReason is a reason for the DependenciesValid condition that an APIExport the referenced
+	// APIExport depends on is not found.
+	DependencyNotFoundReason = "DependencyNotFound"
+
+	// DependencyNotBoundReason is a reason for the DependenciesValid condition that there is no APIBinding
+	// for a dependency in the workspace. APIBindings for dependencies have to be created by the user.
+	DependencyNotBoundReason = "DependencyNotBound"
+
+	// WaitingForDependenciesReason is a reason for the DependenciesValid condition that the APIBindings of
+	// the dependencies are not bound yet.
+	WaitingForDependenciesReason = "WaitingForDependencies"
+
+	// PermissionClaimsExpiredReason is a reason for the PermissionClaimsApplied condition that the acceptance
+	// of at least one permission claim has expired and its access has been revoked.
+	PermissionClaimsExpiredReason = "PermissionClaimsExpired"
 )
 
 // BoundAPIResource describes a bound GroupVersionResource through an APIResourceSchema of an APIExport..
@@ -287,6 +479,20 @@ type BoundAPIResource struct {
 	// +optional
 	// +listType=set
 	StorageVersions []string `json:"storageVersions,omitempty"`
+
+	// servedAs is the group and resource the bound API is served as in the workspace, if it is
+	// renamed through spec.resourceRenames. Objects are still stored under group and resource.
+	//
+	// +optional
+	ServedAs *GroupResource `json:"servedAs,omitempty"`
+}
+
+// ServedGroupResource returns the group and resource the bound API is served as in the workspace.
+func (r BoundAPIResource) ServedGroupResource() schema.GroupResource {
+	if r.ServedAs != nil {
+		return schema.GroupResource{Group: r.ServedAs.Group, Resource: r.ServedAs.Resource}
+	}
+	return schema.GroupResource{Group: r.Group, Resource: r.Resource}
 }
 
 // BoundAPIResourceSchema is a reference to an APIResourceSchema.
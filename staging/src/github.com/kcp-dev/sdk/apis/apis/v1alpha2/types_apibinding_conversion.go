@@ -31,6 +31,12 @@ const (
 	StatusAppliedClaimsAnnotation            = "apis.v1alpha2.kcp.io/status-applied-permission-claims"
 	StatusPermissionClaimsV1Alpha1Annotation = "apis.v1alpha2.kcp.io/v1alpha1-status-export-permission-claims"
 	StatusAppliedClaimsV1Alpha1Annotation    = "apis.v1alpha2.kcp.io/v1alpha1-status-applied-permission-claims"
+	StatusDependenciesAnnotation             = "apis.v1alpha2.kcp.io/status-dependencies"
+	ReferenceReleaseAnnotation               = "apis.v1alpha2.kcp.io/reference-release"
+	StatusReleaseAnnotation                  = "apis.v1alpha2.kcp.io/status-release"
+	ResourceRenamesAnnotation                = "apis.v1alpha2.kcp.io/resource-renames"
+	StatusServedAsAnnotation                 = "apis.v1alpha2.kcp.io/status-served-as"
+	ResourcesAnnotation                      = "apis.v1alpha2.kcp.io/resources"
 )
 
 // v1alpha2 -> v1alpha1 conversions.
@@ -56,6 +62,50 @@ func Convert_v1alpha2_APIBinding_To_v1alpha1_APIBinding(in *APIBinding, out *api
 		out.Annotations[AcceptablePermissionClaimsAnnotation] = string(encoded)
 	}
 
+	// The release, channel and upgrade policy of the reference do not exist in v1alpha1 and are retained
+	// via an annotation.
+	if export := in.Spec.Reference.Export; export != nil && (export.Release != "" || export.Channel != "" || export.UpgradePolicy != "") {
+		encoded, err := json.Marshal(exportBindingReleaseReference{
+			Release:       export.Release,
+			Channel:       export.Channel,
+			UpgradePolicy: export.UpgradePolicy,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to encode release reference as JSON: %w", err)
+		}
+
+		if out.Annotations == nil {
+			out.Annotations = map[string]string{}
+		}
+		out.Annotations[ReferenceReleaseAnnotation] = string(encoded)
+	}
+
+	// Spec.ResourceRenames does not exist in v1alpha1 and is retained via an annotation.
+	if len(in.Spec.ResourceRenames) > 0 {
+		encoded, err := json.Marshal(in.Spec.ResourceRenames)
+		if err != nil {
+			return fmt.Errorf("failed to encode resource renames as JSON: %w", err)
+		}
+
+		if out.Annotations == nil {
+			out.Annotations = map[string]string{}
+		}
+		out.Annotations[ResourceRenamesAnnotation] = string(encoded)
+	}
+
+	// Spec.Resources does not exist in v1alpha1 and is retained via an annotation.
+	if len(in.Spec.Resources) > 0 {
+		encoded, err := json.Marshal(in.Spec.Resources)
+		if err != nil {
+			return fmt.Errorf("failed to encode resources as JSON: %w", err)
+		}
+
+		if out.Annotations == nil {
+			out.Annotations = map[string]string{}
+		}
+		out.Annotations[ResourcesAnnotation] = string(encoded)
+	}
+
 	if err := Convert_v1alpha2_APIBindingSpec_To_v1alpha1_APIBindingSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
@@ -114,6 +164,46 @@ func Convert_v1alpha2_APIBinding_To_v1alpha1_APIBinding(in *APIBinding, out *api
 		out.Annotations[StatusAppliedClaimsAnnotation] = string(encoded)
 	}
 
+	// Status.Dependencies does not exist in v1alpha1 and is retained via an annotation.
+	if len(in.Status.Dependencies) > 0 {
+		encoded, err := json.Marshal(in.Status.Dependencies)
+		if err != nil {
+			return fmt.Errorf("failed to encode dependencies as JSON: %w", err)
+		}
+
+		if out.Annotations == nil {
+			out.Annotations = map[string]string{}
+		}
+		out.Annotations[StatusDependenciesAnnotation] = string(encoded)
+	}
+
+	// Status.Release does not exist in v1alpha1 and is retained via an annotation.
+	if in.Status.Release != "" {
+		if out.Annotations == nil {
+			out.Annotations = map[string]string{}
+		}
+		out.Annotations[StatusReleaseAnnotation] = in.Status.Release
+	}
+
+	// BoundResources[].ServedAs does not exist in v1alpha1 and is retained via an annotation.
+	var servedAs []boundAPIResourceServedAs
+	for _, br := range in.Status.BoundResources {
+		if br.ServedAs != nil {
+			servedAs = append(servedAs, boundAPIResourceServedAs{GroupResource: GroupResource{Group: br.Group, Resource: br.Resource}, ServedAs: *br.ServedAs})
+		}
+	}
+	if len(servedAs) > 0 {
+		encoded, err := json.Marshal(servedAs)
+		if err != nil {
+			return fmt.Errorf("failed to encode served resources as JSON: %w", err)
+		}
+
+		if out.Annotations == nil {
+			out.Annotations = map[string]string{}
+		}
+		out.Annotations[StatusServedAsAnnotation] = string(encoded)
+	}
+
 	if err := Convert_v1alpha2_APIBindingStatus_To_v1alpha1_APIBindingStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
@@ -170,11 +260,11 @@ func Convert_v1alpha2_APIBinding_To_v1alpha1_APIBinding(in *APIBinding, out *api
 }
 
 // Convert_v1alpha2_AcceptablePermissionClaims_To_v1alpha1_AcceptablePermissionClaims converts v1alpha2.AcceptablePermissionClaims
-// to v1alpha1.AcceptablePermissionClaims. This is not a lossless conversion, verbs and selectors are lost in this conversion.
+// to v1alpha1.AcceptablePermissionClaims. This is not a lossless conversion, verbs, selectors and expiries are lost in this conversion.
 // For lossless conversion use Convert_v1alpha2_APIBinding_To_v1alpha1_APIBinding.
 func Convert_v1alpha2_AcceptablePermissionClaims_To_v1alpha1_AcceptablePermissionClaims(in []AcceptablePermissionClaim, s kubeconversion.Scope) (out []apisv1alpha1.AcceptablePermissionClaim, overhanging []AcceptablePermissionClaim, err error) {
 	for _, apc := range in {
-		if len(apc.PermissionClaim.Verbs) == 1 && apc.PermissionClaim.Verbs[0] == "*" && apc.Selector.MatchAll {
+		if len(apc.PermissionClaim.Verbs) == 1 && apc.PermissionClaim.Verbs[0] == "*" && apc.Selector.MatchAll && apc.ExpiresAt == nil {
 			var v1apc apisv1alpha1.AcceptablePermissionClaim
 
 			if err := Convert_v1alpha2_AcceptablePermissionClaim_To_v1alpha1_AcceptablePermissionClaim(&apc, &v1apc, s); err != nil {
@@ -212,7 +302,7 @@ func Convert_v1alpha2_ScopedPermissionClaims_To_v1alpha1_PermissionClaims(in []S
 }
 
 // Convert_v1alpha2_AcceptablePermissionClaim_To_v1alpha1_AcceptablePermissionClaim converts v1alpha2.AcceptablePermissionClaim
-// to v1alpha1.AcceptablePermissionClaim. This is not a lossless conversion, selectors are lost in this conversion.
+// to v1alpha1.AcceptablePermissionClaim. This is not a lossless conversion, selectors and expiries are lost in this conversion.
 // For lossless conversion use Convert_v1alpha2_APIBinding_To_v1alpha1_APIBinding.
 func Convert_v1alpha2_AcceptablePermissionClaim_To_v1alpha1_AcceptablePermissionClaim(in *AcceptablePermissionClaim, out *apisv1alpha1.AcceptablePermissionClaim, s kubeconversion.Scope) error {
 	if err := Convert_v1alpha2_ScopedPermissionClaim_To_v1alpha1_PermissionClaim(&in.ScopedPermissionClaim, &out.PermissionClaim, s); err != nil {
@@ -232,6 +322,44 @@ func Convert_v1alpha2_ScopedPermissionClaim_To_v1alpha1_PermissionClaim(in *Scop
 	return nil
 }
 
+// exportBindingReleaseReference holds the fields of ExportBindingReference that do not exist in v1alpha1.
+type exportBindingReleaseReference struct {
+	Release       string               `json:"release,omitempty"`
+	Channel       string               `json:"channel,omitempty"`
+	UpgradePolicy ReleaseUpgradePolicy `json:"upgradePolicy,omitempty"`
+}
+
+// Convert_v1alpha2_ExportBindingReference_To_v1alpha1_ExportBindingReference is *not* lossless, as it will drop
+// the release, channel and upgrade policy. To have a full, lossless conversion, use
+// Convert_v1alpha2_APIBinding_To_v1alpha1_APIBinding instead.
+func Convert_v1alpha2_ExportBindingReference_To_v1alpha1_ExportBindingReference(in *ExportBindingReference, out *apisv1alpha1.ExportBindingReference, s kubeconversion.Scope) error {
+	return autoConvert_v1alpha2_ExportBindingReference_To_v1alpha1_ExportBindingReference(in, out, s)
+}
+
+// Convert_v1alpha2_APIBindingSpec_To_v1alpha1_APIBindingSpec is *not* lossless, as it will drop the
+// resource renames. To have a full, lossless conversion, use Convert_v1alpha2_APIBinding_To_v1alpha1_APIBinding instead.
+func Convert_v1alpha2_APIBindingSpec_To_v1alpha1_APIBindingSpec(in *APIBindingSpec, out *apisv1alpha1.APIBindingSpec, s kubeconversion.Scope) error {
+	return autoConvert_v1alpha2_APIBindingSpec_To_v1alpha1_APIBindingSpec(in, out, s)
+}
+
+// boundAPIResourceServedAs holds the served group and resource of a BoundAPIResource, which do not exist in v1alpha1.
+type boundAPIResourceServedAs struct {
+	GroupResource `json:",inline"`
+	ServedAs      GroupResource `json:"servedAs"`
+}
+
+// Convert_v1alpha2_BoundAPIResource_To_v1alpha1_BoundAPIResource is *not* lossless, as it will drop the
+// served group and resource. To have a full, lossless conversion, use Convert_v1alpha2_APIBinding_To_v1alpha1_APIBinding instead.
+func Convert_v1alpha2_BoundAPIResource_To_v1alpha1_BoundAPIResource(in *BoundAPIResource, out *apisv1alpha1.BoundAPIResource, s kubeconversion.Scope) error {
+	return autoConvert_v1alpha2_BoundAPIResource_To_v1alpha1_BoundAPIResource(in, out, s)
+}
+
+// Convert_v1alpha2_APIBindingStatus_To_v1alpha1_APIBindingStatus is *not* lossless, as it will drop the
+// dependencies and the release. To have a full, lossless conversion, use Convert_v1alpha2_APIBinding_To_v1alpha1_APIBinding instead.
+func Convert_v1alpha2_APIBindingStatus_To_v1alpha1_APIBindingStatus(in *APIBindingStatus, out *apisv1alpha1.APIBindingStatus, s kubeconversion.Scope) error {
+	return autoConvert_v1alpha2_APIBindingStatus_To_v1alpha1_APIBindingStatus(in, out, s)
+}
+
 // v1alpha1 -> v1alpha2 conversions.
 
 func Convert_v1alpha1_APIBinding_To_v1alpha2_APIBinding(in *apisv1alpha1.APIBinding, out *APIBinding, s kubeconversion.Scope) error {
@@ -271,6 +399,7 @@ func Convert_v1alpha1_APIBinding_To_v1alpha2_APIBinding(in *apisv1alpha1.APIBind
 				if pc.EqualGRI(opc.PermissionClaim) {
 					out.Spec.PermissionClaims[i].PermissionClaim.Verbs = pc.Verbs
 					out.Spec.PermissionClaims[i].Selector = pc.Selector
+					out.Spec.PermissionClaims[i].ExpiresAt = pc.ExpiresAt
 				}
 			}
 		}
@@ -290,7 +419,7 @@ func Convert_v1alpha1_APIBinding_To_v1alpha2_APIBinding(in *apisv1alpha1.APIBind
 		// This is handling a special case where PermissionClaim had ResourceSelector in v1alpha1.
 		// That field doesn't exist in v1alpha2 and it always resulted in `MatchAll = true` behavior,
 		// so we set it here explicitly.
-		if !opc.Selector.MatchAll && len(opc.Selector.MatchLabels) == 0 && len(opc.Selector.MatchExpressions) == 0 {
+		if opc.Selector.IsEmpty() {
 			out.Spec.PermissionClaims[i].Selector.MatchAll = true
 		}
 	}
@@ -370,6 +499,67 @@ func Convert_v1alpha1_APIBinding_To_v1alpha2_APIBinding(in *apisv1alpha1.APIBind
 		delete(out.Annotations, StatusAppliedClaimsAnnotation)
 	}
 
+	if dependencies, ok := in.Annotations[StatusDependenciesAnnotation]; ok {
+		if err := json.Unmarshal([]byte(dependencies), &out.Status.Dependencies); err != nil {
+			return fmt.Errorf("failed to decode dependencies from JSON: %w", err)
+		}
+
+		delete(out.Annotations, StatusDependenciesAnnotation)
+	}
+
+	if release, ok := in.Annotations[StatusReleaseAnnotation]; ok {
+		out.Status.Release = release
+		delete(out.Annotations, StatusReleaseAnnotation)
+	}
+
+	if renames, ok := in.Annotations[ResourceRenamesAnnotation]; ok {
+		if err := json.Unmarshal([]byte(renames), &out.Spec.ResourceRenames); err != nil {
+			return fmt.Errorf("failed to decode resource renames from JSON: %w", err)
+		}
+
+		delete(out.Annotations, ResourceRenamesAnnotation)
+	}
+
+	if resources, ok := in.Annotations[ResourcesAnnotation]; ok {
+		if err := json.Unmarshal([]byte(resources), &out.Spec.Resources); err != nil {
+			return fmt.Errorf("failed to decode resources from JSON: %w", err)
+		}
+
+		delete(out.Annotations, ResourcesAnnotation)
+	}
+
+	if servedAsAnnotation, ok := in.Annotations[StatusServedAsAnnotation]; ok {
+		var servedAs []boundAPIResourceServedAs
+		if err := json.Unmarshal([]byte(servedAsAnnotation), &servedAs); err != nil {
+			return fmt.Errorf("failed to decode served resources from JSON: %w", err)
+		}
+
+		for _, sa := range servedAs {
+			for i, br := range out.Status.BoundResources {
+				if br.Group == sa.Group && br.Resource == sa.Resource {
+					out.Status.BoundResources[i].ServedAs = &GroupResource{Group: sa.ServedAs.Group, Resource: sa.ServedAs.Resource}
+				}
+			}
+		}
+
+		delete(out.Annotations, StatusServedAsAnnotation)
+	}
+
+	if releaseReference, ok := in.Annotations[ReferenceReleaseAnnotation]; ok {
+		var ref exportBindingReleaseReference
+		if err := json.Unmarshal([]byte(releaseReference), &ref); err != nil {
+			return fmt.Errorf("failed to decode release reference from JSON: %w", err)
+		}
+
+		if out.Spec.Reference.Export != nil {
+			out.Spec.Reference.Export.Release = ref.Release
+			out.Spec.Reference.Export.Channel = ref.Channel
+			out.Spec.Reference.Export.UpgradePolicy = ref.UpgradePolicy
+		}
+
+		delete(out.Annotations, ReferenceReleaseAnnotation)
+	}
+
 	// If the export permission claims in status do not have a verb yet,
 	// they should default to "*" as verb.
 	for i, pc := range out.Status.ExportPermissionClaims {
@@ -386,7 +576,7 @@ func Convert_v1alpha1_APIBinding_To_v1alpha2_APIBinding(in *apisv1alpha1.APIBind
 		// This is handling a special case where PermissionClaim had ResourceSelector in v1alpha1.
 		// That field doesn't exist in v1alpha2 and it always resulted in `MatchAll = true` behavior,
 		// so we set it here explicitly.
-		if !spc.Selector.MatchAll && len(spc.Selector.MatchLabels) == 0 && len(spc.Selector.MatchExpressions) == 0 {
+		if spc.Selector.IsEmpty() {
 			out.Status.AppliedPermissionClaims[i].Selector.MatchAll = true
 		}
 	}
@@ -131,6 +131,59 @@ func TestConvertV1Alpha2APIBindings(t *testing.T) {
 				},
 			},
 		},
+		{
+			Spec: APIBindingSpec{
+				Reference: BindingReference{
+					Export: &ExportBindingReference{
+						Path:          "foo",
+						Name:          "bar",
+						Channel:       "stable",
+						UpgradePolicy: ReleaseUpgradePolicyCompatible,
+					},
+				},
+			},
+			Status: APIBindingStatus{
+				Release: "v1",
+			},
+		},
+		{
+			Spec: APIBindingSpec{
+				Reference: BindingReference{
+					Export: &ExportBindingReference{
+						Path: "foo",
+						Name: "bar",
+					},
+				},
+				ResourceRenames: []ResourceRename{{
+					GroupResource: GroupResource{Group: "example.io", Resource: "widgets"},
+					As:            GroupResource{Group: "acme.example.com", Resource: "widgets"},
+				}},
+			},
+			Status: APIBindingStatus{
+				BoundResources: []BoundAPIResource{
+					{
+						Group:    "example.io",
+						Resource: "widgets",
+						ServedAs: &GroupResource{Group: "acme.example.com", Resource: "widgets"},
+					},
+					{
+						Group:    "example.io",
+						Resource: "gadgets",
+					},
+				},
+			},
+		},
+		{
+			Spec: APIBindingSpec{
+				Reference: BindingReference{
+					Export: &ExportBindingReference{
+						Path: "foo",
+						Name: "bar",
+					},
+				},
+				Resources: []GroupResource{{Group: "example.io", Resource: "widgets"}},
+			},
+		},
 		{
 			Spec: APIBindingSpec{
 				Reference: BindingReference{
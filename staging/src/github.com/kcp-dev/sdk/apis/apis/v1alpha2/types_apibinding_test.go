@@ -78,6 +78,55 @@ func TestAPIBindingAPIExportReferenceCELValidation(t *testing.T) {
 			},
 			wantErrs: []string{"openAPIV3Schema.properties.spec.properties.reference: Invalid value: \"object\": APIExport reference must not be changed"},
 		},
+		{
+			name: "unset path",
+			current: map[string]any{
+				"export": map[string]any{
+					"name": "bar",
+				},
+			},
+			old: map[string]any{
+				"export": map[string]any{
+					"path": "foo",
+					"name": "bar",
+				},
+			},
+			wantErrs: []string{"openAPIV3Schema.properties.spec.properties.reference: Invalid value: \"object\": APIExport reference must not be changed"},
+		},
+		{
+			name: "change channel",
+			current: map[string]any{
+				"export": map[string]any{
+					"path":    "foo",
+					"name":    "bar",
+					"channel": "beta",
+				},
+			},
+			old: map[string]any{
+				"export": map[string]any{
+					"path":    "foo",
+					"name":    "bar",
+					"channel": "stable",
+				},
+			},
+		},
+		{
+			name: "switch from channel to release",
+			current: map[string]any{
+				"export": map[string]any{
+					"path":    "foo",
+					"name":    "bar",
+					"release": "v1",
+				},
+			},
+			old: map[string]any{
+				"export": map[string]any{
+					"path":    "foo",
+					"name":    "bar",
+					"channel": "stable",
+				},
+			},
+		},
 	}
 
 	validators := apitest.FieldValidatorsFromFile(t, "../../../../../../../../config/crds/apis.kcp.io_apibindings.yaml")
@@ -105,3 +154,71 @@ func TestAPIBindingAPIExportReferenceCELValidation(t *testing.T) {
 		})
 	}
 }
+
+// TestExportBindingReferenceCELValidation will validate the release, channel and upgrade policy of an APIExport reference.
+func TestExportBindingReferenceCELValidation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		current  map[string]any
+		wantErrs []string
+	}{
+		{
+			name: "release",
+			current: map[string]any{
+				"name":    "bar",
+				"release": "v1",
+			},
+		},
+		{
+			name: "channel with upgrade policy",
+			current: map[string]any{
+				"name":          "bar",
+				"channel":       "stable",
+				"upgradePolicy": "Compatible",
+			},
+		},
+		{
+			name: "release and channel",
+			current: map[string]any{
+				"name":    "bar",
+				"release": "v1",
+				"channel": "stable",
+			},
+			wantErrs: []string{"openAPIV3Schema.properties.spec.properties.reference.properties.export: Invalid value: \"object\": release and channel are mutually exclusive"},
+		},
+		{
+			name: "upgrade policy without channel",
+			current: map[string]any{
+				"name":          "bar",
+				"release":       "v1",
+				"upgradePolicy": "Automatic",
+			},
+			wantErrs: []string{"openAPIV3Schema.properties.spec.properties.reference.properties.export: Invalid value: \"object\": upgradePolicy requires channel"},
+		},
+	}
+
+	validators := apitest.FieldValidatorsFromFile(t, "../../../../../../../../config/crds/apis.kcp.io_apibindings.yaml")
+
+	for _, tc := range testCases {
+		pth := "openAPIV3Schema.properties.spec.properties.reference.properties.export"
+		validator, found := validators["v1alpha2"][pth]
+		require.True(t, found, "failed to find validator for %s", pth)
+
+		t.Run(tc.name, func(t *testing.T) {
+			errs := validator(tc.current, nil)
+			t.Log(errs)
+
+			if got := len(errs); got != len(tc.wantErrs) {
+				t.Errorf("expected errors %v, got %v", len(tc.wantErrs), len(errs))
+				return
+			}
+
+			for i := range tc.wantErrs {
+				got := errs[i].Error()
+				if got != tc.wantErrs[i] {
+					t.Errorf("want error %q, got %q", tc.wantErrs[i], got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	conditionsv1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+)
+
+// APIDeployment rolls out new APIResourceSchemas of an APIExport to the workspaces
+// bound to it, in waves.
+//
+// An APIDeployment lives in the workspace of the APIExport. Bindings that are part of
+// the current or an earlier wave are bound to spec.resources, all others keep the
+// resources of the APIExport. Before every wave, the new schemas are checked to be
+// backwards compatible with the schemas of the APIExport. When the last wave is
+// complete, spec.resources are written to the APIExport.
+//
+// Deleting an APIDeployment before it completed returns all bindings to the resources
+// of the APIExport.
+//
+// +crd
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories=kcp
+// +kubebuilder:printcolumn:name="Export",type="string",JSONPath=".spec.exportName"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Wave",type="integer",JSONPath=".status.currentWave"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type APIDeployment struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec holds the desired state.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	Spec APIDeploymentSpec `json:"spec"`
+
+	// Status communicates the observed state.
+	//
+	// +optional
+	Status APIDeploymentStatus `json:"status,omitempty"`
+}
+
+func (in *APIDeployment) GetConditions() conditionsv1alpha1.Conditions {
+	return in.Status.Conditions
+}
+
+func (in *APIDeployment) SetConditions(conditions conditionsv1alpha1.Conditions) {
+	in.Status.Conditions = conditions
+}
+
+// APIDeploymentSpec defines the desired state of APIDeployment.
+type APIDeploymentSpec struct {
+	// exportName is the name of the APIExport in the same workspace whose bindings are upgraded.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="exportName is immutable"
+	ExportName string `json:"exportName"`
+
+	// resources are the new resources of the APIExport. Resources that are not part of the
+	// APIExport yet are added, resources of the APIExport that are missing here are kept.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	// +listMapKey=group
+	Resources []ResourceSchema `json:"resources"`
+
+	// waves are the steps of the rollout. Every binding belongs to the first wave it matches.
+	// Bindings that match no wave are upgraded in a final wave after all others. Without waves,
+	// all bindings are upgraded at once.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Waves []APIDeploymentWave `json:"waves,omitempty"`
+
+	// paused stops the rollout from proceeding to the next wave. Bindings of the current wave
+	// are still upgraded.
+	//
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// APIDeploymentWave selects the bindings upgraded in one step of the rollout.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.partition) || has(self.selector)",message="either partition or selector must be set"
+type APIDeploymentWave struct {
+	// name is the name of the wave.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// partition is the name of a Partition in the workspace of the APIDeployment. The wave
+	// selects the bindings in workspaces scheduled to shards of the partition.
+	//
+	// +optional
+	Partition string `json:"partition,omitempty"`
+
+	// selector selects bindings by their labels. If partition is set too, bindings have to
+	// match both.
+	//
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// APIDeploymentPhaseType is the type of the current phase of an APIDeployment.
+type APIDeploymentPhaseType string
+
+const (
+	// APIDeploymentPhaseProgressing means that the bindings are being upgraded.
+	APIDeploymentPhaseProgressing APIDeploymentPhaseType = "Progressing"
+	// APIDeploymentPhaseCompleted means that all bindings are upgraded and the APIExport is updated.
+	APIDeploymentPhaseCompleted APIDeploymentPhaseType = "Completed"
+)
+
+// APIDeploymentBindingState is the state of a binding in the rollout.
+type APIDeploymentBindingState string
+
+const (
+	// APIDeploymentBindingPending means that the wave of the binding has not started yet.
+	APIDeploymentBindingPending APIDeploymentBindingState = "Pending"
+	// APIDeploymentBindingUpgrading means that the binding is being bound to the new resources.
+	APIDeploymentBindingUpgrading APIDeploymentBindingState = "Upgrading"
+	// APIDeploymentBindingUpgraded means that the binding is bound to the new resources.
+	APIDeploymentBindingUpgraded APIDeploymentBindingState = "Upgraded"
+	// APIDeploymentBindingFailed means that the binding could not be bound to the new resources.
+	APIDeploymentBindingFailed APIDeploymentBindingState = "Failed"
+)
+
+// APIDeploymentStatus communicates the observed state of the APIDeployment.
+type APIDeploymentStatus struct {
+	// phase is the current phase of the APIDeployment:
+	// - "": the rollout has not started yet.
+	// - Progressing: the bindings are being upgraded.
+	// - Completed: all bindings are upgraded and the APIExport is updated.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum="";Progressing;Completed
+	Phase APIDeploymentPhaseType `json:"phase,omitempty"`
+
+	// currentWave is the index of the wave being rolled out. The implicit final wave of the
+	// bindings that match no wave has the index len(spec.waves).
+	//
+	// +optional
+	CurrentWave int32 `json:"currentWave,omitempty"`
+
+	// bindings records the progress of every binding of the APIExport.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=cluster
+	// +listMapKey=name
+	Bindings []APIDeploymentBindingStatus `json:"bindings,omitempty"`
+
+	// conditions is a list of conditions that apply to the APIDeployment.
+	//
+	// +optional
+	Conditions conditionsv1alpha1.Conditions `json:"conditions,omitempty"`
+}
+
+// APIDeploymentBindingStatus records the progress of one binding.
+type APIDeploymentBindingStatus struct {
+	// cluster is the name of the logical cluster of the binding.
+	Cluster string `json:"cluster"`
+
+	// name is the name of the binding.
+	Name string `json:"name"`
+
+	// wave is the name of the wave the binding belongs to. It is empty for the final wave.
+	//
+	// +optional
+	Wave string `json:"wave,omitempty"`
+
+	// state is the state of the binding in the rollout.
+	//
+	// +kubebuilder:validation:Enum=Pending;Upgrading;Upgraded;Failed
+	State APIDeploymentBindingState `json:"state"`
+
+	// message explains the state, e.g. why a binding failed.
+	//
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// These are valid conditions of APIDeployment.
+const (
+	// APIDeploymentSchemasCompatible is a condition for APIDeployment that reflects whether the
+	// new resources are backwards compatible with those of the APIExport.
+	APIDeploymentSchemasCompatible conditionsv1alpha1.ConditionType = "SchemasCompatible"
+
+	// APIDeploymentIncompatibleSchemasReason is a reason for the SchemasCompatible condition that
+	// a new schema would break existing objects.
+	APIDeploymentIncompatibleSchemasReason = "IncompatibleSchemas"
+
+	// APIDeploymentReady is a condition for APIDeployment that reflects whether the referenced
+	// APIExport and Partitions exist, and whether the rollout can proceed.
+	APIDeploymentReady conditionsv1alpha1.ConditionType = "Ready"
+
+	// APIDeploymentExportNotFoundReason is a reason for the Ready condition that the APIExport is not found.
+	APIDeploymentExportNotFoundReason = "APIExportNotFound"
+	// APIDeploymentPartitionNotFoundReason is a reason for the Ready condition that a Partition is not found.
+	APIDeploymentPartitionNotFoundReason = "PartitionNotFound"
+	// APIDeploymentInvalidSelectorReason is a reason for the Ready condition that the selector of a wave
+	// or of its Partition is invalid.
+	APIDeploymentInvalidSelectorReason = "InvalidSelector"
+	// APIDeploymentWaitingReason is a reason for the Ready condition that an older APIDeployment of the
+	// same APIExport has not completed yet.
+	APIDeploymentWaitingReason = "WaitingForAPIDeployment"
+	// APIDeploymentSchemaNotFoundReason is a reason for the SchemasCompatible condition that an
+	// APIResourceSchema is not found.
+	APIDeploymentSchemaNotFoundReason = "APIResourceSchemaNotFound"
+)
+
+// APIDeploymentList is a list of APIDeployment resources
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type APIDeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []APIDeployment `json:"items"`
+}
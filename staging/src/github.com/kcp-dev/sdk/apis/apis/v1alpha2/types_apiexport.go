@@ -86,10 +86,10 @@ type APIExportSpec struct {
 	// APIExport.
 	//
 	// The schemas can be changed in the life-cycle of the APIExport. These changes
-	// have no effect on existing APIBindings, but only on newly bound ones.
+	// are applied to all APIBindings at once.
 	//
-	// For updating existing APIBindings, use an APIDeployment keeping bound
-	// workspaces up-to-date.
+	// For rolling out new schemas to existing APIBindings gradually, use an
+	// APIDeployment, which updates this field when the rollout is complete.
 	//
 	// +optional
 	// +listType=map
@@ -153,6 +153,111 @@ type APIExportSpec struct {
 	// +listMapKey=group
 	// +listMapKey=resource
 	PermissionClaims []PermissionClaim `json:"permissionClaims,omitempty"`
+
+	// dependencies are other APIExports that the APIs of this APIExport require, e.g.
+	// because their objects reference objects of those APIs.
+	//
+	// When a workspace binds to this APIExport, every dependency must be bound by an
+	// APIBinding in the workspace, too. These APIBindings are not created automatically.
+	// APIBindings that dependencies are bound through cannot be deleted while this
+	// APIExport is bound.
+	//
+	// +optional
+	// +listType=atomic
+	Dependencies []APIExportDependency `json:"dependencies,omitempty"`
+
+	// releases are named sets of resource schemas, e.g. "v1.2.0". APIBindings can pin a
+	// release, or follow a channel, instead of binding to the resources above.
+	//
+	// The resources above are still served in the virtual workspace of this APIExport,
+	// hence the schemas of a release should be compatible with them.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Releases []APIExportRelease `json:"releases,omitempty"`
+
+	// channels point at releases, e.g. "stable" and "beta". APIBindings following a channel
+	// move along with it according to their upgrade policy.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Channels []APIExportChannel `json:"channels,omitempty"`
+}
+
+// APIExportRelease is a named set of resource schemas of an APIExport.
+type APIExportRelease struct {
+	// name is the name of the release.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// resources are the APIResourceSchemas bound by APIBindings to this release.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	// +listMapKey=group
+	Resources []ResourceSchema `json:"resources"`
+}
+
+// APIExportChannel points at a release of an APIExport.
+type APIExportChannel struct {
+	// name is the name of the channel.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// release is the name of the release the channel points at.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Release string `json:"release"`
+}
+
+// FindRelease returns the release with the given name, or nil if there is none.
+func (in *APIExportSpec) FindRelease(name string) *APIExportRelease {
+	for i := range in.Releases {
+		if in.Releases[i].Name == name {
+			return &in.Releases[i]
+		}
+	}
+	return nil
+}
+
+// FindChannel returns the channel with the given name, or nil if there is none.
+func (in *APIExportSpec) FindChannel(name string) *APIExportChannel {
+	for i := range in.Channels {
+		if in.Channels[i].Name == name {
+			return &in.Channels[i]
+		}
+	}
+	return nil
+}
+
+// APIExportDependency references an APIExport that another APIExport depends on.
+type APIExportDependency struct {
+	// path is a logical cluster path where the APIExport is defined.
+	// If the path is unset, the logical cluster of the depending APIExport is used.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern:="^[a-z0-9]([-a-z0-9]*[a-z0-9])?(:[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$"
+	Path string `json:"path,omitempty"`
+
+	// name is the name of the APIExport.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kube:validation:MinLength=1
+	Name string `json:"name"`
 }
 
 // ResourceSchema defines the resource schemas that are exposed with this APIExport.
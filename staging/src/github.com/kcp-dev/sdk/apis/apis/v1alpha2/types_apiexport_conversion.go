@@ -30,6 +30,9 @@ const (
 	ResourceSchemasAnnotation          = "apis.v1alpha2.kcp.io/resource-schemas"
 	PermissionClaimsAnnotation         = "apis.v1alpha2.kcp.io/permission-claims"
 	PermissionClaimsV1Alpha1Annotation = "apis.v1alpha2.kcp.io/v1alpha1-permission-claims"
+	DependenciesAnnotation             = "apis.v1alpha2.kcp.io/dependencies"
+	ReleasesAnnotation                 = "apis.v1alpha2.kcp.io/releases"
+	ChannelsAnnotation                 = "apis.v1alpha2.kcp.io/channels"
 )
 
 // v1alpha2 -> v1alpha1 conversions.
@@ -69,6 +72,43 @@ func Convert_v1alpha2_APIExport_To_v1alpha1_APIExport(in *APIExport, out *apisv1
 		out.Annotations[PermissionClaimsAnnotation] = string(encoded)
 	}
 
+	// Dependencies do not exist in v1alpha1 and are retained via an annotation.
+	if len(in.Spec.Dependencies) > 0 {
+		encoded, err := json.Marshal(in.Spec.Dependencies)
+		if err != nil {
+			return fmt.Errorf("failed to encode dependencies as JSON: %w", err)
+		}
+
+		if out.Annotations == nil {
+			out.Annotations = map[string]string{}
+		}
+		out.Annotations[DependenciesAnnotation] = string(encoded)
+	}
+
+	// Releases and channels do not exist in v1alpha1 and are retained via annotations.
+	if len(in.Spec.Releases) > 0 {
+		encoded, err := json.Marshal(in.Spec.Releases)
+		if err != nil {
+			return fmt.Errorf("failed to encode releases as JSON: %w", err)
+		}
+
+		if out.Annotations == nil {
+			out.Annotations = map[string]string{}
+		}
+		out.Annotations[ReleasesAnnotation] = string(encoded)
+	}
+	if len(in.Spec.Channels) > 0 {
+		encoded, err := json.Marshal(in.Spec.Channels)
+		if err != nil {
+			return fmt.Errorf("failed to encode channels as JSON: %w", err)
+		}
+
+		if out.Annotations == nil {
+			out.Annotations = map[string]string{}
+		}
+		out.Annotations[ChannelsAnnotation] = string(encoded)
+	}
+
 	if err := Convert_v1alpha2_APIExportSpec_To_v1alpha1_APIExportSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
@@ -142,7 +182,7 @@ func Convert_v1alpha2_PermissionClaims_To_v1alpha1_PermissionClaims(in []Permiss
 }
 
 // Convert_v1alpha2_APIExportSpec_To_v1alpha1_APIExportSpec is *not* lossless, as it will drop all non-CRD
-// resource schemas and all non-wildcard PermissionClaims present in the APIExport's spec.
+// resource schemas, all non-wildcard PermissionClaims and all dependencies present in the APIExport's spec.
 // To have a full, lossless conversion, use Convert_v1alpha2_APIExport_To_v1alpha1_APIExport instead.
 func Convert_v1alpha2_APIExportSpec_To_v1alpha1_APIExportSpec(in *APIExportSpec, out *apisv1alpha1.APIExportSpec, s kubeconversion.Scope) error {
 	if in.Identity != nil {
@@ -261,6 +301,45 @@ func Convert_v1alpha1_APIExport_To_v1alpha2_APIExport(in *apisv1alpha1.APIExport
 		}
 	}
 
+	if dependencies, ok := in.Annotations[DependenciesAnnotation]; ok {
+		if err := json.Unmarshal([]byte(dependencies), &out.Spec.Dependencies); err != nil {
+			return fmt.Errorf("failed to decode dependencies from JSON: %w", err)
+		}
+
+		delete(out.Annotations, DependenciesAnnotation)
+
+		// Make tests for equality easier to write by turning []string into nil.
+		if len(out.Annotations) == 0 {
+			out.Annotations = nil
+		}
+	}
+
+	if releases, ok := in.Annotations[ReleasesAnnotation]; ok {
+		if err := json.Unmarshal([]byte(releases), &out.Spec.Releases); err != nil {
+			return fmt.Errorf("failed to decode releases from JSON: %w", err)
+		}
+
+		delete(out.Annotations, ReleasesAnnotation)
+
+		// Make tests for equality easier to write by turning []string into nil.
+		if len(out.Annotations) == 0 {
+			out.Annotations = nil
+		}
+	}
+
+	if channels, ok := in.Annotations[ChannelsAnnotation]; ok {
+		if err := json.Unmarshal([]byte(channels), &out.Spec.Channels); err != nil {
+			return fmt.Errorf("failed to decode channels from JSON: %w", err)
+		}
+
+		delete(out.Annotations, ChannelsAnnotation)
+
+		// Make tests for equality easier to write by turning []string into nil.
+		if len(out.Annotations) == 0 {
+			out.Annotations = nil
+		}
+	}
+
 	for i, opc := range out.Spec.PermissionClaims {
 		if len(opc.Verbs) == 0 {
 			out.Spec.PermissionClaims[i].Verbs = []string{"*"}
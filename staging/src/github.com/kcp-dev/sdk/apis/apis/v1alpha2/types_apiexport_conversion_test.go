@@ -33,6 +33,33 @@ func TestConvertV1Alpha2APIExports(t *testing.T) {
 		{
 			Spec: APIExportSpec{},
 		},
+		{
+			Spec: APIExportSpec{
+				Dependencies: []APIExportDependency{
+					{Name: "networking.example.io"},
+					{Path: "root:providers", Name: "storage.example.io"},
+				},
+			},
+		},
+		{
+			Spec: APIExportSpec{
+				Releases: []APIExportRelease{{
+					Name: "v1",
+					Resources: []ResourceSchema{{
+						Group:  "bar",
+						Name:   "foo",
+						Schema: "v1.foo.bar",
+						Storage: ResourceSchemaStorage{
+							CRD: &ResourceSchemaStorageCRD{},
+						},
+					}},
+				}},
+				Channels: []APIExportChannel{{
+					Name:    "stable",
+					Release: "v1",
+				}},
+			},
+		},
 		{
 			Spec: APIExportSpec{
 				Resources: []ResourceSchema{{
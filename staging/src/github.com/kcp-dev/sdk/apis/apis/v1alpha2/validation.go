@@ -18,7 +18,9 @@ package v1alpha2
 
 import (
 	"fmt"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -28,6 +30,8 @@ func ValidateAPIBinding(apiBinding *APIBinding) field.ErrorList {
 
 	allErrs = append(allErrs, ValidateAPIBindingReference(apiBinding.Spec.Reference, field.NewPath("spec", "reference"))...)
 	allErrs = append(allErrs, ValidateAPIBindingPermissionClaims(apiBinding.Spec.PermissionClaims, field.NewPath("spec", "permissionClaims"))...)
+	allErrs = append(allErrs, ValidateAPIBindingResourceRenames(apiBinding.Spec.ResourceRenames, field.NewPath("spec", "resourceRenames"))...)
+	allErrs = append(allErrs, ValidateAPIBindingResources(apiBinding.Spec.Resources, field.NewPath("spec", "resources"))...)
 
 	return allErrs
 }
@@ -47,6 +51,35 @@ func ValidateAPIBindingUpdate(oldBinding, newBinding *APIBinding) field.ErrorLis
 		)
 	}
 
+	// deselecting resources would orphan the objects of bound resources
+	resourcesPath := field.NewPath("spec", "resources")
+	if len(oldBinding.Spec.Resources) == 0 && len(newBinding.Spec.Resources) > 0 {
+		allErrs = append(allErrs, field.Forbidden(resourcesPath, "resources cannot be restricted once all resources are selected"))
+	}
+	for _, gr := range oldBinding.Spec.Resources {
+		if !newBinding.Spec.SelectsResource(gr.Group, gr.Resource) {
+			allErrs = append(allErrs, field.Forbidden(resourcesPath, fmt.Sprintf("resource %q of group %q cannot be removed", gr.Resource, gr.Group)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateAPIBindingResources validates an APIBinding's Resources.
+func ValidateAPIBindingResources(resources []GroupResource, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := map[GroupResource]bool{}
+	for i, gr := range resources {
+		if gr.Resource == "" {
+			allErrs = append(allErrs, field.Required(path.Index(i).Child("resource"), ""))
+		}
+		if seen[gr] {
+			allErrs = append(allErrs, field.Duplicate(path.Index(i), gr))
+		}
+		seen[gr] = true
+	}
+
 	return allErrs
 }
 
@@ -56,8 +89,16 @@ func ValidateAPIBindingReference(reference BindingReference, path *field.Path) f
 
 	if reference.Export == nil {
 		allErrs = append(allErrs, field.Required(path.Child("export"), ""))
-	} else if reference.Export.Name == "" {
-		allErrs = append(allErrs, field.Required(path.Child("export").Child("name"), ""))
+	} else {
+		if reference.Export.Name == "" {
+			allErrs = append(allErrs, field.Required(path.Child("export").Child("name"), ""))
+		}
+		if reference.Export.Release != "" && reference.Export.Channel != "" {
+			allErrs = append(allErrs, field.Invalid(path.Child("export").Child("channel"), reference.Export.Channel, "release and channel are mutually exclusive"))
+		}
+		if reference.Export.UpgradePolicy != "" && reference.Export.Channel == "" {
+			allErrs = append(allErrs, field.Invalid(path.Child("export").Child("upgradePolicy"), reference.Export.UpgradePolicy, "upgradePolicy requires channel"))
+		}
 	}
 
 	return allErrs
@@ -77,9 +118,67 @@ func ValidateAPIBindingPermissionClaims(permissionClaims []AcceptablePermissionC
 			if len(permissionClaims[i].Selector.MatchExpressions) > 0 {
 				allErrs = append(allErrs, field.Invalid(claimPath.Child("selector").Child("matchExpressions"), permissionClaims[i].Selector, "matchExpressions cannot be used with matchAll"))
 			}
-		} else if len(permissionClaims[i].Selector.MatchLabels) == 0 && len(permissionClaims[i].Selector.MatchExpressions) == 0 {
-			allErrs = append(allErrs, field.Required(claimPath.Child("selector"), "either one of matchAll, matchLabels, or matchExpressions must be set"))
+			if len(permissionClaims[i].Selector.Namespaces) > 0 {
+				allErrs = append(allErrs, field.Invalid(claimPath.Child("selector").Child("namespaces"), permissionClaims[i].Selector, "namespaces cannot be used with matchAll"))
+			}
+			if len(permissionClaims[i].Selector.Names) > 0 {
+				allErrs = append(allErrs, field.Invalid(claimPath.Child("selector").Child("names"), permissionClaims[i].Selector, "names cannot be used with matchAll"))
+			}
+		} else if permissionClaims[i].Selector.IsEmpty() {
+			allErrs = append(allErrs, field.Required(claimPath.Child("selector"), "either one of matchAll, matchLabels, matchExpressions, namespaces, or names must be set"))
+		}
+
+		for j, namespace := range permissionClaims[i].Selector.Namespaces {
+			for _, msg := range validation.ValidateNamespaceName(namespace, false) {
+				allErrs = append(allErrs, field.Invalid(claimPath.Child("selector").Child("namespaces").Index(j), namespace, msg))
+			}
+		}
+		for j, name := range permissionClaims[i].Selector.Names {
+			if name == "" {
+				allErrs = append(allErrs, field.Required(claimPath.Child("selector").Child("names").Index(j), ""))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// reservedRenameGroupSuffixes are the group suffixes resources cannot be renamed into.
+var reservedRenameGroupSuffixes = []string{"k8s.io", "kubernetes.io", "kcp.io"}
+
+// ValidateAPIBindingResourceRenames validates an APIBinding's ResourceRenames.
+func ValidateAPIBindingResourceRenames(renames []ResourceRename, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := map[GroupResource]bool{}
+	for i, rename := range renames {
+		renamePath := path.Index(i)
+
+		if rename.Resource == "" {
+			allErrs = append(allErrs, field.Required(renamePath.Child("resource"), ""))
+		}
+		if rename.As.Resource == "" {
+			allErrs = append(allErrs, field.Required(renamePath.Child("as").Child("resource"), ""))
+		}
+		switch {
+		case rename.As.Group == "":
+			allErrs = append(allErrs, field.Invalid(renamePath.Child("as").Child("group"), rename.As.Group, "resources cannot be renamed into the core group"))
+		case !strings.Contains(rename.As.Group, "."):
+			allErrs = append(allErrs, field.Invalid(renamePath.Child("as").Child("group"), rename.As.Group, "must be a fully qualified group name"))
+		default:
+			for _, suffix := range reservedRenameGroupSuffixes {
+				if rename.As.Group == suffix || strings.HasSuffix(rename.As.Group, "."+suffix) {
+					allErrs = append(allErrs, field.Invalid(renamePath.Child("as").Child("group"), rename.As.Group, fmt.Sprintf("groups ending in %q are reserved", suffix)))
+				}
+			}
+		}
+		if rename.As == rename.GroupResource {
+			allErrs = append(allErrs, field.Invalid(renamePath.Child("as"), rename.As, "must differ from the exported group and resource"))
+		}
+		if seen[rename.As] {
+			allErrs = append(allErrs, field.Duplicate(renamePath.Child("as"), rename.As))
 		}
+		seen[rename.As] = true
 	}
 
 	return allErrs
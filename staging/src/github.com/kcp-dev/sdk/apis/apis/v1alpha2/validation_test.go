@@ -78,6 +78,69 @@ func TestValidateAPIBindingPermissionClaims(t *testing.T) {
 			},
 			wantErrs: nil,
 		},
+		"namespaces and names": {
+			permissionClaims: []AcceptablePermissionClaim{
+				{
+					ScopedPermissionClaim: ScopedPermissionClaim{
+						Selector: PermissionClaimSelector{
+							Namespaces: []string{"default"},
+							Names:      []string{"test"},
+						},
+					},
+				},
+			},
+			wantErrs: nil,
+		},
+		"matchLabels+namespaces": {
+			permissionClaims: []AcceptablePermissionClaim{
+				{
+					ScopedPermissionClaim: ScopedPermissionClaim{
+						Selector: PermissionClaimSelector{
+							LabelSelector: metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"test": "test",
+								},
+							},
+							Namespaces: []string{"default"},
+						},
+					},
+				},
+			},
+			wantErrs: nil,
+		},
+		"matchAll+namespaces+names": {
+			permissionClaims: []AcceptablePermissionClaim{
+				{
+					ScopedPermissionClaim: ScopedPermissionClaim{
+						Selector: PermissionClaimSelector{
+							MatchAll:   true,
+							Namespaces: []string{"default"},
+							Names:      []string{"test"},
+						},
+					},
+				},
+			},
+			wantErrs: []string{
+				"spec.permissionClaims[0].selector.namespaces: Invalid value: {\"matchAll\":true,\"namespaces\":[\"default\"],\"names\":[\"test\"]}: namespaces cannot be used with matchAll",
+				"spec.permissionClaims[0].selector.names: Invalid value: {\"matchAll\":true,\"namespaces\":[\"default\"],\"names\":[\"test\"]}: names cannot be used with matchAll",
+			},
+		},
+		"invalid namespaces and names": {
+			permissionClaims: []AcceptablePermissionClaim{
+				{
+					ScopedPermissionClaim: ScopedPermissionClaim{
+						Selector: PermissionClaimSelector{
+							Namespaces: []string{"Not_A_Namespace"},
+							Names:      []string{""},
+						},
+					},
+				},
+			},
+			wantErrs: []string{
+				"spec.permissionClaims[0].selector.namespaces[0]: Invalid value: \"Not_A_Namespace\": a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')",
+				"spec.permissionClaims[0].selector.names[0]: Required value",
+			},
+		},
 		"none": {
 			permissionClaims: []AcceptablePermissionClaim{
 				{
@@ -86,7 +149,7 @@ func TestValidateAPIBindingPermissionClaims(t *testing.T) {
 					},
 				},
 			},
-			wantErrs: []string{"spec.permissionClaims[0].selector: Required value: either one of matchAll, matchLabels, or matchExpressions must be set"},
+			wantErrs: []string{"spec.permissionClaims[0].selector: Required value: either one of matchAll, matchLabels, matchExpressions, namespaces, or names must be set"},
 		},
 		"empty": {
 			permissionClaims: []AcceptablePermissionClaim{
@@ -102,7 +165,7 @@ func TestValidateAPIBindingPermissionClaims(t *testing.T) {
 					},
 				},
 			},
-			wantErrs: []string{"spec.permissionClaims[0].selector: Required value: either one of matchAll, matchLabels, or matchExpressions must be set"},
+			wantErrs: []string{"spec.permissionClaims[0].selector: Required value: either one of matchAll, matchLabels, matchExpressions, namespaces, or names must be set"},
 		},
 		"matchAll+matchLabels+matchExpressions": {
 			permissionClaims: []AcceptablePermissionClaim{
@@ -182,3 +245,209 @@ func TestValidateAPIBindingPermissionClaims(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAPIBindingReference(t *testing.T) {
+	tests := map[string]struct {
+		reference BindingReference
+		wantErrs  []string
+	}{
+		"export": {
+			reference: BindingReference{Export: &ExportBindingReference{Name: "foo"}},
+		},
+		"missing export": {
+			reference: BindingReference{},
+			wantErrs:  []string{"spec.reference.export: Required value"},
+		},
+		"missing name": {
+			reference: BindingReference{Export: &ExportBindingReference{Release: "v1"}},
+			wantErrs:  []string{"spec.reference.export.name: Required value"},
+		},
+		"channel with upgrade policy": {
+			reference: BindingReference{Export: &ExportBindingReference{Name: "foo", Channel: "stable", UpgradePolicy: ReleaseUpgradePolicyCompatible}},
+		},
+		"release and channel": {
+			reference: BindingReference{Export: &ExportBindingReference{Name: "foo", Release: "v1", Channel: "stable"}},
+			wantErrs:  []string{`spec.reference.export.channel: Invalid value: "stable": release and channel are mutually exclusive`},
+		},
+		"upgrade policy without channel": {
+			reference: BindingReference{Export: &ExportBindingReference{Name: "foo", UpgradePolicy: ReleaseUpgradePolicyAutomatic}},
+			wantErrs:  []string{`spec.reference.export.upgradePolicy: Invalid value: "Automatic": upgradePolicy requires channel`},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := ValidateAPIBindingReference(tc.reference, field.NewPath("spec", "reference"))
+
+			errs := []string{}
+			for _, err := range got {
+				errs = append(errs, err.Error())
+			}
+			if len(tc.wantErrs) == 0 {
+				tc.wantErrs = []string{}
+			}
+
+			if !equality.Semantic.DeepEqual(errs, tc.wantErrs) {
+				t.Errorf("ValidateAPIBindingReference() = %v, want %v", errs, tc.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateAPIBindingResourceRenames(t *testing.T) {
+	widgets := GroupResource{Group: "example.io", Resource: "widgets"}
+
+	tests := map[string]struct {
+		renames  []ResourceRename
+		wantErrs []string
+	}{
+		"no renames": {},
+		"renamed group": {
+			renames: []ResourceRename{{GroupResource: widgets, As: GroupResource{Group: "acme.example.com", Resource: "widgets"}}},
+		},
+		"renamed resource": {
+			renames: []ResourceRename{{GroupResource: widgets, As: GroupResource{Group: "example.io", Resource: "acmewidgets"}}},
+		},
+		"missing as resource": {
+			renames:  []ResourceRename{{GroupResource: widgets, As: GroupResource{Group: "acme.example.com"}}},
+			wantErrs: []string{"spec.resourceRenames[0].as.resource: Required value"},
+		},
+		"core group": {
+			renames:  []ResourceRename{{GroupResource: widgets, As: GroupResource{Resource: "widgets"}}},
+			wantErrs: []string{`spec.resourceRenames[0].as.group: Invalid value: "": resources cannot be renamed into the core group`},
+		},
+		"unqualified group": {
+			renames:  []ResourceRename{{GroupResource: widgets, As: GroupResource{Group: "acme", Resource: "widgets"}}},
+			wantErrs: []string{`spec.resourceRenames[0].as.group: Invalid value: "acme": must be a fully qualified group name`},
+		},
+		"reserved group": {
+			renames:  []ResourceRename{{GroupResource: widgets, As: GroupResource{Group: "apps.kcp.io", Resource: "widgets"}}},
+			wantErrs: []string{`spec.resourceRenames[0].as.group: Invalid value: "apps.kcp.io": groups ending in "kcp.io" are reserved`},
+		},
+		"unchanged": {
+			renames:  []ResourceRename{{GroupResource: widgets, As: widgets}},
+			wantErrs: []string{`spec.resourceRenames[0].as: Invalid value: {"group":"example.io","resource":"widgets"}: must differ from the exported group and resource`},
+		},
+		"duplicate as": {
+			renames: []ResourceRename{
+				{GroupResource: widgets, As: GroupResource{Group: "acme.example.com", Resource: "widgets"}},
+				{GroupResource: GroupResource{Group: "example.io", Resource: "gadgets"}, As: GroupResource{Group: "acme.example.com", Resource: "widgets"}},
+			},
+			wantErrs: []string{`spec.resourceRenames[1].as: Duplicate value: {"group":"acme.example.com","resource":"widgets"}`},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := ValidateAPIBindingResourceRenames(tc.renames, field.NewPath("spec", "resourceRenames"))
+
+			errs := []string{}
+			for _, err := range got {
+				errs = append(errs, err.Error())
+			}
+			if len(tc.wantErrs) == 0 {
+				tc.wantErrs = []string{}
+			}
+
+			if !equality.Semantic.DeepEqual(errs, tc.wantErrs) {
+				t.Errorf("ValidateAPIBindingResourceRenames() = %v, want %v", errs, tc.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateAPIBindingResources(t *testing.T) {
+	widgets := GroupResource{Group: "example.io", Resource: "widgets"}
+
+	tests := map[string]struct {
+		resources []GroupResource
+		wantErrs  []string
+	}{
+		"no resources": {},
+		"selected resources": {
+			resources: []GroupResource{widgets, {Group: "example.io", Resource: "gadgets"}},
+		},
+		"missing resource": {
+			resources: []GroupResource{{Group: "example.io"}},
+			wantErrs:  []string{"spec.resources[0].resource: Required value"},
+		},
+		"duplicate resource": {
+			resources: []GroupResource{widgets, widgets},
+			wantErrs:  []string{`spec.resources[1]: Duplicate value: {"group":"example.io","resource":"widgets"}`},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := ValidateAPIBindingResources(tc.resources, field.NewPath("spec", "resources"))
+
+			errs := []string{}
+			for _, err := range got {
+				errs = append(errs, err.Error())
+			}
+			if len(tc.wantErrs) == 0 {
+				tc.wantErrs = []string{}
+			}
+
+			if !equality.Semantic.DeepEqual(errs, tc.wantErrs) {
+				t.Errorf("ValidateAPIBindingResources() = %v, want %v", errs, tc.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateAPIBindingUpdateResources(t *testing.T) {
+	widgets := GroupResource{Group: "example.io", Resource: "widgets"}
+	gadgets := GroupResource{Group: "example.io", Resource: "gadgets"}
+
+	tests := map[string]struct {
+		oldResources []GroupResource
+		newResources []GroupResource
+		wantErrs     []string
+	}{
+		"all resources": {},
+		"unchanged": {
+			oldResources: []GroupResource{widgets},
+			newResources: []GroupResource{widgets},
+		},
+		"added resource": {
+			oldResources: []GroupResource{widgets},
+			newResources: []GroupResource{widgets, gadgets},
+		},
+		"selected all resources": {
+			oldResources: []GroupResource{widgets},
+		},
+		"removed resource": {
+			oldResources: []GroupResource{widgets, gadgets},
+			newResources: []GroupResource{widgets},
+			wantErrs:     []string{`spec.resources: Forbidden: resource "gadgets" of group "example.io" cannot be removed`},
+		},
+		"restricted all resources": {
+			newResources: []GroupResource{widgets},
+			wantErrs:     []string{"spec.resources: Forbidden: resources cannot be restricted once all resources are selected"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			oldBinding := &APIBinding{Spec: APIBindingSpec{
+				Reference: BindingReference{Export: &ExportBindingReference{Name: "widgets"}},
+				Resources: tc.oldResources,
+			}}
+			newBinding := oldBinding.DeepCopy()
+			newBinding.Spec.Resources = tc.newResources
+
+			errs := []string{}
+			for _, err := range ValidateAPIBindingUpdate(oldBinding, newBinding) {
+				errs = append(errs, err.Error())
+			}
+			if len(tc.wantErrs) == 0 {
+				tc.wantErrs = []string{}
+			}
+
+			if !equality.Semantic.DeepEqual(errs, tc.wantErrs) {
+				t.Errorf("ValidateAPIBindingUpdate() = %v, want %v", errs, tc.wantErrs)
+			}
+		})
+	}
+}
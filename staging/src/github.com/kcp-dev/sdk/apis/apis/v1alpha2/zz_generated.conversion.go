@@ -49,21 +49,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*APIBindingSpec)(nil), (*v1alpha1.APIBindingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_APIBindingSpec_To_v1alpha1_APIBindingSpec(a.(*APIBindingSpec), b.(*v1alpha1.APIBindingSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIBindingSpec)(nil), (*APIBindingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_APIBindingSpec_To_v1alpha2_APIBindingSpec(a.(*v1alpha1.APIBindingSpec), b.(*APIBindingSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*APIBindingStatus)(nil), (*v1alpha1.APIBindingStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_APIBindingStatus_To_v1alpha1_APIBindingStatus(a.(*APIBindingStatus), b.(*v1alpha1.APIBindingStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha1.APIBindingStatus)(nil), (*APIBindingStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_APIBindingStatus_To_v1alpha2_APIBindingStatus(a.(*v1alpha1.APIBindingStatus), b.(*APIBindingStatus), scope)
 	}); err != nil {
@@ -99,11 +89,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*BoundAPIResource)(nil), (*v1alpha1.BoundAPIResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_BoundAPIResource_To_v1alpha1_BoundAPIResource(a.(*BoundAPIResource), b.(*v1alpha1.BoundAPIResource), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha1.BoundAPIResource)(nil), (*BoundAPIResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BoundAPIResource_To_v1alpha2_BoundAPIResource(a.(*v1alpha1.BoundAPIResource), b.(*BoundAPIResource), scope)
 	}); err != nil {
@@ -119,11 +104,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ExportBindingReference)(nil), (*v1alpha1.ExportBindingReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ExportBindingReference_To_v1alpha1_ExportBindingReference(a.(*ExportBindingReference), b.(*v1alpha1.ExportBindingReference), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha1.ExportBindingReference)(nil), (*ExportBindingReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ExportBindingReference_To_v1alpha2_ExportBindingReference(a.(*v1alpha1.ExportBindingReference), b.(*ExportBindingReference), scope)
 	}); err != nil {
@@ -219,6 +199,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*APIBindingSpec)(nil), (*v1alpha1.APIBindingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_APIBindingSpec_To_v1alpha1_APIBindingSpec(a.(*APIBindingSpec), b.(*v1alpha1.APIBindingSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*APIBindingStatus)(nil), (*v1alpha1.APIBindingStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_APIBindingStatus_To_v1alpha1_APIBindingStatus(a.(*APIBindingStatus), b.(*v1alpha1.APIBindingStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*APIBinding)(nil), (*v1alpha1.APIBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_APIBinding_To_v1alpha1_APIBinding(a.(*APIBinding), b.(*v1alpha1.APIBinding), scope)
 	}); err != nil {
@@ -239,6 +229,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*BoundAPIResource)(nil), (*v1alpha1.BoundAPIResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_BoundAPIResource_To_v1alpha1_BoundAPIResource(a.(*BoundAPIResource), b.(*v1alpha1.BoundAPIResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*ExportBindingReference)(nil), (*v1alpha1.ExportBindingReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ExportBindingReference_To_v1alpha1_ExportBindingReference(a.(*ExportBindingReference), b.(*v1alpha1.ExportBindingReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*PermissionClaim)(nil), (*v1alpha1.PermissionClaim)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_PermissionClaim_To_v1alpha1_PermissionClaim(a.(*PermissionClaim), b.(*v1alpha1.PermissionClaim), scope)
 	}); err != nil {
@@ -331,14 +331,11 @@ func autoConvert_v1alpha2_APIBindingSpec_To_v1alpha1_APIBindingSpec(in *APIBindi
 	} else {
 		out.PermissionClaims = nil
 	}
+	// WARNING: in.ResourceRenames requires manual conversion: does not exist in peer-type
+	// WARNING: in.Resources requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha2_APIBindingSpec_To_v1alpha1_APIBindingSpec is an autogenerated conversion function.
-func Convert_v1alpha2_APIBindingSpec_To_v1alpha1_APIBindingSpec(in *APIBindingSpec, out *v1alpha1.APIBindingSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha2_APIBindingSpec_To_v1alpha1_APIBindingSpec(in, out, s)
-}
-
 func autoConvert_v1alpha1_APIBindingSpec_To_v1alpha2_APIBindingSpec(in *v1alpha1.APIBindingSpec, out *APIBindingSpec, s conversion.Scope) error {
 	if err := Convert_v1alpha1_BindingReference_To_v1alpha2_BindingReference(&in.Reference, &out.Reference, s); err != nil {
 		return err
@@ -364,7 +361,18 @@ func Convert_v1alpha1_APIBindingSpec_To_v1alpha2_APIBindingSpec(in *v1alpha1.API
 
 func autoConvert_v1alpha2_APIBindingStatus_To_v1alpha1_APIBindingStatus(in *APIBindingStatus, out *v1alpha1.APIBindingStatus, s conversion.Scope) error {
 	out.APIExportClusterName = in.APIExportClusterName
-	out.BoundResources = *(*[]v1alpha1.BoundAPIResource)(unsafe.Pointer(&in.BoundResources))
+	// WARNING: in.Release requires manual conversion: does not exist in peer-type
+	if in.BoundResources != nil {
+		in, out := &in.BoundResources, &out.BoundResources
+		*out = make([]v1alpha1.BoundAPIResource, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_BoundAPIResource_To_v1alpha1_BoundAPIResource(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.BoundResources = nil
+	}
 	out.Phase = v1alpha1.APIBindingPhaseType(in.Phase)
 	out.Conditions = *(*conditionsv1alpha1.Conditions)(unsafe.Pointer(&in.Conditions))
 	if in.AppliedPermissionClaims != nil {
@@ -389,17 +397,23 @@ func autoConvert_v1alpha2_APIBindingStatus_To_v1alpha1_APIBindingStatus(in *APIB
 	} else {
 		out.ExportPermissionClaims = nil
 	}
+	// WARNING: in.Dependencies requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha2_APIBindingStatus_To_v1alpha1_APIBindingStatus is an autogenerated conversion function.
-func Convert_v1alpha2_APIBindingStatus_To_v1alpha1_APIBindingStatus(in *APIBindingStatus, out *v1alpha1.APIBindingStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha2_APIBindingStatus_To_v1alpha1_APIBindingStatus(in, out, s)
-}
-
 func autoConvert_v1alpha1_APIBindingStatus_To_v1alpha2_APIBindingStatus(in *v1alpha1.APIBindingStatus, out *APIBindingStatus, s conversion.Scope) error {
 	out.APIExportClusterName = in.APIExportClusterName
-	out.BoundResources = *(*[]BoundAPIResource)(unsafe.Pointer(&in.BoundResources))
+	if in.BoundResources != nil {
+		in, out := &in.BoundResources, &out.BoundResources
+		*out = make([]BoundAPIResource, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_BoundAPIResource_To_v1alpha2_BoundAPIResource(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.BoundResources = nil
+	}
 	out.Phase = APIBindingPhaseType(in.Phase)
 	out.Conditions = *(*conditionsv1alpha1.Conditions)(unsafe.Pointer(&in.Conditions))
 	if in.AppliedPermissionClaims != nil {
@@ -511,6 +525,9 @@ func autoConvert_v1alpha2_APIExportSpec_To_v1alpha1_APIExportSpec(in *APIExportS
 	} else {
 		out.PermissionClaims = nil
 	}
+	// WARNING: in.Dependencies requires manual conversion: does not exist in peer-type
+	// WARNING: in.Releases requires manual conversion: does not exist in peer-type
+	// WARNING: in.Channels requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -559,6 +576,7 @@ func Convert_v1alpha1_APIExportStatus_To_v1alpha2_APIExportStatus(in *v1alpha1.A
 func autoConvert_v1alpha2_AcceptablePermissionClaim_To_v1alpha1_AcceptablePermissionClaim(in *AcceptablePermissionClaim, out *v1alpha1.AcceptablePermissionClaim, s conversion.Scope) error {
 	// WARNING: in.ScopedPermissionClaim requires manual conversion: does not exist in peer-type
 	out.State = v1alpha1.AcceptablePermissionClaimState(in.State)
+	// WARNING: in.ExpiresAt requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -569,7 +587,15 @@ func autoConvert_v1alpha1_AcceptablePermissionClaim_To_v1alpha2_AcceptablePermis
 }
 
 func autoConvert_v1alpha2_BindingReference_To_v1alpha1_BindingReference(in *BindingReference, out *v1alpha1.BindingReference, s conversion.Scope) error {
-	out.Export = (*v1alpha1.ExportBindingReference)(unsafe.Pointer(in.Export))
+	if in.Export != nil {
+		in, out := &in.Export, &out.Export
+		*out = new(v1alpha1.ExportBindingReference)
+		if err := Convert_v1alpha2_ExportBindingReference_To_v1alpha1_ExportBindingReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Export = nil
+	}
 	return nil
 }
 
@@ -579,7 +605,15 @@ func Convert_v1alpha2_BindingReference_To_v1alpha1_BindingReference(in *BindingR
 }
 
 func autoConvert_v1alpha1_BindingReference_To_v1alpha2_BindingReference(in *v1alpha1.BindingReference, out *BindingReference, s conversion.Scope) error {
-	out.Export = (*ExportBindingReference)(unsafe.Pointer(in.Export))
+	if in.Export != nil {
+		in, out := &in.Export, &out.Export
+		*out = new(ExportBindingReference)
+		if err := Convert_v1alpha1_ExportBindingReference_To_v1alpha2_ExportBindingReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Export = nil
+	}
 	return nil
 }
 
@@ -595,14 +629,10 @@ func autoConvert_v1alpha2_BoundAPIResource_To_v1alpha1_BoundAPIResource(in *Boun
 		return err
 	}
 	out.StorageVersions = *(*[]string)(unsafe.Pointer(&in.StorageVersions))
+	// WARNING: in.ServedAs requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha2_BoundAPIResource_To_v1alpha1_BoundAPIResource is an autogenerated conversion function.
-func Convert_v1alpha2_BoundAPIResource_To_v1alpha1_BoundAPIResource(in *BoundAPIResource, out *v1alpha1.BoundAPIResource, s conversion.Scope) error {
-	return autoConvert_v1alpha2_BoundAPIResource_To_v1alpha1_BoundAPIResource(in, out, s)
-}
-
 func autoConvert_v1alpha1_BoundAPIResource_To_v1alpha2_BoundAPIResource(in *v1alpha1.BoundAPIResource, out *BoundAPIResource, s conversion.Scope) error {
 	out.Group = in.Group
 	out.Resource = in.Resource
@@ -645,14 +675,12 @@ func Convert_v1alpha1_BoundAPIResourceSchema_To_v1alpha2_BoundAPIResourceSchema(
 func autoConvert_v1alpha2_ExportBindingReference_To_v1alpha1_ExportBindingReference(in *ExportBindingReference, out *v1alpha1.ExportBindingReference, s conversion.Scope) error {
 	out.Path = in.Path
 	out.Name = in.Name
+	// WARNING: in.Release requires manual conversion: does not exist in peer-type
+	// WARNING: in.Channel requires manual conversion: does not exist in peer-type
+	// WARNING: in.UpgradePolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha2_ExportBindingReference_To_v1alpha1_ExportBindingReference is an autogenerated conversion function.
-func Convert_v1alpha2_ExportBindingReference_To_v1alpha1_ExportBindingReference(in *ExportBindingReference, out *v1alpha1.ExportBindingReference, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ExportBindingReference_To_v1alpha1_ExportBindingReference(in, out, s)
-}
-
 func autoConvert_v1alpha1_ExportBindingReference_To_v1alpha2_ExportBindingReference(in *v1alpha1.ExportBindingReference, out *ExportBindingReference, s conversion.Scope) error {
 	out.Path = in.Path
 	out.Name = in.Name
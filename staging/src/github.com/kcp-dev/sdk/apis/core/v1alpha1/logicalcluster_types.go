@@ -59,7 +59,7 @@ const (
 
 // LogicalClusterPhaseType is the type of the current phase of the logical cluster.
 //
-// +kubebuilder:validation:Enum=Scheduling;Initializing;Ready;Unavailable
+// +kubebuilder:validation:Enum=Scheduling;Initializing;Ready;Unavailable;Migrating;Hibernated
 type LogicalClusterPhaseType string
 
 const (
@@ -72,7 +72,29 @@ const (
 	// This should be used when we really can't serve the logical cluster content and not some
 	// temporary flakes, like readiness probe failing.
 	LogicalClusterPhaseUnavailable LogicalClusterPhaseType = "Unavailable"
-	LogicalClusterPhaseDeleting    LogicalClusterPhaseType = "Deleting"
+	// LogicalClusterPhaseMigrating phase is used to indicate that the logical cluster is being moved
+	// to another shard. The logical cluster is read-only for users while in this state, and it is
+	// served from the old shard until the migration completes.
+	// Possible state transitions are from Ready to Migrating and from Migrating to Ready.
+	LogicalClusterPhaseMigrating LogicalClusterPhaseType = "Migrating"
+	// LogicalClusterPhaseHibernated phase is used to indicate that the logical cluster is suspended
+	// according to spec.hibernation. Per-cluster controllers are stopped, and user access is
+	// restricted to reading or denied completely.
+	// Possible state transitions are from Ready to Hibernated and from Hibernated to Ready.
+	LogicalClusterPhaseHibernated LogicalClusterPhaseType = "Hibernated"
+	LogicalClusterPhaseDeleting   LogicalClusterPhaseType = "Deleting"
+)
+
+// LogicalClusterHibernationMode describes how a hibernated logical cluster can be accessed.
+//
+// +kubebuilder:validation:Enum=ReadOnly;Denied
+type LogicalClusterHibernationMode string
+
+const (
+	// LogicalClusterHibernationModeReadOnly permits users to read, but not to change objects.
+	LogicalClusterHibernationModeReadOnly LogicalClusterHibernationMode = "ReadOnly"
+	// LogicalClusterHibernationModeDenied denies all user access.
+	LogicalClusterHibernationModeDenied LogicalClusterHibernationMode = "Denied"
 )
 
 // LogicalClusterInitializer is a unique string corresponding to a logical cluster
@@ -117,6 +139,12 @@ type LogicalClusterSpec struct {
 	//
 	// +optional
 	Terminators []LogicalClusterTerminator `json:"terminators,omitempty"`
+
+	// hibernation suspends the logical cluster when set. It is set by the system from
+	// the spec.hibernation field of the owning Workspace.
+	//
+	// +optional
+	Hibernation LogicalClusterHibernationMode `json:"hibernation,omitempty"`
 }
 
 // LogicalClusterOwner is a reference to a resource controlling the life-cycle of a LogicalCluster.
@@ -27,6 +27,10 @@ import (
 // RootShard holds a name of the root shard.
 var RootShard = "root"
 
+// ShardResourceLogicalClusters is the name of the shard capacity resource counting
+// the logical clusters that live on a shard.
+const ShardResourceLogicalClusters corev1.ResourceName = "logicalclusters"
+
 // Shard describes a kcp instance on which a number of logical clusters will live
 //
 // +crd
@@ -38,6 +42,7 @@ var RootShard = "root"
 // +kubebuilder:printcolumn:name="Region",type=string,JSONPath=`.metadata.labels['region']`,description="The region this workspace is in"
 // +kubebuilder:printcolumn:name="URL",type=string,JSONPath=`.spec.baseURL`,description="Type URL to directly connect to the shard"
 // +kubebuilder:printcolumn:name="External URL",type=string,JSONPath=`.spec.externalURL`,description="The URL exposed in logical clusters created on that shard"
+// +kubebuilder:printcolumn:name="Unschedulable",type=boolean,JSONPath=`.spec.unschedulable`,description="Whether new workspaces are kept away from the shard"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 type Shard struct {
 	v1.TypeMeta `json:",inline"`
@@ -102,6 +107,20 @@ type ShardSpec struct {
 	// +kubebuilder:validation:Format=uri
 	// +kubebuilder:validation:MinLength=1
 	VirtualWorkspaceURL string `json:"virtualWorkspaceURL,omitempty"`
+
+	// unschedulable prevents new workspaces from being scheduled onto this shard,
+	// i.e. the shard is cordoned. Logical clusters that live on the shard already
+	// are not affected.
+	//
+	// +optional
+	Unschedulable bool `json:"unschedulable,omitempty"`
+
+	// drain moves the logical clusters of all workspaces on this shard to other
+	// schedulable shards. A draining shard is unschedulable. The progress is
+	// reported in the Drained condition.
+	//
+	// +optional
+	Drain bool `json:"drain,omitempty"`
 }
 
 // ShardStatus communicates the observed state of the Shard.
@@ -110,11 +129,29 @@ type ShardStatus struct {
 	// +optional
 	Capacity corev1.ResourceList `json:"capacity,omitempty"`
 
+	// allocated is the set of integer resources currently consumed by logical clusters
+	// on this shard. It is reported by the shard itself, and compared against capacity
+	// when scheduling new logical clusters.
+	//
+	// +optional
+	Allocated corev1.ResourceList `json:"allocated,omitempty"`
+
 	// Current processing state of the Shard.
 	// +optional
 	Conditions v1alpha1.Conditions `json:"conditions,omitempty"`
 }
 
+// These are valid conditions of Shard.
+const (
+	// ShardDrained means that no logical clusters of workspaces are left on a draining shard.
+	// The condition is only set while spec.drain is true.
+	ShardDrained v1alpha1.ConditionType = "Drained"
+
+	// ShardDraining is the reason for the Drained condition while logical clusters are
+	// still being moved away from the shard.
+	ShardDraining = "Draining"
+)
+
 // ShardList is a list of shard instances
 //
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
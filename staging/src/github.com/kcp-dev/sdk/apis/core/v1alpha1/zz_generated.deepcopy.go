@@ -256,6 +256,13 @@ func (in *ShardStatus) DeepCopyInto(out *ShardStatus) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.Allocated != nil {
+		in, out := &in.Allocated, &out.Allocated
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(conditionsv1alpha1.Conditions, len(*in))
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -71,6 +72,10 @@ const (
 	// WorkspaceReasonReasonUnknown reason in WorkspaceScheduled means that scheduler has failed for
 	// some unexpected reason.
 	WorkspaceReasonReasonUnknown = "Unknown"
+	// WorkspaceReasonShardChosen reason in WorkspaceScheduled condition means that the scheduler has chosen
+	// a shard, but the logical cluster has not been created on it yet. The condition status is Unknown
+	// in that case, and the message explains the decision.
+	WorkspaceReasonShardChosen = "ShardChosen"
 
 	// WorkspaceContentDeleted represents the status that all resources in the workspace are deleted.
 	WorkspaceContentDeleted conditionsv1alpha1.ConditionType = "WorkspaceContentDeleted"
@@ -109,12 +114,89 @@ const (
 	// WorkspaceInitializedAPIBindingErrors is a reason for the APIBindingsInitialized condition that indicates there
 	// were errors trying to initialize APIBindings for the workspace.
 	WorkspaceReconciledAPIBindingErrors = WorkspaceInitializedAPIBindingErrors
+
+	// WorkspaceMigrated represents the status of moving the logical cluster of the workspace to
+	// the shard requested in spec.location.shard, or away from a draining shard.
+	WorkspaceMigrated conditionsv1alpha1.ConditionType = "Migrated"
+	// WorkspaceMigratedCopying is a reason for the Migrated condition that indicates that the
+	// objects of the logical cluster are being copied to the target shard.
+	WorkspaceMigratedCopying = "Copying"
+	// WorkspaceMigratedCleaningUp is a reason for the Migrated condition that indicates that the
+	// workspace is served from the target shard, and the logical cluster on the source shard is
+	// being deleted.
+	WorkspaceMigratedCleaningUp = "CleaningUp"
+	// WorkspaceMigratedInvalidTarget is a reason for the Migrated condition that indicates that the
+	// shard in spec.location.shard does not exist or cannot host logical clusters.
+	WorkspaceMigratedInvalidTarget = "InvalidTarget"
+	// WorkspaceMigratedNotSupported is a reason for the Migrated condition that indicates that the
+	// workspace cannot be migrated, e.g. because it has child workspaces.
+	WorkspaceMigratedNotSupported = "NotSupported"
+	// WorkspaceMigratedUnschedulable is a reason for the Migrated condition that indicates that the
+	// shard of the workspace is drained, but no other shard can host the workspace.
+	WorkspaceMigratedUnschedulable = "Unschedulable"
+
+	// WorkspaceMoved represents the status of moving a workspace to another place in the
+	// workspace hierarchy. On the workspace created with spec.moveFrom it becomes true when
+	// the logical cluster has been taken over, on the old workspace when it has been handed over.
+	WorkspaceMoved conditionsv1alpha1.ConditionType = "Moved"
+	// WorkspaceMovedInvalidSource is a reason for the Moved condition that indicates that the
+	// workspace in spec.moveFrom does not exist or cannot be moved here.
+	WorkspaceMovedInvalidSource = "InvalidSource"
+	// WorkspaceMovedHandingOver is a reason for the Moved condition that indicates that the
+	// new workspace is taking over the logical cluster. If the new workspace is deleted in
+	// this state, the logical cluster is handed back to the old workspace.
+	WorkspaceMovedHandingOver = "HandingOver"
+	// WorkspaceMovedRedirecting is a reason for the Moved condition that indicates that the
+	// old workspace has been moved, and requests to its path are served from the new path
+	// until the grace period ends and the old workspace is deleted.
+	WorkspaceMovedRedirecting = "Redirecting"
+
+	// WorkspaceExpiring represents the status of the expiry of a workspace with spec.expiration.
+	// It becomes true within the warning period before the workspace expires.
+	WorkspaceExpiring conditionsv1alpha1.ConditionType = "Expiring"
+	// WorkspaceExpiringSoon is a reason for the Expiring condition that indicates that the
+	// workspace will be deleted at the time given in the message.
+	WorkspaceExpiringSoon = "ExpiringSoon"
+	// WorkspaceExpired is a reason for the Expiring condition that indicates that the
+	// workspace has expired and is deleted.
+	WorkspaceExpired = "Expired"
 )
 
 // LogicalClusterTypeAnnotationKey is the annotation key used to indicate
 // the type of the workspace on the corresponding LogicalCluster object. Its format is "root:ws:name".
 const LogicalClusterTypeAnnotationKey = "internal.tenancy.kcp.io/type"
 
+// LogicalClusterMigrationSourceAnnotationKey is the annotation key set on the copy of a
+// LogicalCluster on the target shard while its workspace is migrated. Its value is the
+// name of the source shard. The copy is not served until the annotation is removed.
+const LogicalClusterMigrationSourceAnnotationKey = "internal.tenancy.kcp.io/migration-source"
+
+// LogicalClusterMigratedToAnnotationKey is the annotation key set on the LogicalCluster on the
+// source shard of a migration before the copy on the target shard is served. Its value is the
+// name of the target shard. The LogicalCluster is not served anymore from the source shard.
+const LogicalClusterMigratedToAnnotationKey = "internal.tenancy.kcp.io/migrated-to"
+
+// WorkspaceMovedToAnnotationKey is the annotation key set on a workspace that has been moved
+// to another place in the workspace hierarchy. Its value is the path of the new workspace.
+// Requests to the path of the old workspace are served from the new path while it exists.
+const WorkspaceMovedToAnnotationKey = "internal.tenancy.kcp.io/moved-to"
+
+// LogicalClusterWorkspaceQuotaAnnotationKey is the annotation key set on a LogicalCluster with
+// the nested workspace limits of its ResourceQuotas. Its value is a JSON object with the
+// nestedWorkspaces and workspaceDepth limits. It is kept up to date by kcp and makes the limits
+// visible to the workspaces nested below, which are possibly scheduled on other shards.
+const LogicalClusterWorkspaceQuotaAnnotationKey = "internal.tenancy.kcp.io/workspace-quota"
+
+const (
+	// ResourceNestedWorkspaces is the ResourceQuota resource name limiting the total number of
+	// workspaces nested below a workspace, at any depth.
+	ResourceNestedWorkspaces corev1.ResourceName = "tenancy.kcp.io/nested-workspaces"
+	// ResourceWorkspaceDepth is the ResourceQuota resource name limiting how many levels of
+	// workspaces can be nested below a workspace. A value of 1 allows child workspaces, but
+	// no grandchildren.
+	ResourceWorkspaceDepth corev1.ResourceName = "tenancy.kcp.io/workspace-depth"
+)
+
 // Workspace defines a generic Kubernetes-cluster-like endpoint, with standard Kubernetes
 // discovery APIs, OpenAPI and resource API endpoints.
 //
@@ -202,6 +284,58 @@ type WorkspaceSpec struct {
 	// +optional
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="mount is immutable"
 	Mount *Mount `json:"mount,omitempty"`
+
+	// moveFrom is the path of an existing workspace that is moved here, e.g. "root:org-a:team".
+	// The new workspace takes over the logical cluster of the existing workspace, including
+	// its child workspaces. The old path is served from the new path for a grace period,
+	// after which the old workspace is deleted.
+	//
+	// The type of the workspace must match the type of the moved workspace. The field can
+	// only be set on creation by system privileged users.
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="moveFrom is immutable"
+	MoveFrom string `json:"moveFrom,omitempty"`
+
+	// hibernation suspends the workspace when set, e.g. because it is idle. Controllers
+	// for the workspace are stopped to reclaim resources, and user access is restricted:
+	//
+	// - ReadOnly permits reading, but not changing objects in the workspace.
+	// - Denied denies all access to the workspace.
+	//
+	// Unset the field to resume the workspace. Child workspaces are not affected.
+	//
+	// +optional
+	Hibernation corev1alpha1.LogicalClusterHibernationMode `json:"hibernation,omitempty"`
+
+	// expiration deletes the workspace automatically after a lifetime or at a point in time,
+	// e.g. for ephemeral CI or preview workspaces. Before the workspace expires, the Expiring
+	// condition warns about it. The workspace is deleted like any other workspace, i.e. its
+	// terminators run before it is gone.
+	//
+	// +optional
+	Expiration *WorkspaceExpiration `json:"expiration,omitempty"`
+}
+
+// WorkspaceExpiration defines when a workspace expires.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.ttl) != has(self.expiresAt)",message="exactly one of ttl and expiresAt must be set"
+type WorkspaceExpiration struct {
+	// ttl is the lifetime of the workspace, counted from its creation, e.g. "24h".
+	//
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// expiresAt is the point in time at which the workspace expires.
+	//
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// warningPeriod is how long before the expiry the Expiring condition is set.
+	// It defaults to one hour.
+	//
+	// +optional
+	WarningPeriod *metav1.Duration `json:"warningPeriod,omitempty"`
 }
 
 // Mount is a reference to an object implementing a mounting feature. It is used to orchestrate
@@ -250,6 +384,83 @@ type WorkspaceLocation struct {
 	//
 	// +optional
 	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// shard is the name of the shard the workspace must live on. It can only be set
+	// by system privileged users.
+	//
+	// If the workspace is already scheduled onto another shard, the logical cluster of
+	// the workspace is migrated with all its objects to this shard. The workspace is
+	// read-only while it is migrated.
+	//
+	// +optional
+	Shard string `json:"shard,omitempty"`
+
+	// affinity places the workspace into a topology domain that hosts sibling workspaces,
+	// i.e. workspaces in the same parent workspace, matched by the given terms. A term
+	// that matches no scheduled sibling workspace does not restrict the placement.
+	//
+	// +optional
+	// +listType=atomic
+	Affinity []WorkspaceAffinityTerm `json:"affinity,omitempty"`
+
+	// antiAffinity keeps the workspace out of topology domains that host sibling
+	// workspaces matched by the given terms.
+	//
+	// +optional
+	// +listType=atomic
+	AntiAffinity []WorkspaceAffinityTerm `json:"antiAffinity,omitempty"`
+
+	// topologySpreadConstraints spread sibling workspaces evenly across topology domains.
+	//
+	// +optional
+	// +listType=atomic
+	TopologySpreadConstraints []WorkspaceTopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// WorkspaceAffinityTerm selects sibling workspaces, and the topology domain they are compared by.
+type WorkspaceAffinityTerm struct {
+	// workspaceSelector selects sibling workspaces by their labels. If both workspaceSelector
+	// and type are unset, all sibling workspaces are selected.
+	//
+	// +optional
+	WorkspaceSelector *metav1.LabelSelector `json:"workspaceSelector,omitempty"`
+
+	// type selects sibling workspaces of the given type. If path is empty, types
+	// of that name in any workspace are selected.
+	//
+	// +optional
+	Type *WorkspaceTypeReference `json:"type,omitempty"`
+
+	// topologyKey is the key of shard labels. Shards with the same value of this label
+	// are in the same topology domain. If empty, every shard is its own topology domain.
+	//
+	// +optional
+	TopologyKey string `json:"topologyKey,omitempty"`
+}
+
+// WorkspaceTopologySpreadConstraint describes how sibling workspaces are spread across topology domains.
+type WorkspaceTopologySpreadConstraint struct {
+	// topologyKey is the key of shard labels. Shards with the same value of this label
+	// are in the same topology domain. Shards without this label are not considered.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	TopologyKey string `json:"topologyKey"`
+
+	// maxSkew is the maximal difference of the number of selected sibling workspaces
+	// between any two topology domains, including the workspace being scheduled.
+	//
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	MaxSkew int32 `json:"maxSkew,omitempty"`
+
+	// workspaceSelector selects the sibling workspaces that are counted. If unset, all
+	// sibling workspaces are counted.
+	//
+	// +optional
+	WorkspaceSelector *metav1.LabelSelector `json:"workspaceSelector,omitempty"`
 }
 
 // WorkspaceStatus communicates the observed state of the Workspace.
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
@@ -141,6 +142,26 @@ type WorkspaceTypeSpec struct {
 	// +kubebuilder:validation:Enum=InitializeOnly;Maintain
 	DefaultAPIBindingLifecycle *APIBindingLifecycleMode `json:"defaultAPIBindingLifecycle,omitempty"`
 
+	// defaultResourceQuotas are ResourceQuotas to create during initialization of workspaces
+	// created from this type. Missing namespaces are created.
+	//
+	// +optional
+	DefaultResourceQuotas []ResourceQuotaTemplate `json:"defaultResourceQuotas,omitempty"`
+
+	// defaultLimitRanges are LimitRanges to create during initialization of workspaces
+	// created from this type. Missing namespaces are created.
+	//
+	// +optional
+	DefaultLimitRanges []LimitRangeTemplate `json:"defaultLimitRanges,omitempty"`
+
+	// Configure the lifecycle behaviour of defaultResourceQuotas and defaultLimitRanges. With
+	// Maintain, changes to the templates are rolled out to existing workspaces, and modified or
+	// deleted objects are restored.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=InitializeOnly;Maintain
+	DefaultResourcesLifecycle *APIBindingLifecycleMode `json:"defaultResourcesLifecycle,omitempty"`
+
 	// authenticationConfigurations are additional authentication options that should apply to any
 	// workspace using this workspace type.
 	//
@@ -166,6 +187,56 @@ type APIExportReference struct {
 	Export string `json:"export"`
 }
 
+// ResourceQuotaTemplate describes a ResourceQuota created in workspaces of a WorkspaceType.
+type ResourceQuotaTemplate struct {
+	// name is the name of the ResourceQuota.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// namespace is the namespace of the ResourceQuota.
+	//
+	// +optional
+	// +kubebuilder:default=default
+	Namespace string `json:"namespace,omitempty"`
+
+	// annotations are set on the ResourceQuota, e.g. experimental.quota.kcp.io/cluster-scoped
+	// to count objects in all namespaces of the workspace.
+	//
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// spec is the spec of the ResourceQuota.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	Spec corev1.ResourceQuotaSpec `json:"spec"`
+}
+
+// LimitRangeTemplate describes a LimitRange created in workspaces of a WorkspaceType.
+type LimitRangeTemplate struct {
+	// name is the name of the LimitRange.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// namespace is the namespace of the LimitRange.
+	//
+	// +optional
+	// +kubebuilder:default=default
+	Namespace string `json:"namespace,omitempty"`
+
+	// spec is the spec of the LimitRange.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	Spec corev1.LimitRangeSpec `json:"spec"`
+}
+
 // AuthenticationConfigurationReference provides the fields necessary to resolve a WorkspaceAuthenticationConfiguration.
 type AuthenticationConfigurationReference struct {
 	// name is the name of the WorkspaceAuthenticationConfiguration.
@@ -176,8 +247,8 @@ type AuthenticationConfigurationReference struct {
 	Name string `json:"name"`
 }
 
-// APIBindingLifecycleMode defines how the lifecycle of an APIBinding is
-// managed.
+// APIBindingLifecycleMode defines how the lifecycle of an APIBinding, or of
+// another object created from a WorkspaceType, is managed.
 type APIBindingLifecycleMode string
 
 const (
@@ -284,6 +355,10 @@ type WorkspaceTypeList struct {
 // on a WorkspaceType to be created.
 const WorkspaceAPIBindingsInitializer corev1alpha1.LogicalClusterInitializer = "system:apibindings"
 
+// WorkspaceDefaultResourcesInitializer is a special-case initializer that waits for the ResourceQuotas
+// and LimitRanges defined on a WorkspaceType to be created.
+const WorkspaceDefaultResourcesInitializer corev1alpha1.LogicalClusterInitializer = "system:default-resources"
+
 const (
 	// WorkspacePhaseLabel holds the Workspace.Status.Phase value, and is enforced to match
 	// by a mutating admission webhook.
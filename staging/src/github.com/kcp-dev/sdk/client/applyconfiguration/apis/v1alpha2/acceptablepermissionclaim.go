@@ -19,6 +19,8 @@ limitations under the License.
 package v1alpha2
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
 )
 
@@ -27,6 +29,7 @@ import (
 type AcceptablePermissionClaimApplyConfiguration struct {
 	ScopedPermissionClaimApplyConfiguration `json:",inline"`
 	State                                   *apisv1alpha2.AcceptablePermissionClaimState `json:"state,omitempty"`
+	ExpiresAt                               *v1.Time                                     `json:"expiresAt,omitempty"`
 }
 
 // AcceptablePermissionClaimApplyConfiguration constructs a declarative configuration of the AcceptablePermissionClaim type for use with
@@ -84,3 +87,11 @@ func (b *AcceptablePermissionClaimApplyConfiguration) WithState(value apisv1alph
 	b.State = &value
 	return b
 }
+
+// WithExpiresAt sets the ExpiresAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExpiresAt field is set to the value of the last call.
+func (b *AcceptablePermissionClaimApplyConfiguration) WithExpiresAt(value v1.Time) *AcceptablePermissionClaimApplyConfiguration {
+	b.ExpiresAt = &value
+	return b
+}
@@ -23,6 +23,8 @@ package v1alpha2
 type APIBindingSpecApplyConfiguration struct {
 	Reference        *BindingReferenceApplyConfiguration           `json:"reference,omitempty"`
 	PermissionClaims []AcceptablePermissionClaimApplyConfiguration `json:"permissionClaims,omitempty"`
+	ResourceRenames  []ResourceRenameApplyConfiguration            `json:"resourceRenames,omitempty"`
+	Resources        []GroupResourceApplyConfiguration             `json:"resources,omitempty"`
 }
 
 // APIBindingSpecApplyConfiguration constructs a declarative configuration of the APIBindingSpec type for use with
@@ -51,3 +53,29 @@ func (b *APIBindingSpecApplyConfiguration) WithPermissionClaims(values ...*Accep
 	}
 	return b
 }
+
+// WithResourceRenames adds the given value to the ResourceRenames field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ResourceRenames field.
+func (b *APIBindingSpecApplyConfiguration) WithResourceRenames(values ...*ResourceRenameApplyConfiguration) *APIBindingSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResourceRenames")
+		}
+		b.ResourceRenames = append(b.ResourceRenames, *values[i])
+	}
+	return b
+}
+
+// WithResources adds the given value to the Resources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Resources field.
+func (b *APIBindingSpecApplyConfiguration) WithResources(values ...*GroupResourceApplyConfiguration) *APIBindingSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResources")
+		}
+		b.Resources = append(b.Resources, *values[i])
+	}
+	return b
+}
@@ -27,11 +27,13 @@ import (
 // with apply.
 type APIBindingStatusApplyConfiguration struct {
 	APIExportClusterName    *string                                   `json:"apiExportClusterName,omitempty"`
+	Release                 *string                                   `json:"release,omitempty"`
 	BoundResources          []BoundAPIResourceApplyConfiguration      `json:"boundResources,omitempty"`
 	Phase                   *apisv1alpha2.APIBindingPhaseType         `json:"phase,omitempty"`
 	Conditions              *v1alpha1.Conditions                      `json:"conditions,omitempty"`
 	AppliedPermissionClaims []ScopedPermissionClaimApplyConfiguration `json:"appliedPermissionClaims,omitempty"`
 	ExportPermissionClaims  []PermissionClaimApplyConfiguration       `json:"exportPermissionClaims,omitempty"`
+	Dependencies            []string                                  `json:"dependencies,omitempty"`
 }
 
 // APIBindingStatusApplyConfiguration constructs a declarative configuration of the APIBindingStatus type for use with
@@ -48,6 +50,14 @@ func (b *APIBindingStatusApplyConfiguration) WithAPIExportClusterName(value stri
 	return b
 }
 
+// WithRelease sets the Release field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Release field is set to the value of the last call.
+func (b *APIBindingStatusApplyConfiguration) WithRelease(value string) *APIBindingStatusApplyConfiguration {
+	b.Release = &value
+	return b
+}
+
 // WithBoundResources adds the given value to the BoundResources field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the BoundResources field.
@@ -102,3 +112,13 @@ func (b *APIBindingStatusApplyConfiguration) WithExportPermissionClaims(values .
 	}
 	return b
 }
+
+// WithDependencies adds the given value to the Dependencies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Dependencies field.
+func (b *APIBindingStatusApplyConfiguration) WithDependencies(values ...string) *APIBindingStatusApplyConfiguration {
+	for i := range values {
+		b.Dependencies = append(b.Dependencies, values[i])
+	}
+	return b
+}
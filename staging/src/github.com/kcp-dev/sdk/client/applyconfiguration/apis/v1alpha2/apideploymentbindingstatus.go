@@ -0,0 +1,79 @@
+/*
+Copyright The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+)
+
+// APIDeploymentBindingStatusApplyConfiguration represents a declarative configuration of the APIDeploymentBindingStatus type for use
+// with apply.
+type APIDeploymentBindingStatusApplyConfiguration struct {
+	Cluster *string                                 `json:"cluster,omitempty"`
+	Name    *string                                 `json:"name,omitempty"`
+	Wave    *string                                 `json:"wave,omitempty"`
+	State   *apisv1alpha2.APIDeploymentBindingState `json:"state,omitempty"`
+	Message *string                                 `json:"message,omitempty"`
+}
+
+// APIDeploymentBindingStatusApplyConfiguration constructs a declarative configuration of the APIDeploymentBindingStatus type for use with
+// apply.
+func APIDeploymentBindingStatus() *APIDeploymentBindingStatusApplyConfiguration {
+	return &APIDeploymentBindingStatusApplyConfiguration{}
+}
+
+// WithCluster sets the Cluster field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cluster field is set to the value of the last call.
+func (b *APIDeploymentBindingStatusApplyConfiguration) WithCluster(value string) *APIDeploymentBindingStatusApplyConfiguration {
+	b.Cluster = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *APIDeploymentBindingStatusApplyConfiguration) WithName(value string) *APIDeploymentBindingStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithWave sets the Wave field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Wave field is set to the value of the last call.
+func (b *APIDeploymentBindingStatusApplyConfiguration) WithWave(value string) *APIDeploymentBindingStatusApplyConfiguration {
+	b.Wave = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *APIDeploymentBindingStatusApplyConfiguration) WithState(value apisv1alpha2.APIDeploymentBindingState) *APIDeploymentBindingStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *APIDeploymentBindingStatusApplyConfiguration) WithMessage(value string) *APIDeploymentBindingStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -0,0 +1,76 @@
+/*
+Copyright The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// APIDeploymentSpecApplyConfiguration represents a declarative configuration of the APIDeploymentSpec type for use
+// with apply.
+type APIDeploymentSpecApplyConfiguration struct {
+	ExportName *string                               `json:"exportName,omitempty"`
+	Resources  []ResourceSchemaApplyConfiguration    `json:"resources,omitempty"`
+	Waves      []APIDeploymentWaveApplyConfiguration `json:"waves,omitempty"`
+	Paused     *bool                                 `json:"paused,omitempty"`
+}
+
+// APIDeploymentSpecApplyConfiguration constructs a declarative configuration of the APIDeploymentSpec type for use with
+// apply.
+func APIDeploymentSpec() *APIDeploymentSpecApplyConfiguration {
+	return &APIDeploymentSpecApplyConfiguration{}
+}
+
+// WithExportName sets the ExportName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExportName field is set to the value of the last call.
+func (b *APIDeploymentSpecApplyConfiguration) WithExportName(value string) *APIDeploymentSpecApplyConfiguration {
+	b.ExportName = &value
+	return b
+}
+
+// WithResources adds the given value to the Resources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Resources field.
+func (b *APIDeploymentSpecApplyConfiguration) WithResources(values ...*ResourceSchemaApplyConfiguration) *APIDeploymentSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResources")
+		}
+		b.Resources = append(b.Resources, *values[i])
+	}
+	return b
+}
+
+// WithWaves adds the given value to the Waves field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Waves field.
+func (b *APIDeploymentSpecApplyConfiguration) WithWaves(values ...*APIDeploymentWaveApplyConfiguration) *APIDeploymentSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWaves")
+		}
+		b.Waves = append(b.Waves, *values[i])
+	}
+	return b
+}
+
+// WithPaused sets the Paused field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Paused field is set to the value of the last call.
+func (b *APIDeploymentSpecApplyConfiguration) WithPaused(value bool) *APIDeploymentSpecApplyConfiguration {
+	b.Paused = &value
+	return b
+}
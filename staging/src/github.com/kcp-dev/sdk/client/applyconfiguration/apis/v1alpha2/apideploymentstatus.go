@@ -0,0 +1,76 @@
+/*
+Copyright The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	v1alpha1 "github.com/kcp-dev/sdk/apis/third_party/conditions/apis/conditions/v1alpha1"
+)
+
+// APIDeploymentStatusApplyConfiguration represents a declarative configuration of the APIDeploymentStatus type for use
+// with apply.
+type APIDeploymentStatusApplyConfiguration struct {
+	Phase       *apisv1alpha2.APIDeploymentPhaseType           `json:"phase,omitempty"`
+	CurrentWave *int32                                         `json:"currentWave,omitempty"`
+	Bindings    []APIDeploymentBindingStatusApplyConfiguration `json:"bindings,omitempty"`
+	Conditions  *v1alpha1.Conditions                           `json:"conditions,omitempty"`
+}
+
+// APIDeploymentStatusApplyConfiguration constructs a declarative configuration of the APIDeploymentStatus type for use with
+// apply.
+func APIDeploymentStatus() *APIDeploymentStatusApplyConfiguration {
+	return &APIDeploymentStatusApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *APIDeploymentStatusApplyConfiguration) WithPhase(value apisv1alpha2.APIDeploymentPhaseType) *APIDeploymentStatusApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithCurrentWave sets the CurrentWave field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CurrentWave field is set to the value of the last call.
+func (b *APIDeploymentStatusApplyConfiguration) WithCurrentWave(value int32) *APIDeploymentStatusApplyConfiguration {
+	b.CurrentWave = &value
+	return b
+}
+
+// WithBindings adds the given value to the Bindings field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Bindings field.
+func (b *APIDeploymentStatusApplyConfiguration) WithBindings(values ...*APIDeploymentBindingStatusApplyConfiguration) *APIDeploymentStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithBindings")
+		}
+		b.Bindings = append(b.Bindings, *values[i])
+	}
+	return b
+}
+
+// WithConditions sets the Conditions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Conditions field is set to the value of the last call.
+func (b *APIDeploymentStatusApplyConfiguration) WithConditions(value v1alpha1.Conditions) *APIDeploymentStatusApplyConfiguration {
+	b.Conditions = &value
+	return b
+}
@@ -0,0 +1,61 @@
+/*
+Copyright The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1 "github.com/kcp-dev/sdk/client/applyconfiguration/meta/v1"
+)
+
+// APIDeploymentWaveApplyConfiguration represents a declarative configuration of the APIDeploymentWave type for use
+// with apply.
+type APIDeploymentWaveApplyConfiguration struct {
+	Name      *string                             `json:"name,omitempty"`
+	Partition *string                             `json:"partition,omitempty"`
+	Selector  *v1.LabelSelectorApplyConfiguration `json:"selector,omitempty"`
+}
+
+// APIDeploymentWaveApplyConfiguration constructs a declarative configuration of the APIDeploymentWave type for use with
+// apply.
+func APIDeploymentWave() *APIDeploymentWaveApplyConfiguration {
+	return &APIDeploymentWaveApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *APIDeploymentWaveApplyConfiguration) WithName(value string) *APIDeploymentWaveApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithPartition sets the Partition field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Partition field is set to the value of the last call.
+func (b *APIDeploymentWaveApplyConfiguration) WithPartition(value string) *APIDeploymentWaveApplyConfiguration {
+	b.Partition = &value
+	return b
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *APIDeploymentWaveApplyConfiguration) WithSelector(value *v1.LabelSelectorApplyConfiguration) *APIDeploymentWaveApplyConfiguration {
+	b.Selector = value
+	return b
+}
@@ -25,6 +25,9 @@ type APIExportSpecApplyConfiguration struct {
 	Identity                *IdentityApplyConfiguration                `json:"identity,omitempty"`
 	MaximalPermissionPolicy *MaximalPermissionPolicyApplyConfiguration `json:"maximalPermissionPolicy,omitempty"`
 	PermissionClaims        []PermissionClaimApplyConfiguration        `json:"permissionClaims,omitempty"`
+	Dependencies            []APIExportDependencyApplyConfiguration    `json:"dependencies,omitempty"`
+	Releases                []APIExportReleaseApplyConfiguration       `json:"releases,omitempty"`
+	Channels                []APIExportChannelApplyConfiguration       `json:"channels,omitempty"`
 }
 
 // APIExportSpecApplyConfiguration constructs a declarative configuration of the APIExportSpec type for use with
@@ -74,3 +77,42 @@ func (b *APIExportSpecApplyConfiguration) WithPermissionClaims(values ...*Permis
 	}
 	return b
 }
+
+// WithDependencies adds the given value to the Dependencies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Dependencies field.
+func (b *APIExportSpecApplyConfiguration) WithDependencies(values ...*APIExportDependencyApplyConfiguration) *APIExportSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDependencies")
+		}
+		b.Dependencies = append(b.Dependencies, *values[i])
+	}
+	return b
+}
+
+// WithReleases adds the given value to the Releases field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Releases field.
+func (b *APIExportSpecApplyConfiguration) WithReleases(values ...*APIExportReleaseApplyConfiguration) *APIExportSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithReleases")
+		}
+		b.Releases = append(b.Releases, *values[i])
+	}
+	return b
+}
+
+// WithChannels adds the given value to the Channels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Channels field.
+func (b *APIExportSpecApplyConfiguration) WithChannels(values ...*APIExportChannelApplyConfiguration) *APIExportSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithChannels")
+		}
+		b.Channels = append(b.Channels, *values[i])
+	}
+	return b
+}
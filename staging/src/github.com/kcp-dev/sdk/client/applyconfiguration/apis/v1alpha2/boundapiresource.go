@@ -25,6 +25,7 @@ type BoundAPIResourceApplyConfiguration struct {
 	Resource        *string                                   `json:"resource,omitempty"`
 	Schema          *BoundAPIResourceSchemaApplyConfiguration `json:"schema,omitempty"`
 	StorageVersions []string                                  `json:"storageVersions,omitempty"`
+	ServedAs        *GroupResourceApplyConfiguration          `json:"servedAs,omitempty"`
 }
 
 // BoundAPIResourceApplyConfiguration constructs a declarative configuration of the BoundAPIResource type for use with
@@ -66,3 +67,11 @@ func (b *BoundAPIResourceApplyConfiguration) WithStorageVersions(values ...strin
 	}
 	return b
 }
+
+// WithServedAs sets the ServedAs field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServedAs field is set to the value of the last call.
+func (b *BoundAPIResourceApplyConfiguration) WithServedAs(value *GroupResourceApplyConfiguration) *BoundAPIResourceApplyConfiguration {
+	b.ServedAs = value
+	return b
+}
@@ -18,11 +18,18 @@ limitations under the License.
 
 package v1alpha2
 
+import (
+	apisv1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+)
+
 // ExportBindingReferenceApplyConfiguration represents a declarative configuration of the ExportBindingReference type for use
 // with apply.
 type ExportBindingReferenceApplyConfiguration struct {
-	Path *string `json:"path,omitempty"`
-	Name *string `json:"name,omitempty"`
+	Path          *string                            `json:"path,omitempty"`
+	Name          *string                            `json:"name,omitempty"`
+	Release       *string                            `json:"release,omitempty"`
+	Channel       *string                            `json:"channel,omitempty"`
+	UpgradePolicy *apisv1alpha2.ReleaseUpgradePolicy `json:"upgradePolicy,omitempty"`
 }
 
 // ExportBindingReferenceApplyConfiguration constructs a declarative configuration of the ExportBindingReference type for use with
@@ -46,3 +53,27 @@ func (b *ExportBindingReferenceApplyConfiguration) WithName(value string) *Expor
 	b.Name = &value
 	return b
 }
+
+// WithRelease sets the Release field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Release field is set to the value of the last call.
+func (b *ExportBindingReferenceApplyConfiguration) WithRelease(value string) *ExportBindingReferenceApplyConfiguration {
+	b.Release = &value
+	return b
+}
+
+// WithChannel sets the Channel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Channel field is set to the value of the last call.
+func (b *ExportBindingReferenceApplyConfiguration) WithChannel(value string) *ExportBindingReferenceApplyConfiguration {
+	b.Channel = &value
+	return b
+}
+
+// WithUpgradePolicy sets the UpgradePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpgradePolicy field is set to the value of the last call.
+func (b *ExportBindingReferenceApplyConfiguration) WithUpgradePolicy(value apisv1alpha2.ReleaseUpgradePolicy) *ExportBindingReferenceApplyConfiguration {
+	b.UpgradePolicy = &value
+	return b
+}
@@ -26,7 +26,9 @@ import (
 // with apply.
 type PermissionClaimSelectorApplyConfiguration struct {
 	v1.LabelSelectorApplyConfiguration `json:",inline"`
-	MatchAll                           *bool `json:"matchAll,omitempty"`
+	MatchAll                           *bool    `json:"matchAll,omitempty"`
+	Namespaces                         []string `json:"namespaces,omitempty"`
+	Names                              []string `json:"names,omitempty"`
 }
 
 // PermissionClaimSelectorApplyConfiguration constructs a declarative configuration of the PermissionClaimSelector type for use with
@@ -69,3 +71,23 @@ func (b *PermissionClaimSelectorApplyConfiguration) WithMatchAll(value bool) *Pe
 	b.MatchAll = &value
 	return b
 }
+
+// WithNamespaces adds the given value to the Namespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Namespaces field.
+func (b *PermissionClaimSelectorApplyConfiguration) WithNamespaces(values ...string) *PermissionClaimSelectorApplyConfiguration {
+	for i := range values {
+		b.Namespaces = append(b.Namespaces, values[i])
+	}
+	return b
+}
+
+// WithNames adds the given value to the Names field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Names field.
+func (b *PermissionClaimSelectorApplyConfiguration) WithNames(values ...string) *PermissionClaimSelectorApplyConfiguration {
+	for i := range values {
+		b.Names = append(b.Names, values[i])
+	}
+	return b
+}
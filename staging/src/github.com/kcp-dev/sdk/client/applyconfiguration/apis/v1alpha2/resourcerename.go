@@ -0,0 +1,56 @@
+/*
+Copyright The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// ResourceRenameApplyConfiguration represents a declarative configuration of the ResourceRename type for use
+// with apply.
+type ResourceRenameApplyConfiguration struct {
+	GroupResourceApplyConfiguration `json:",inline"`
+	As                              *GroupResourceApplyConfiguration `json:"as,omitempty"`
+}
+
+// ResourceRenameApplyConfiguration constructs a declarative configuration of the ResourceRename type for use with
+// apply.
+func ResourceRename() *ResourceRenameApplyConfiguration {
+	return &ResourceRenameApplyConfiguration{}
+}
+
+// WithGroup sets the Group field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Group field is set to the value of the last call.
+func (b *ResourceRenameApplyConfiguration) WithGroup(value string) *ResourceRenameApplyConfiguration {
+	b.GroupResourceApplyConfiguration.Group = &value
+	return b
+}
+
+// WithResource sets the Resource field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resource field is set to the value of the last call.
+func (b *ResourceRenameApplyConfiguration) WithResource(value string) *ResourceRenameApplyConfiguration {
+	b.GroupResourceApplyConfiguration.Resource = &value
+	return b
+}
+
+// WithAs sets the As field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the As field is set to the value of the last call.
+func (b *ResourceRenameApplyConfiguration) WithAs(value *GroupResourceApplyConfiguration) *ResourceRenameApplyConfiguration {
+	b.As = value
+	return b
+}
@@ -25,10 +25,11 @@ import (
 // LogicalClusterSpecApplyConfiguration represents a declarative configuration of the LogicalClusterSpec type for use
 // with apply.
 type LogicalClusterSpecApplyConfiguration struct {
-	DirectlyDeletable *bool                                    `json:"directlyDeletable,omitempty"`
-	Owner             *LogicalClusterOwnerApplyConfiguration   `json:"owner,omitempty"`
-	Initializers      []corev1alpha1.LogicalClusterInitializer `json:"initializers,omitempty"`
-	Terminators       []corev1alpha1.LogicalClusterTerminator  `json:"terminators,omitempty"`
+	DirectlyDeletable *bool                                       `json:"directlyDeletable,omitempty"`
+	Owner             *LogicalClusterOwnerApplyConfiguration      `json:"owner,omitempty"`
+	Initializers      []corev1alpha1.LogicalClusterInitializer    `json:"initializers,omitempty"`
+	Terminators       []corev1alpha1.LogicalClusterTerminator     `json:"terminators,omitempty"`
+	Hibernation       *corev1alpha1.LogicalClusterHibernationMode `json:"hibernation,omitempty"`
 }
 
 // LogicalClusterSpecApplyConfiguration constructs a declarative configuration of the LogicalClusterSpec type for use with
@@ -72,3 +73,11 @@ func (b *LogicalClusterSpecApplyConfiguration) WithTerminators(values ...corev1a
 	}
 	return b
 }
+
+// WithHibernation sets the Hibernation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Hibernation field is set to the value of the last call.
+func (b *LogicalClusterSpecApplyConfiguration) WithHibernation(value corev1alpha1.LogicalClusterHibernationMode) *LogicalClusterSpecApplyConfiguration {
+	b.Hibernation = &value
+	return b
+}
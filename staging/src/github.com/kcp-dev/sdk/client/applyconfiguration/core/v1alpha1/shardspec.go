@@ -24,6 +24,8 @@ type ShardSpecApplyConfiguration struct {
 	BaseURL             *string `json:"baseURL,omitempty"`
 	ExternalURL         *string `json:"externalURL,omitempty"`
 	VirtualWorkspaceURL *string `json:"virtualWorkspaceURL,omitempty"`
+	Unschedulable       *bool   `json:"unschedulable,omitempty"`
+	Drain               *bool   `json:"drain,omitempty"`
 }
 
 // ShardSpecApplyConfiguration constructs a declarative configuration of the ShardSpec type for use with
@@ -55,3 +57,19 @@ func (b *ShardSpecApplyConfiguration) WithVirtualWorkspaceURL(value string) *Sha
 	b.VirtualWorkspaceURL = &value
 	return b
 }
+
+// WithUnschedulable sets the Unschedulable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Unschedulable field is set to the value of the last call.
+func (b *ShardSpecApplyConfiguration) WithUnschedulable(value bool) *ShardSpecApplyConfiguration {
+	b.Unschedulable = &value
+	return b
+}
+
+// WithDrain sets the Drain field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Drain field is set to the value of the last call.
+func (b *ShardSpecApplyConfiguration) WithDrain(value bool) *ShardSpecApplyConfiguration {
+	b.Drain = &value
+	return b
+}
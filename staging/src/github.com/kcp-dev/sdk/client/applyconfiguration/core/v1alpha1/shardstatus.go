@@ -28,6 +28,7 @@ import (
 // with apply.
 type ShardStatusApplyConfiguration struct {
 	Capacity   *v1.ResourceList               `json:"capacity,omitempty"`
+	Allocated  *v1.ResourceList               `json:"allocated,omitempty"`
 	Conditions *conditionsv1alpha1.Conditions `json:"conditions,omitempty"`
 }
 
@@ -45,6 +46,14 @@ func (b *ShardStatusApplyConfiguration) WithCapacity(value v1.ResourceList) *Sha
 	return b
 }
 
+// WithAllocated sets the Allocated field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Allocated field is set to the value of the last call.
+func (b *ShardStatusApplyConfiguration) WithAllocated(value v1.ResourceList) *ShardStatusApplyConfiguration {
+	b.Allocated = &value
+	return b
+}
+
 // WithConditions sets the Conditions field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Conditions field is set to the value of the last call.
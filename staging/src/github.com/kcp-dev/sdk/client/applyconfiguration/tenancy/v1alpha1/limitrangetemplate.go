@@ -0,0 +1,61 @@
+/*
+Copyright The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// LimitRangeTemplateApplyConfiguration represents a declarative configuration of the LimitRangeTemplate type for use
+// with apply.
+type LimitRangeTemplateApplyConfiguration struct {
+	Name      *string            `json:"name,omitempty"`
+	Namespace *string            `json:"namespace,omitempty"`
+	Spec      *v1.LimitRangeSpec `json:"spec,omitempty"`
+}
+
+// LimitRangeTemplateApplyConfiguration constructs a declarative configuration of the LimitRangeTemplate type for use with
+// apply.
+func LimitRangeTemplate() *LimitRangeTemplateApplyConfiguration {
+	return &LimitRangeTemplateApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *LimitRangeTemplateApplyConfiguration) WithName(value string) *LimitRangeTemplateApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *LimitRangeTemplateApplyConfiguration) WithNamespace(value string) *LimitRangeTemplateApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithSpec sets the Spec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Spec field is set to the value of the last call.
+func (b *LimitRangeTemplateApplyConfiguration) WithSpec(value v1.LimitRangeSpec) *LimitRangeTemplateApplyConfiguration {
+	b.Spec = &value
+	return b
+}
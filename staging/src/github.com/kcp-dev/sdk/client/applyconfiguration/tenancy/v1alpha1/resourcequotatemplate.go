@@ -0,0 +1,76 @@
+/*
+Copyright The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// ResourceQuotaTemplateApplyConfiguration represents a declarative configuration of the ResourceQuotaTemplate type for use
+// with apply.
+type ResourceQuotaTemplateApplyConfiguration struct {
+	Name        *string               `json:"name,omitempty"`
+	Namespace   *string               `json:"namespace,omitempty"`
+	Annotations map[string]string     `json:"annotations,omitempty"`
+	Spec        *v1.ResourceQuotaSpec `json:"spec,omitempty"`
+}
+
+// ResourceQuotaTemplateApplyConfiguration constructs a declarative configuration of the ResourceQuotaTemplate type for use with
+// apply.
+func ResourceQuotaTemplate() *ResourceQuotaTemplateApplyConfiguration {
+	return &ResourceQuotaTemplateApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ResourceQuotaTemplateApplyConfiguration) WithName(value string) *ResourceQuotaTemplateApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *ResourceQuotaTemplateApplyConfiguration) WithNamespace(value string) *ResourceQuotaTemplateApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *ResourceQuotaTemplateApplyConfiguration) WithAnnotations(entries map[string]string) *ResourceQuotaTemplateApplyConfiguration {
+	if b.Annotations == nil && len(entries) > 0 {
+		b.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Annotations[k] = v
+	}
+	return b
+}
+
+// WithSpec sets the Spec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Spec field is set to the value of the last call.
+func (b *ResourceQuotaTemplateApplyConfiguration) WithSpec(value v1.ResourceQuotaSpec) *ResourceQuotaTemplateApplyConfiguration {
+	b.Spec = &value
+	return b
+}
@@ -0,0 +1,61 @@
+/*
+Copyright The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "github.com/kcp-dev/sdk/client/applyconfiguration/meta/v1"
+)
+
+// WorkspaceAffinityTermApplyConfiguration represents a declarative configuration of the WorkspaceAffinityTerm type for use
+// with apply.
+type WorkspaceAffinityTermApplyConfiguration struct {
+	WorkspaceSelector *v1.LabelSelectorApplyConfiguration       `json:"workspaceSelector,omitempty"`
+	Type              *WorkspaceTypeReferenceApplyConfiguration `json:"type,omitempty"`
+	TopologyKey       *string                                   `json:"topologyKey,omitempty"`
+}
+
+// WorkspaceAffinityTermApplyConfiguration constructs a declarative configuration of the WorkspaceAffinityTerm type for use with
+// apply.
+func WorkspaceAffinityTerm() *WorkspaceAffinityTermApplyConfiguration {
+	return &WorkspaceAffinityTermApplyConfiguration{}
+}
+
+// WithWorkspaceSelector sets the WorkspaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkspaceSelector field is set to the value of the last call.
+func (b *WorkspaceAffinityTermApplyConfiguration) WithWorkspaceSelector(value *v1.LabelSelectorApplyConfiguration) *WorkspaceAffinityTermApplyConfiguration {
+	b.WorkspaceSelector = value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *WorkspaceAffinityTermApplyConfiguration) WithType(value *WorkspaceTypeReferenceApplyConfiguration) *WorkspaceAffinityTermApplyConfiguration {
+	b.Type = value
+	return b
+}
+
+// WithTopologyKey sets the TopologyKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopologyKey field is set to the value of the last call.
+func (b *WorkspaceAffinityTermApplyConfiguration) WithTopologyKey(value string) *WorkspaceAffinityTermApplyConfiguration {
+	b.TopologyKey = &value
+	return b
+}
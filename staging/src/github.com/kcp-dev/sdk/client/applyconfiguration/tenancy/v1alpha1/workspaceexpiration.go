@@ -0,0 +1,61 @@
+/*
+Copyright The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkspaceExpirationApplyConfiguration represents a declarative configuration of the WorkspaceExpiration type for use
+// with apply.
+type WorkspaceExpirationApplyConfiguration struct {
+	TTL           *v1.Duration `json:"ttl,omitempty"`
+	ExpiresAt     *v1.Time     `json:"expiresAt,omitempty"`
+	WarningPeriod *v1.Duration `json:"warningPeriod,omitempty"`
+}
+
+// WorkspaceExpirationApplyConfiguration constructs a declarative configuration of the WorkspaceExpiration type for use with
+// apply.
+func WorkspaceExpiration() *WorkspaceExpirationApplyConfiguration {
+	return &WorkspaceExpirationApplyConfiguration{}
+}
+
+// WithTTL sets the TTL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TTL field is set to the value of the last call.
+func (b *WorkspaceExpirationApplyConfiguration) WithTTL(value v1.Duration) *WorkspaceExpirationApplyConfiguration {
+	b.TTL = &value
+	return b
+}
+
+// WithExpiresAt sets the ExpiresAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExpiresAt field is set to the value of the last call.
+func (b *WorkspaceExpirationApplyConfiguration) WithExpiresAt(value v1.Time) *WorkspaceExpirationApplyConfiguration {
+	b.ExpiresAt = &value
+	return b
+}
+
+// WithWarningPeriod sets the WarningPeriod field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WarningPeriod field is set to the value of the last call.
+func (b *WorkspaceExpirationApplyConfiguration) WithWarningPeriod(value v1.Duration) *WorkspaceExpirationApplyConfiguration {
+	b.WarningPeriod = &value
+	return b
+}
@@ -25,7 +25,11 @@ import (
 // WorkspaceLocationApplyConfiguration represents a declarative configuration of the WorkspaceLocation type for use
 // with apply.
 type WorkspaceLocationApplyConfiguration struct {
-	Selector *v1.LabelSelectorApplyConfiguration `json:"selector,omitempty"`
+	Selector                  *v1.LabelSelectorApplyConfiguration                   `json:"selector,omitempty"`
+	Shard                     *string                                               `json:"shard,omitempty"`
+	Affinity                  []WorkspaceAffinityTermApplyConfiguration             `json:"affinity,omitempty"`
+	AntiAffinity              []WorkspaceAffinityTermApplyConfiguration             `json:"antiAffinity,omitempty"`
+	TopologySpreadConstraints []WorkspaceTopologySpreadConstraintApplyConfiguration `json:"topologySpreadConstraints,omitempty"`
 }
 
 // WorkspaceLocationApplyConfiguration constructs a declarative configuration of the WorkspaceLocation type for use with
@@ -41,3 +45,50 @@ func (b *WorkspaceLocationApplyConfiguration) WithSelector(value *v1.LabelSelect
 	b.Selector = value
 	return b
 }
+
+// WithShard sets the Shard field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Shard field is set to the value of the last call.
+func (b *WorkspaceLocationApplyConfiguration) WithShard(value string) *WorkspaceLocationApplyConfiguration {
+	b.Shard = &value
+	return b
+}
+
+// WithAffinity adds the given value to the Affinity field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Affinity field.
+func (b *WorkspaceLocationApplyConfiguration) WithAffinity(values ...*WorkspaceAffinityTermApplyConfiguration) *WorkspaceLocationApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAffinity")
+		}
+		b.Affinity = append(b.Affinity, *values[i])
+	}
+	return b
+}
+
+// WithAntiAffinity adds the given value to the AntiAffinity field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AntiAffinity field.
+func (b *WorkspaceLocationApplyConfiguration) WithAntiAffinity(values ...*WorkspaceAffinityTermApplyConfiguration) *WorkspaceLocationApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAntiAffinity")
+		}
+		b.AntiAffinity = append(b.AntiAffinity, *values[i])
+	}
+	return b
+}
+
+// WithTopologySpreadConstraints adds the given value to the TopologySpreadConstraints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TopologySpreadConstraints field.
+func (b *WorkspaceLocationApplyConfiguration) WithTopologySpreadConstraints(values ...*WorkspaceTopologySpreadConstraintApplyConfiguration) *WorkspaceLocationApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTopologySpreadConstraints")
+		}
+		b.TopologySpreadConstraints = append(b.TopologySpreadConstraints, *values[i])
+	}
+	return b
+}
@@ -18,14 +18,21 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	corev1alpha1 "github.com/kcp-dev/sdk/apis/core/v1alpha1"
+)
+
 // WorkspaceSpecApplyConfiguration represents a declarative configuration of the WorkspaceSpec type for use
 // with apply.
 type WorkspaceSpecApplyConfiguration struct {
-	Type     *WorkspaceTypeReferenceApplyConfiguration `json:"type,omitempty"`
-	Location *WorkspaceLocationApplyConfiguration      `json:"location,omitempty"`
-	Cluster  *string                                   `json:"cluster,omitempty"`
-	URL      *string                                   `json:"URL,omitempty"`
-	Mount    *MountApplyConfiguration                  `json:"mount,omitempty"`
+	Type        *WorkspaceTypeReferenceApplyConfiguration   `json:"type,omitempty"`
+	Location    *WorkspaceLocationApplyConfiguration        `json:"location,omitempty"`
+	Cluster     *string                                     `json:"cluster,omitempty"`
+	URL         *string                                     `json:"URL,omitempty"`
+	Mount       *MountApplyConfiguration                    `json:"mount,omitempty"`
+	MoveFrom    *string                                     `json:"moveFrom,omitempty"`
+	Hibernation *corev1alpha1.LogicalClusterHibernationMode `json:"hibernation,omitempty"`
+	Expiration  *WorkspaceExpirationApplyConfiguration      `json:"expiration,omitempty"`
 }
 
 // WorkspaceSpecApplyConfiguration constructs a declarative configuration of the WorkspaceSpec type for use with
@@ -73,3 +80,27 @@ func (b *WorkspaceSpecApplyConfiguration) WithMount(value *MountApplyConfigurati
 	b.Mount = value
 	return b
 }
+
+// WithMoveFrom sets the MoveFrom field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MoveFrom field is set to the value of the last call.
+func (b *WorkspaceSpecApplyConfiguration) WithMoveFrom(value string) *WorkspaceSpecApplyConfiguration {
+	b.MoveFrom = &value
+	return b
+}
+
+// WithHibernation sets the Hibernation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Hibernation field is set to the value of the last call.
+func (b *WorkspaceSpecApplyConfiguration) WithHibernation(value corev1alpha1.LogicalClusterHibernationMode) *WorkspaceSpecApplyConfiguration {
+	b.Hibernation = &value
+	return b
+}
+
+// WithExpiration sets the Expiration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Expiration field is set to the value of the last call.
+func (b *WorkspaceSpecApplyConfiguration) WithExpiration(value *WorkspaceExpirationApplyConfiguration) *WorkspaceSpecApplyConfiguration {
+	b.Expiration = value
+	return b
+}
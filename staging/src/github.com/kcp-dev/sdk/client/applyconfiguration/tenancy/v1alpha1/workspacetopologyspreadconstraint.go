@@ -0,0 +1,61 @@
+/*
+Copyright The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "github.com/kcp-dev/sdk/client/applyconfiguration/meta/v1"
+)
+
+// WorkspaceTopologySpreadConstraintApplyConfiguration represents a declarative configuration of the WorkspaceTopologySpreadConstraint type for use
+// with apply.
+type WorkspaceTopologySpreadConstraintApplyConfiguration struct {
+	TopologyKey       *string                             `json:"topologyKey,omitempty"`
+	MaxSkew           *int32                              `json:"maxSkew,omitempty"`
+	WorkspaceSelector *v1.LabelSelectorApplyConfiguration `json:"workspaceSelector,omitempty"`
+}
+
+// WorkspaceTopologySpreadConstraintApplyConfiguration constructs a declarative configuration of the WorkspaceTopologySpreadConstraint type for use with
+// apply.
+func WorkspaceTopologySpreadConstraint() *WorkspaceTopologySpreadConstraintApplyConfiguration {
+	return &WorkspaceTopologySpreadConstraintApplyConfiguration{}
+}
+
+// WithTopologyKey sets the TopologyKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopologyKey field is set to the value of the last call.
+func (b *WorkspaceTopologySpreadConstraintApplyConfiguration) WithTopologyKey(value string) *WorkspaceTopologySpreadConstraintApplyConfiguration {
+	b.TopologyKey = &value
+	return b
+}
+
+// WithMaxSkew sets the MaxSkew field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxSkew field is set to the value of the last call.
+func (b *WorkspaceTopologySpreadConstraintApplyConfiguration) WithMaxSkew(value int32) *WorkspaceTopologySpreadConstraintApplyConfiguration {
+	b.MaxSkew = &value
+	return b
+}
+
+// WithWorkspaceSelector sets the WorkspaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkspaceSelector field is set to the value of the last call.
+func (b *WorkspaceTopologySpreadConstraintApplyConfiguration) WithWorkspaceSelector(value *v1.LabelSelectorApplyConfiguration) *WorkspaceTopologySpreadConstraintApplyConfiguration {
+	b.WorkspaceSelector = value
+	return b
+}
@@ -34,6 +34,9 @@ type WorkspaceTypeSpecApplyConfiguration struct {
 	LimitAllowedParents          *WorkspaceTypeSelectorApplyConfiguration                 `json:"limitAllowedParents,omitempty"`
 	DefaultAPIBindings           []APIExportReferenceApplyConfiguration                   `json:"defaultAPIBindings,omitempty"`
 	DefaultAPIBindingLifecycle   *tenancyv1alpha1.APIBindingLifecycleMode                 `json:"defaultAPIBindingLifecycle,omitempty"`
+	DefaultResourceQuotas        []ResourceQuotaTemplateApplyConfiguration                `json:"defaultResourceQuotas,omitempty"`
+	DefaultLimitRanges           []LimitRangeTemplateApplyConfiguration                   `json:"defaultLimitRanges,omitempty"`
+	DefaultResourcesLifecycle    *tenancyv1alpha1.APIBindingLifecycleMode                 `json:"defaultResourcesLifecycle,omitempty"`
 	AuthenticationConfigurations []AuthenticationConfigurationReferenceApplyConfiguration `json:"authenticationConfigurations,omitempty"`
 }
 
@@ -126,6 +129,40 @@ func (b *WorkspaceTypeSpecApplyConfiguration) WithDefaultAPIBindingLifecycle(val
 	return b
 }
 
+// WithDefaultResourceQuotas adds the given value to the DefaultResourceQuotas field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DefaultResourceQuotas field.
+func (b *WorkspaceTypeSpecApplyConfiguration) WithDefaultResourceQuotas(values ...*ResourceQuotaTemplateApplyConfiguration) *WorkspaceTypeSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDefaultResourceQuotas")
+		}
+		b.DefaultResourceQuotas = append(b.DefaultResourceQuotas, *values[i])
+	}
+	return b
+}
+
+// WithDefaultLimitRanges adds the given value to the DefaultLimitRanges field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DefaultLimitRanges field.
+func (b *WorkspaceTypeSpecApplyConfiguration) WithDefaultLimitRanges(values ...*LimitRangeTemplateApplyConfiguration) *WorkspaceTypeSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDefaultLimitRanges")
+		}
+		b.DefaultLimitRanges = append(b.DefaultLimitRanges, *values[i])
+	}
+	return b
+}
+
+// WithDefaultResourcesLifecycle sets the DefaultResourcesLifecycle field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultResourcesLifecycle field is set to the value of the last call.
+func (b *WorkspaceTypeSpecApplyConfiguration) WithDefaultResourcesLifecycle(value tenancyv1alpha1.APIBindingLifecycleMode) *WorkspaceTypeSpecApplyConfiguration {
+	b.DefaultResourcesLifecycle = &value
+	return b
+}
+
 // WithAuthenticationConfigurations adds the given value to the AuthenticationConfigurations field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the AuthenticationConfigurations field.
@@ -120,8 +120,24 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apisv1alpha2.APIBindingSpecApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("APIBindingStatus"):
 		return &apisv1alpha2.APIBindingStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("APIDeployment"):
+		return &apisv1alpha2.APIDeploymentApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("APIDeploymentBindingStatus"):
+		return &apisv1alpha2.APIDeploymentBindingStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("APIDeploymentSpec"):
+		return &apisv1alpha2.APIDeploymentSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("APIDeploymentStatus"):
+		return &apisv1alpha2.APIDeploymentStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("APIDeploymentWave"):
+		return &apisv1alpha2.APIDeploymentWaveApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("APIExport"):
 		return &apisv1alpha2.APIExportApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("APIExportChannel"):
+		return &apisv1alpha2.APIExportChannelApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("APIExportDependency"):
+		return &apisv1alpha2.APIExportDependencyApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("APIExportRelease"):
+		return &apisv1alpha2.APIExportReleaseApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("APIExportSpec"):
 		return &apisv1alpha2.APIExportSpecApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("APIExportStatus"):
@@ -144,6 +160,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apisv1alpha2.PermissionClaimApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("PermissionClaimSelector"):
 		return &apisv1alpha2.PermissionClaimSelectorApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("ResourceRename"):
+		return &apisv1alpha2.ResourceRenameApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("ResourceSchema"):
 		return &apisv1alpha2.ResourceSchemaApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("ResourceSchemaStorage"):
@@ -238,28 +256,38 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationtenancyv1alpha1.IssuerApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("JWTAuthenticator"):
 		return &applyconfigurationtenancyv1alpha1.JWTAuthenticatorApplyConfiguration{}
+	case tenancyv1alpha1.SchemeGroupVersion.WithKind("LimitRangeTemplate"):
+		return &applyconfigurationtenancyv1alpha1.LimitRangeTemplateApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("Mount"):
 		return &applyconfigurationtenancyv1alpha1.MountApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("ObjectReference"):
 		return &applyconfigurationtenancyv1alpha1.ObjectReferenceApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("PrefixedClaimOrExpression"):
 		return &applyconfigurationtenancyv1alpha1.PrefixedClaimOrExpressionApplyConfiguration{}
+	case tenancyv1alpha1.SchemeGroupVersion.WithKind("ResourceQuotaTemplate"):
+		return &applyconfigurationtenancyv1alpha1.ResourceQuotaTemplateApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("UserValidationRule"):
 		return &applyconfigurationtenancyv1alpha1.UserValidationRuleApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("VirtualWorkspace"):
 		return &applyconfigurationtenancyv1alpha1.VirtualWorkspaceApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("Workspace"):
 		return &applyconfigurationtenancyv1alpha1.WorkspaceApplyConfiguration{}
+	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceAffinityTerm"):
+		return &applyconfigurationtenancyv1alpha1.WorkspaceAffinityTermApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceAuthenticationConfiguration"):
 		return &applyconfigurationtenancyv1alpha1.WorkspaceAuthenticationConfigurationApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceAuthenticationConfigurationSpec"):
 		return &applyconfigurationtenancyv1alpha1.WorkspaceAuthenticationConfigurationSpecApplyConfiguration{}
+	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceExpiration"):
+		return &applyconfigurationtenancyv1alpha1.WorkspaceExpirationApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceLocation"):
 		return &applyconfigurationtenancyv1alpha1.WorkspaceLocationApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceSpec"):
 		return &applyconfigurationtenancyv1alpha1.WorkspaceSpecApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceStatus"):
 		return &applyconfigurationtenancyv1alpha1.WorkspaceStatusApplyConfiguration{}
+	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceTopologySpreadConstraint"):
+		return &applyconfigurationtenancyv1alpha1.WorkspaceTopologySpreadConstraintApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceType"):
 		return &applyconfigurationtenancyv1alpha1.WorkspaceTypeApplyConfiguration{}
 	case tenancyv1alpha1.SchemeGroupVersion.WithKind("WorkspaceTypeExtension"):
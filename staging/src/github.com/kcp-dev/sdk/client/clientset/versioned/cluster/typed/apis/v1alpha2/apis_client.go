@@ -33,6 +33,7 @@ import (
 type ApisV1alpha2ClusterInterface interface {
 	ApisV1alpha2ClusterScoper
 	APIBindingsClusterGetter
+	APIDeploymentsClusterGetter
 	APIExportsClusterGetter
 }
 
@@ -56,6 +57,10 @@ func (c *ApisV1alpha2ClusterClient) APIBindings() APIBindingClusterInterface {
 	return &aPIBindingsClusterInterface{clientCache: c.clientCache}
 }
 
+func (c *ApisV1alpha2ClusterClient) APIDeployments() APIDeploymentClusterInterface {
+	return &aPIDeploymentsClusterInterface{clientCache: c.clientCache}
+}
+
 func (c *ApisV1alpha2ClusterClient) APIExports() APIExportClusterInterface {
 	return &aPIExportsClusterInterface{clientCache: c.clientCache}
 }
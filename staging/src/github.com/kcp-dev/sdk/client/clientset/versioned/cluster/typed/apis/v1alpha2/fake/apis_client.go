@@ -44,6 +44,10 @@ func (c *ApisV1alpha2ClusterClient) APIBindings() kcpapisv1alpha2.APIBindingClus
 	return newFakeAPIBindingClusterClient(c)
 }
 
+func (c *ApisV1alpha2ClusterClient) APIDeployments() kcpapisv1alpha2.APIDeploymentClusterInterface {
+	return newFakeAPIDeploymentClusterClient(c)
+}
+
 func (c *ApisV1alpha2ClusterClient) APIExports() kcpapisv1alpha2.APIExportClusterInterface {
 	return newFakeAPIExportClusterClient(c)
 }
@@ -57,6 +61,10 @@ func (c *ApisV1alpha2Client) APIBindings() apisv1alpha2.APIBindingInterface {
 	return newFakeAPIBindingClient(c.Fake, c.ClusterPath)
 }
 
+func (c *ApisV1alpha2Client) APIDeployments() apisv1alpha2.APIDeploymentInterface {
+	return newFakeAPIDeploymentClient(c.Fake, c.ClusterPath)
+}
+
 func (c *ApisV1alpha2Client) APIExports() apisv1alpha2.APIExportInterface {
 	return newFakeAPIExportClient(c.Fake, c.ClusterPath)
 }
@@ -20,4 +20,6 @@ package v1alpha2
 
 type APIBindingClusterExpansion interface{}
 
+type APIDeploymentClusterExpansion interface{}
+
 type APIExportClusterExpansion interface{}
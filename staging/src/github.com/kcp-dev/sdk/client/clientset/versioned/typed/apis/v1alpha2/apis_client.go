@@ -30,6 +30,7 @@ import (
 type ApisV1alpha2Interface interface {
 	RESTClient() rest.Interface
 	APIBindingsGetter
+	APIDeploymentsGetter
 	APIExportsGetter
 }
 
@@ -42,6 +43,10 @@ func (c *ApisV1alpha2Client) APIBindings() APIBindingInterface {
 	return newAPIBindings(c)
 }
 
+func (c *ApisV1alpha2Client) APIDeployments() APIDeploymentInterface {
+	return newAPIDeployments(c)
+}
+
 func (c *ApisV1alpha2Client) APIExports() APIExportInterface {
 	return newAPIExports(c)
 }
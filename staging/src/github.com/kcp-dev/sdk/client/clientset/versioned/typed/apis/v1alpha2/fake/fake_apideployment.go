@@ -0,0 +1,54 @@
+/*
+Copyright The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	gentype "k8s.io/client-go/gentype"
+
+	v1alpha2 "github.com/kcp-dev/sdk/apis/apis/v1alpha2"
+	apisv1alpha2 "github.com/kcp-dev/sdk/client/applyconfiguration/apis/v1alpha2"
+	typedapisv1alpha2 "github.com/kcp-dev/sdk/client/clientset/versioned/typed/apis/v1alpha2"
+)
+
+// fakeAPIDeployments implements APIDeploymentInterface
+type fakeAPIDeployments struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha2.APIDeployment, *v1alpha2.APIDeploymentList, *apisv1alpha2.APIDeploymentApplyConfiguration]
+	Fake *FakeApisV1alpha2
+}
+
+func newFakeAPIDeployments(fake *FakeApisV1alpha2) typedapisv1alpha2.APIDeploymentInterface {
+	return &fakeAPIDeployments{
+		gentype.NewFakeClientWithListAndApply[*v1alpha2.APIDeployment, *v1alpha2.APIDeploymentList, *apisv1alpha2.APIDeploymentApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha2.SchemeGroupVersion.WithResource("apideployments"),
+			v1alpha2.SchemeGroupVersion.WithKind("APIDeployment"),
+			func() *v1alpha2.APIDeployment { return &v1alpha2.APIDeployment{} },
+			func() *v1alpha2.APIDeploymentList { return &v1alpha2.APIDeploymentList{} },
+			func(dst, src *v1alpha2.APIDeploymentList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha2.APIDeploymentList) []*v1alpha2.APIDeployment {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha2.APIDeploymentList, items []*v1alpha2.APIDeployment) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
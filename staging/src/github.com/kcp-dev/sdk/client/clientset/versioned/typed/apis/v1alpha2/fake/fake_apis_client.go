@@ -33,6 +33,10 @@ func (c *FakeApisV1alpha2) APIBindings() v1alpha2.APIBindingInterface {
 	return newFakeAPIBindings(c)
 }
 
+func (c *FakeApisV1alpha2) APIDeployments() v1alpha2.APIDeploymentInterface {
+	return newFakeAPIDeployments(c)
+}
+
 func (c *FakeApisV1alpha2) APIExports() v1alpha2.APIExportInterface {
 	return newFakeAPIExports(c)
 }
@@ -20,4 +20,6 @@ package v1alpha2
 
 type APIBindingExpansion interface{}
 
+type APIDeploymentExpansion interface{}
+
 type APIExportExpansion interface{}
@@ -25,6 +25,8 @@ import (
 type ClusterInterface interface {
 	// APIBindings returns a APIBindingClusterInformer.
 	APIBindings() APIBindingClusterInformer
+	// APIDeployments returns a APIDeploymentClusterInformer.
+	APIDeployments() APIDeploymentClusterInformer
 	// APIExports returns a APIExportClusterInformer.
 	APIExports() APIExportClusterInformer
 }
@@ -44,6 +46,11 @@ func (v *version) APIBindings() APIBindingClusterInformer {
 	return &aPIBindingClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// APIDeployments returns a APIDeploymentClusterInformer.
+func (v *version) APIDeployments() APIDeploymentClusterInformer {
+	return &aPIDeploymentClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // APIExports returns a APIExportClusterInformer.
 func (v *version) APIExports() APIExportClusterInformer {
 	return &aPIExportClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -52,6 +59,8 @@ func (v *version) APIExports() APIExportClusterInformer {
 type Interface interface {
 	// APIBindings returns a APIBindingInformer.
 	APIBindings() APIBindingInformer
+	// APIDeployments returns a APIDeploymentInformer.
+	APIDeployments() APIDeploymentInformer
 	// APIExports returns a APIExportInformer.
 	APIExports() APIExportInformer
 }
@@ -72,6 +81,11 @@ func (v *scopedVersion) APIBindings() APIBindingInformer {
 	return &aPIBindingScopedInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// APIDeployments returns a APIDeploymentInformer.
+func (v *scopedVersion) APIDeployments() APIDeploymentInformer {
+	return &aPIDeploymentScopedInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // APIExports returns a APIExportInformer.
 func (v *scopedVersion) APIExports() APIExportInformer {
 	return &aPIExportScopedInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -113,6 +113,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		// Group=apis.kcp.io, Version=v1alpha2
 	case kcpv1alpha2.SchemeGroupVersion.WithResource("apibindings"):
 		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Apis().V1alpha2().APIBindings().Informer()}, nil
+	case kcpv1alpha2.SchemeGroupVersion.WithResource("apideployments"):
+		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Apis().V1alpha2().APIDeployments().Informer()}, nil
 	case kcpv1alpha2.SchemeGroupVersion.WithResource("apiexports"):
 		return &genericClusterInformer{resource: resource.GroupResource(), informer: f.Apis().V1alpha2().APIExports().Informer()}, nil
 
@@ -174,6 +176,9 @@ func (f *sharedScopedInformerFactory) ForResource(resource schema.GroupVersionRe
 	case kcpv1alpha2.SchemeGroupVersion.WithResource("apibindings"):
 		informer := f.Apis().V1alpha2().APIBindings().Informer()
 		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
+	case kcpv1alpha2.SchemeGroupVersion.WithResource("apideployments"):
+		informer := f.Apis().V1alpha2().APIDeployments().Informer()
+		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
 	case kcpv1alpha2.SchemeGroupVersion.WithResource("apiexports"):
 		informer := f.Apis().V1alpha2().APIExports().Informer()
 		return &genericInformer{lister: cache.NewGenericLister(informer.GetIndexer(), resource.GroupResource()), informer: informer}, nil
@@ -26,6 +26,14 @@ type APIBindingClusterListerExpansion interface{}
 // APIBindingLister.
 type APIBindingListerExpansion interface{}
 
+// APIDeploymentClusterListerExpansion allows custom methods to be added to
+// APIDeploymentClusterLister.
+type APIDeploymentClusterListerExpansion interface{}
+
+// APIDeploymentListerExpansion allows custom methods to be added to
+// APIDeploymentLister.
+type APIDeploymentListerExpansion interface{}
+
 // APIExportClusterListerExpansion allows custom methods to be added to
 // APIExportClusterLister.
 type APIExportClusterListerExpansion interface{}
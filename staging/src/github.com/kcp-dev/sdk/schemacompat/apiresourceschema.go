@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemacompat
+
+import (
+	"fmt"
+
+	"go.uber.org/multierr"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+)
+
+// EnsureAPIResourceSchemaCompatibility ensures that objects stored with the existing
+// APIResourceSchema are still valid with the new one: the group and resource must not change,
+// the storage version of the existing schema must still exist, and the schema of every version
+// that exists in both must be backwards compatible according to EnsureStructuralSchemaCompatibility.
+//
+// Every incompatibility is reported as a separate error, combined with multierr.
+func EnsureAPIResourceSchemaCompatibility(existing, new *apisv1alpha1.APIResourceSchema) error {
+	if existing.Spec.Group != new.Spec.Group || existing.Spec.Names.Plural != new.Spec.Names.Plural {
+		return field.Invalid(field.NewPath("spec"), fmt.Sprintf("%s.%s", new.Spec.Names.Plural, new.Spec.Group),
+			fmt.Sprintf("resource changed (was %s.%s)", existing.Spec.Names.Plural, existing.Spec.Group))
+	}
+
+	newVersions := map[string]*apisv1alpha1.APIResourceVersion{}
+	for i := range new.Spec.Versions {
+		newVersions[new.Spec.Versions[i].Name] = &new.Spec.Versions[i]
+	}
+
+	var err error
+	for i := range existing.Spec.Versions {
+		existingVersion := &existing.Spec.Versions[i]
+		fldPath := field.NewPath("spec", "versions").Key(existingVersion.Name)
+
+		newVersion, found := newVersions[existingVersion.Name]
+		if !found {
+			if existingVersion.Storage {
+				multierr.AppendInto(&err, field.NotFound(fldPath, "storage version was removed"))
+			}
+			continue
+		}
+
+		existingSchema, schemaErr := existingVersion.GetSchema()
+		if schemaErr != nil {
+			multierr.AppendInto(&err, field.InternalError(fldPath.Child("schema"), schemaErr))
+			continue
+		}
+		newSchema, schemaErr := newVersion.GetSchema()
+		if schemaErr != nil {
+			multierr.AppendInto(&err, field.InternalError(fldPath.Child("schema"), schemaErr))
+			continue
+		}
+		if existingSchema == nil || newSchema == nil {
+			continue
+		}
+		if _, compatErr := EnsureStructuralSchemaCompatibility(fldPath.Child("schema", "openAPIV3Schema"), existingSchema, newSchema, false); compatErr != nil {
+			multierr.AppendInto(&err, compatErr)
+		}
+	}
+	return err
+}
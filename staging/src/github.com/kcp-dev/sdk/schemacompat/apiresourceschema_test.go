@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The kcp Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemacompat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/multierr"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/sdk/apis/apis/v1alpha1"
+)
+
+func newSchema(t *testing.T, versions map[string]map[string]string, storage string) *apisv1alpha1.APIResourceSchema {
+	t.Helper()
+
+	sch := &apisv1alpha1.APIResourceSchema{
+		Spec: apisv1alpha1.APIResourceSchemaSpec{
+			Group: "example.io",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Plural: "widgets"},
+		},
+	}
+	for _, name := range []string{"v1", "v2"} {
+		properties, found := versions[name]
+		if !found {
+			continue
+		}
+		version := apisv1alpha1.APIResourceVersion{Name: name, Served: true, Storage: name == storage}
+		props := &apiextensionsv1.JSONSchemaProps{Type: "object", Properties: map[string]apiextensionsv1.JSONSchemaProps{}}
+		for k, v := range properties {
+			props.Properties[k] = apiextensionsv1.JSONSchemaProps{Type: v}
+		}
+		require.NoError(t, version.SetSchema(props))
+		sch.Spec.Versions = append(sch.Spec.Versions, version)
+	}
+	return sch
+}
+
+func TestEnsureAPIResourceSchemaCompatibility(t *testing.T) {
+	existing := newSchema(t, map[string]map[string]string{"v1": {"name": "string"}}, "v1")
+
+	tests := map[string]struct {
+		new     *apisv1alpha1.APIResourceSchema
+		wantErr []string
+	}{
+		"new field": {
+			new: newSchema(t, map[string]map[string]string{"v1": {"name": "string", "size": "integer"}}, "v1"),
+		},
+		"new version": {
+			new: newSchema(t, map[string]map[string]string{"v1": {"name": "string"}, "v2": {"size": "integer"}}, "v2"),
+		},
+		"removed field": {
+			new:     newSchema(t, map[string]map[string]string{"v1": {}}, "v1"),
+			wantErr: []string{`spec.versions[v1].schema.openAPIV3Schema.properties: Invalid value: ["name"]: properties value has been completely cleared in an incompatible way`},
+		},
+		"changed type": {
+			new:     newSchema(t, map[string]map[string]string{"v1": {"name": "integer"}}, "v1"),
+			wantErr: []string{`spec.versions[v1].schema.openAPIV3Schema.properties[name].type: Invalid value: "integer": The type changed (was "string", now "integer")`},
+		},
+		"removed storage version": {
+			new:     newSchema(t, map[string]map[string]string{"v2": {"name": "string"}}, "v2"),
+			wantErr: []string{`spec.versions[v1]: Not found: "storage version was removed"`},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := EnsureAPIResourceSchemaCompatibility(existing, tc.new)
+			var got []string
+			for _, e := range multierr.Errors(err) {
+				got = append(got, e.Error())
+			}
+			require.Equal(t, tc.wantErr, got)
+		})
+	}
+}
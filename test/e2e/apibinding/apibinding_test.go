@@ -212,13 +212,13 @@ func TestAPIBinding(t *testing.T) {
 	// Filtering out shards that are not schedulable
 	var shardItems []corev1alpha1.Shard
 	for _, s := range shards.Items {
-		if _, ok := s.Annotations["experimental.core.kcp.io/unschedulable"]; !ok {
+		if !s.Spec.Unschedulable {
 			shardItems = append(shardItems, s)
 		}
 	}
 	require.Eventually(t, func() bool {
 		for _, s := range shardItems {
-			if _, ok := s.Annotations["experimental.core.kcp.io/unschedulable"]; !ok {
+			if !s.Spec.Unschedulable {
 				if len(s.Spec.VirtualWorkspaceURL) == 0 {
 					t.Logf("%q shard hasn't had assigned a virtual workspace URL", s.Name)
 					return false
@@ -99,12 +99,10 @@ func TestPartitionSet(t *testing.T) {
 			Labels: map[string]string{
 				"partition-test-region": "partition-test-region-1",
 			},
-			Annotations: map[string]string{
-				"experimental.core.kcp.io/unschedulable": "true",
-			},
 		},
 		Spec: corev1alpha1.ShardSpec{
-			BaseURL: "https://base.kcp.test.dev",
+			BaseURL:       "https://base.kcp.test.dev",
+			Unschedulable: true,
 		},
 	}
 	shardClient := kcpClusterClient.CoreV1alpha1().Shards()
@@ -140,12 +138,10 @@ func TestPartitionSet(t *testing.T) {
 			Labels: map[string]string{
 				"partition-test-region": "partition-test-region-2",
 			},
-			Annotations: map[string]string{
-				"experimental.core.kcp.io/unschedulable": "true",
-			},
 		},
 		Spec: corev1alpha1.ShardSpec{
-			BaseURL: "https://base.kcp.test.dev",
+			BaseURL:       "https://base.kcp.test.dev",
+			Unschedulable: true,
 		},
 	}
 	shard2, err = shardClient.Cluster(core.RootCluster.Path()).Create(ctx, shard2, metav1.CreateOptions{})
@@ -206,12 +202,10 @@ func TestPartitionSet(t *testing.T) {
 			Labels: map[string]string{
 				"partition-test-region": "partition-test-region-3",
 			},
-			Annotations: map[string]string{
-				"experimental.core.kcp.io/unschedulable": "true",
-			},
 		},
 		Spec: corev1alpha1.ShardSpec{
-			BaseURL: "https://base.kcp.test.dev",
+			BaseURL:       "https://base.kcp.test.dev",
+			Unschedulable: true,
 		},
 	}
 	shard3, err = shardClient.Cluster(core.RootCluster.Path()).Create(ctx, shard3, metav1.CreateOptions{})
@@ -370,12 +364,10 @@ func TestPartitionSetAdmission(t *testing.T) {
 				"partition-test-label4": labelValues[3],
 				"partition-test-label5": labelValues[4],
 			},
-			Annotations: map[string]string{
-				"experimental.core.kcp.io/unschedulable": "true",
-			},
 		},
 		Spec: corev1alpha1.ShardSpec{
-			BaseURL: "https://base.kcp.test.dev",
+			BaseURL:       "https://base.kcp.test.dev",
+			Unschedulable: true,
 		},
 	}
 	shard, err := shardClient.Cluster(core.RootCluster.Path()).Create(ctx, admissionShard, metav1.CreateOptions{})